@@ -0,0 +1,820 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"iu-k8s.linecorp.com/server/pkg/client"
+)
+
+func runClusters(ctx context.Context, e *env, args []string) error {
+	if _, err := parseArgs(flag.NewFlagSet("clusters", flag.ContinueOnError), args); err != nil {
+		return err
+	}
+	resp, err := e.client.ListClustersWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+	if e.json {
+		return e.printJSON(resp.JSON200)
+	}
+	for _, cluster := range resp.JSON200.Items {
+		fmt.Fprintln(e.stdout, cluster.Name)
+	}
+	return nil
+}
+
+func runLogs(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("logs", flag.ContinueOnError)
+	container := flags.String("c", "", "Container name")
+	follow := flags.Bool("f", false, "Follow the log")
+	tail := flags.Int64("tail", -1, "Lines from the end of the log to show; -1 shows all")
+	since := flags.Duration("since", 0, "Only show lines newer than this")
+	timestamps := flags.Bool("timestamps", false, "Prefix lines with their timestamp")
+	previous := flags.Bool("previous", false, "Show the log of the previous container instance")
+	pos, err := parseArgs(flags, args, "CLUSTER", "NAMESPACE", "POD")
+	if err != nil {
+		return err
+	}
+
+	params := &client.GetPodLogsParams{Follow: follow, Timestamps: timestamps, Previous: previous}
+	if *container != "" {
+		params.Container = container
+	}
+	if *tail >= 0 {
+		params.TailLines = tail
+	}
+	if *since > 0 {
+		seconds := int64(max(since.Seconds(), 1))
+		params.SinceSeconds = &seconds
+	}
+	stream, err := client.PodLogs(ctx, e.client.ClientInterface, pos[0], pos[1], pos[2], params)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	_, err = io.Copy(e.stdout, stream)
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+func runScale(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("scale", flag.ContinueOnError)
+	wait := flags.Bool("wait", false, "Wait for the rollout to finish")
+	pos, err := parseArgs(flags, args, "CLUSTER", "NAMESPACE", "WORKLOAD", "NAME", "REPLICAS")
+	if err != nil {
+		return err
+	}
+	replicas, err := strconv.ParseInt(pos[4], 10, 32)
+	if err != nil || replicas < 0 {
+		return usageErrorf("invalid replicas %q", pos[4])
+	}
+	resp, err := e.client.ScaleWorkloadWithResponse(ctx, pos[0], pos[1], client.ScaleWorkloadParamsWorkload(pos[2]), pos[3], nil,
+		client.ScaleRequest{Replicas: int32(replicas)})
+	if err != nil {
+		return err
+	}
+	return e.operationStarted(ctx, resp.HTTPResponse, resp.Body, resp.JSON202, *wait)
+}
+
+func runRestart(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("restart", flag.ContinueOnError)
+	wait := flags.Bool("wait", false, "Wait for the rollout to finish")
+	pos, err := parseArgs(flags, args, "CLUSTER", "NAMESPACE", "WORKLOAD", "NAME")
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.RestartWorkloadWithResponse(ctx, pos[0], pos[1], client.RestartWorkloadParamsWorkload(pos[2]), pos[3], nil)
+	if err != nil {
+		return err
+	}
+	return e.operationStarted(ctx, resp.HTTPResponse, resp.Body, resp.JSON202, *wait)
+}
+
+func runRollback(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("rollback", flag.ContinueOnError)
+	wait := flags.Bool("wait", false, "Wait for the rollout to finish")
+	pos, err := parseArgs(flags, args, "CLUSTER", "NAMESPACE", "WORKLOAD", "NAME", "REVISION")
+	if err != nil {
+		return err
+	}
+	revision, err := strconv.ParseInt(pos[4], 10, 64)
+	if err != nil || revision < 1 {
+		return usageErrorf("invalid revision %q", pos[4])
+	}
+	resp, err := e.client.RollbackWorkloadWithResponse(ctx, pos[0], pos[1], client.RollbackWorkloadParamsWorkload(pos[2]), pos[3], nil,
+		client.RollbackRequest{Revision: revision})
+	if err != nil {
+		return err
+	}
+	return e.operationStarted(ctx, resp.HTTPResponse, resp.Body, resp.JSON202, *wait)
+}
+
+func runCordon(ctx context.Context, e *env, args []string) error {
+	pos, err := parseArgs(flag.NewFlagSet("cordon", flag.ContinueOnError), args, "CLUSTER", "NODE")
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.CordonNodeWithResponse(ctx, pos[0], pos[1])
+	if err != nil {
+		return err
+	}
+	return e.nodeScheduling(resp.HTTPResponse, resp.Body, resp.JSON200)
+}
+
+func runUncordon(ctx context.Context, e *env, args []string) error {
+	pos, err := parseArgs(flag.NewFlagSet("uncordon", flag.ContinueOnError), args, "CLUSTER", "NODE")
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.UncordonNodeWithResponse(ctx, pos[0], pos[1])
+	if err != nil {
+		return err
+	}
+	return e.nodeScheduling(resp.HTTPResponse, resp.Body, resp.JSON200)
+}
+
+func (e *env) nodeScheduling(httpResp *http.Response, body []byte, status *client.NodeSchedulingStatus) error {
+	if err := client.CheckResponse(httpResp, body); err != nil {
+		return err
+	}
+	if e.json {
+		return e.printJSON(status)
+	}
+	state := "schedulable"
+	if status.Unschedulable {
+		state = "unschedulable"
+	}
+	fmt.Fprintf(e.stdout, "node %s/%s is %s\n", status.Cluster, status.Name, state)
+	return nil
+}
+
+func runDrain(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("drain", flag.ContinueOnError)
+	wait := flags.Bool("wait", false, "Wait for the drain to finish")
+	force := flags.Bool("force", false, "Also evict pods not managed by a controller")
+	deleteEmptyDir := flags.Bool("delete-emptydir-data", false, "Also evict pods using emptyDir volumes")
+	gracePeriod := flags.Int64("grace-period", -1, "Grace period of evicted pods in seconds; -1 uses the server default")
+	timeout := flags.Duration("timeout", 0, "How long to keep evicting; 0 uses the server default")
+	pos, err := parseArgs(flags, args, "CLUSTER", "NODE")
+	if err != nil {
+		return err
+	}
+
+	body := client.DrainRequest{Force: force, DeleteEmptyDirData: deleteEmptyDir}
+	if *gracePeriod >= 0 {
+		body.GracePeriodSeconds = gracePeriod
+	}
+	if *timeout > 0 {
+		seconds := int(timeout.Seconds())
+		body.TimeoutSeconds = &seconds
+	}
+	resp, err := e.client.DrainNodeWithResponse(ctx, pos[0], pos[1], body)
+	if err != nil {
+		return err
+	}
+	return e.operationStarted(ctx, resp.HTTPResponse, resp.Body, resp.JSON202, *wait)
+}
+
+func runProvision(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("provision", flag.ContinueOnError)
+	template := flags.String("template", "", "Template to provision from")
+	team := flags.String("team", "", "Team owning the namespace")
+	groups := flags.String("groups", "", "Comma-separated groups of the team, bound to the roles of the template")
+	pos, err := parseArgs(flags, args, "CLUSTER", "NAMESPACE")
+	if err != nil {
+		return err
+	}
+	if *template == "" || *team == "" {
+		return usageErrorf("provision needs -template and -team")
+	}
+
+	body := client.ProvisionNamespaceRequest{Name: pos[1], Template: *template, Team: *team}
+	if *groups != "" {
+		list := strings.Split(*groups, ",")
+		body.Groups = &list
+	}
+	resp, err := e.client.ProvisionNamespaceWithResponse(ctx, pos[0], body)
+	if err != nil {
+		return err
+	}
+	return e.provisioned(resp.HTTPResponse, resp.Body, resp.JSON201, "provisioned")
+}
+
+func runDeprovision(ctx context.Context, e *env, args []string) error {
+	pos, err := parseArgs(flag.NewFlagSet("deprovision", flag.ContinueOnError), args, "CLUSTER", "NAMESPACE")
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.DeprovisionNamespaceWithResponse(ctx, pos[0], pos[1])
+	if err != nil {
+		return err
+	}
+	return e.provisioned(resp.HTTPResponse, resp.Body, resp.JSON200, "deprovisioned")
+}
+
+func (e *env) provisioned(httpResp *http.Response, body []byte, ns *client.ProvisionedNamespace, verb string) error {
+	if err := client.CheckResponse(httpResp, body); err != nil {
+		return err
+	}
+	if e.json {
+		return e.printJSON(ns)
+	}
+	fmt.Fprintf(e.stdout, "namespace %s/%s %s from template %s\n", ns.Cluster, ns.Name, verb, ns.Template)
+	for _, res := range ns.Resources {
+		fmt.Fprintf(e.stdout, "  %s/%s\n", res.Kind, res.Name)
+	}
+	return nil
+}
+
+func runOperation(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("operation", flag.ContinueOnError)
+	wait := flags.Bool("wait", false, "Wait for the operation to finish")
+	pos, err := parseArgs(flags, args, "ID")
+	if err != nil {
+		return err
+	}
+	if *wait {
+		op, err := client.WaitOperation(ctx, e.client, pos[0])
+		if err != nil {
+			return err
+		}
+		return e.printOperation(op)
+	}
+	resp, err := e.client.GetOperationWithResponse(ctx, pos[0], nil)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+	return e.printOperation(resp.JSON200)
+}
+
+// operationStarted reports an operation returned by a mutating endpoint and
+// optionally waits for it.
+func (e *env) operationStarted(ctx context.Context, httpResp *http.Response, body []byte, op *client.Operation, wait bool) error {
+	if err := client.CheckResponse(httpResp, body); err != nil {
+		return err
+	}
+	if !wait {
+		return e.printOperation(op)
+	}
+	if !e.json {
+		fmt.Fprintf(e.stdout, "operation %s started, waiting...\n", op.Id)
+	}
+	op, err := client.WaitOperation(ctx, e.client, op.Id)
+	if err != nil {
+		return err
+	}
+	return e.printOperation(op)
+}
+
+// printOperation prints op and fails if the operation failed.
+func (e *env) printOperation(op *client.Operation) error {
+	if e.json {
+		if err := e.printJSON(op); err != nil {
+			return err
+		}
+	} else {
+		w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "ID:\t%s\n", op.Id)
+		fmt.Fprintf(w, "Type:\t%s\n", op.Type)
+		fmt.Fprintf(w, "Status:\t%s\n", op.Status)
+		fmt.Fprintf(w, "Progress:\t%d%%\n", op.Progress.Percent)
+		if op.Progress.Message != nil {
+			fmt.Fprintf(w, "Message:\t%s\n", *op.Progress.Message)
+		}
+		fmt.Fprintf(w, "Started:\t%s\n", op.StartedAt.Format(time.RFC3339))
+		if op.FinishedAt != nil {
+			fmt.Fprintf(w, "Finished:\t%s\n", op.FinishedAt.Format(time.RFC3339))
+		}
+		if op.Error != nil {
+			fmt.Fprintf(w, "Error:\t%s\n", *op.Error)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if op.Status == client.OperationStatusFailed {
+		return fmt.Errorf("operation %s failed", op.Id)
+	}
+	return nil
+}
+
+func runApprovals(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("approvals", flag.ContinueOnError)
+	status := flags.String("status", "", "Only approvals in this status: pending, approved, rejected or expired")
+	limit := flags.Int("n", 50, "Maximum number of approvals to print")
+	if _, err := parseArgs(flags, args); err != nil {
+		return err
+	}
+
+	params := client.ListApprovalsParams{Limit: limit}
+	if *status != "" {
+		s := client.ListApprovalsParamsStatus(*status)
+		params.Status = &s
+	}
+	resp, err := e.client.ListApprovalsWithResponse(ctx, &params)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	if e.json {
+		return e.printJSON(resp.JSON200.Items)
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tREQUESTED\tREQUESTED BY\tOPERATION\tTARGET\tSTATUS")
+	for _, a := range resp.JSON200.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", a.Id, a.RequestedAt.Local().Format(time.RFC3339), a.RequestedBy,
+			a.OperationId, operationTarget(a.Target), a.Status)
+	}
+	return w.Flush()
+}
+
+func runApprove(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("approve", flag.ContinueOnError)
+	comment := flags.String("comment", "", "Comment recorded with the decision")
+	pos, err := parseArgs(flags, args, "ID")
+	if err != nil {
+		return err
+	}
+	decision := client.ApprovalDecision{}
+	if *comment != "" {
+		decision.Comment = comment
+	}
+	resp, err := e.client.ApproveOperationWithResponse(ctx, pos[0], decision)
+	if err != nil {
+		return err
+	}
+	return e.decided(resp.HTTPResponse, resp.Body, resp.JSON200)
+}
+
+func runReject(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("reject", flag.ContinueOnError)
+	comment := flags.String("comment", "", "Comment recorded with the decision")
+	pos, err := parseArgs(flags, args, "ID")
+	if err != nil {
+		return err
+	}
+	decision := client.ApprovalDecision{}
+	if *comment != "" {
+		decision.Comment = comment
+	}
+	resp, err := e.client.RejectOperationWithResponse(ctx, pos[0], decision)
+	if err != nil {
+		return err
+	}
+	return e.decided(resp.HTTPResponse, resp.Body, resp.JSON200)
+}
+
+// decided reports a decided approval and fails if its operation failed.
+func (e *env) decided(httpResp *http.Response, body []byte, a *client.Approval) error {
+	if err := client.CheckResponse(httpResp, body); err != nil {
+		return err
+	}
+	if e.json {
+		if err := e.printJSON(a); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(e.stdout, "approval %s %s: %s %s\n", a.Id, a.Status, a.OperationId, operationTarget(a.Target))
+	}
+	if a.Result != nil && a.Result.Status >= http.StatusBadRequest {
+		return fmt.Errorf("%s failed with status %d", a.OperationId, a.Result.Status)
+	}
+	return nil
+}
+
+func operationTarget(t client.OperationTarget) string {
+	parts := []string{t.Cluster}
+	for _, part := range []*string{t.Namespace, t.Resource, t.Name} {
+		if part != nil && *part != "" {
+			parts = append(parts, *part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+func runAudit(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("audit", flag.ContinueOnError)
+	principal := flags.String("principal", "", "Only entries of this principal")
+	cluster := flags.String("cluster", "", "Only entries targeting this cluster")
+	namespace := flags.String("namespace", "", "Only entries targeting this namespace")
+	action := flags.String("action", "", "Only entries of this action, e.g. deployments.scale")
+	outcome := flags.String("outcome", "", "Only entries with this outcome: success, failure or denied")
+	since := flags.String("since", "", "Only entries at or after this RFC 3339 time")
+	until := flags.String("until", "", "Only entries before this RFC 3339 time")
+	limit := flags.Int("n", 50, "Maximum number of entries to print")
+	if _, err := parseArgs(flags, args); err != nil {
+		return err
+	}
+
+	params := client.ListAuditEntriesParams{}
+	for _, f := range []struct {
+		value string
+		field **string
+	}{
+		{*principal, &params.Principal},
+		{*cluster, &params.Cluster},
+		{*namespace, &params.Namespace},
+		{*action, &params.Action},
+	} {
+		if f.value != "" {
+			*f.field = &f.value
+		}
+	}
+	if *outcome != "" {
+		o := client.ListAuditEntriesParamsOutcome(*outcome)
+		params.Outcome = &o
+	}
+	for _, t := range []struct {
+		flag  string
+		value string
+		field **time.Time
+	}{
+		{"since", *since, &params.Since},
+		{"until", *until, &params.Until},
+	} {
+		if t.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, t.value)
+		if err != nil {
+			return usageErrorf("invalid -%s: %v", t.flag, err)
+		}
+		*t.field = &parsed
+	}
+	// Fetch pages no larger than needed.
+	pageSize := min(*limit, 500)
+	params.Limit = &pageSize
+
+	var entries []client.AuditEntry
+	for entry, err := range client.AuditEntries(ctx, e.client, params) {
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		if len(entries) >= *limit {
+			break
+		}
+	}
+
+	if e.json {
+		return e.printJSON(entries)
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tPRINCIPAL\tACTION\tTARGET\tOUTCOME")
+	for _, entry := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", entry.Time.Local().Format(time.RFC3339), entry.Principal,
+			entry.Action, auditTarget(entry.Target), entry.Outcome)
+	}
+	return w.Flush()
+}
+
+func auditTarget(t client.AuditTarget) string {
+	var parts []string
+	for _, part := range []*string{t.Cluster, t.Namespace, t.Resource, t.Name} {
+		if part != nil && *part != "" {
+			parts = append(parts, *part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+func runEvents(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("events", flag.ContinueOnError)
+	namespace := flags.String("namespace", "", "Only events in this namespace")
+	kind := flags.String("kind", "", "Only events about objects of this kind, e.g. Pod")
+	name := flags.String("name", "", "Only events about objects of this name")
+	reason := flags.String("reason", "", "Only events with this reason, e.g. BackOff")
+	eventType := flags.String("type", "", "Only events of this type: Normal or Warning")
+	since := flags.String("since", "", "Only events that last occurred at or after this RFC 3339 time")
+	until := flags.String("until", "", "Only events that first occurred before this RFC 3339 time")
+	limit := flags.Int("n", 50, "Maximum number of events to print")
+	pos, err := parseArgs(flags, args, "CLUSTER")
+	if err != nil {
+		return err
+	}
+
+	params := client.SearchEventsParams{}
+	for _, f := range []struct {
+		value string
+		field **string
+	}{
+		{*namespace, &params.Namespace},
+		{*kind, &params.Kind},
+		{*name, &params.Name},
+		{*reason, &params.Reason},
+	} {
+		if f.value != "" {
+			*f.field = &f.value
+		}
+	}
+	if *eventType != "" {
+		t := client.SearchEventsParamsType(*eventType)
+		params.Type = &t
+	}
+	if params.Since, err = parseTimeFlag("since", *since); err != nil {
+		return err
+	}
+	if params.Until, err = parseTimeFlag("until", *until); err != nil {
+		return err
+	}
+	// Fetch pages no larger than needed.
+	pageSize := min(*limit, 500)
+	params.Limit = &pageSize
+
+	var events []client.ClusterEvent
+	for event, err := range client.Events(ctx, e.client, pos[0], params) {
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+		if len(events) >= *limit {
+			break
+		}
+	}
+
+	if e.json {
+		return e.printJSON(events)
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tOBJECT\tCOUNT\tMESSAGE")
+	for _, event := range events {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", event.LastSeen.Local().Format(time.RFC3339), event.Type,
+			event.Reason, eventObject(event.InvolvedObject), event.Count, event.Message)
+	}
+	return w.Flush()
+}
+
+func runTimeline(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("timeline", flag.ContinueOnError)
+	namespace := flags.String("namespace", "", "Namespace of the object; empty for cluster-scoped objects")
+	since := flags.String("since", "", "Only items at or after this RFC 3339 time")
+	until := flags.String("until", "", "Only items before this RFC 3339 time")
+	limit := flags.Int("n", 100, "Maximum number of items to print")
+	pos, err := parseArgs(flags, args, "CLUSTER", "RESOURCE", "NAME")
+	if err != nil {
+		return err
+	}
+	sinceTime, err := parseTimeFlag("since", *since)
+	if err != nil {
+		return err
+	}
+	untilTime, err := parseTimeFlag("until", *until)
+	if err != nil {
+		return err
+	}
+	pageSize := min(*limit, 500)
+
+	var timeline *client.Timeline
+	if *namespace == "" {
+		resp, err := e.client.GetTimelineWithResponse(ctx, pos[0], pos[1], pos[2], &client.GetTimelineParams{
+			Since: sinceTime, Until: untilTime, Limit: &pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+			return err
+		}
+		timeline = resp.JSON200
+	} else {
+		resp, err := e.client.GetNamespacedTimelineWithResponse(ctx, pos[0], *namespace, pos[1], pos[2], &client.GetNamespacedTimelineParams{
+			Since: sinceTime, Until: untilTime, Limit: &pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+			return err
+		}
+		timeline = resp.JSON200
+	}
+
+	if e.json {
+		return e.printJSON(timeline)
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tKIND\tDETAIL")
+	for _, item := range timeline.Items {
+		var detail string
+		switch {
+		case item.Event != nil:
+			detail = fmt.Sprintf("%s %s (x%d): %s", item.Event.Type, item.Event.Reason, item.Event.Count, item.Event.Message)
+		case item.AuditEntry != nil:
+			detail = fmt.Sprintf("%s %s: %s", item.AuditEntry.Principal, item.AuditEntry.Action, item.AuditEntry.Outcome)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", item.Time.Local().Format(time.RFC3339), item.Kind, detail)
+	}
+	return w.Flush()
+}
+
+// parseTimeFlag parses the RFC 3339 value of a time flag, returning nil
+// for an empty value.
+func parseTimeFlag(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, usageErrorf("invalid -%s: %v", name, err)
+	}
+	return &t, nil
+}
+
+func eventObject(o client.InvolvedObject) string {
+	if o.Namespace != nil && *o.Namespace != "" {
+		return fmt.Sprintf("%s/%s/%s", o.Kind, *o.Namespace, o.Name)
+	}
+	return o.Kind + "/" + o.Name
+}
+
+func runUsage(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("usage", flag.ContinueOnError)
+	namespace := flags.String("namespace", "", "Only report on this namespace")
+	team := flags.String("team", "", "Only report on the namespaces of this team")
+	groupBy := flags.String("by", "namespace", "Report per namespace, team or cluster")
+	asCSV := flags.Bool("csv", false, "Print the report as CSV")
+	pos, err := parseArgs(flags, args, "CLUSTER")
+	if err != nil {
+		return err
+	}
+
+	params := client.GetUsageReportParams{Namespace: optionalFlag(*namespace), Team: optionalFlag(*team)}
+	g := client.GetUsageReportParamsGroupBy(*groupBy)
+	params.GroupBy = &g
+	if *asCSV {
+		format := client.GetUsageReportParamsFormatCsv
+		params.Format = &format
+	}
+	resp, err := e.client.GetUsageReportWithResponse(ctx, pos[0], &params)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+	if *asCSV {
+		_, err := e.stdout.Write(resp.Body)
+		return err
+	}
+
+	if e.json {
+		return e.printJSON(resp.JSON200)
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tPODS\tCPU REQUESTS\tCPU LIMITS\tCPU USAGE\tMEMORY REQUESTS\tMEMORY LIMITS\tMEMORY USAGE\n",
+		strings.ToUpper(*groupBy))
+	for _, item := range resp.JSON200.Items {
+		name := pos[0]
+		switch *groupBy {
+		case "namespace":
+			name = deref(item.Namespace)
+		case "team":
+			name = cmp.Or(deref(item.Team), "-")
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n", name, item.Pods,
+			formatCPU(&item.CpuRequests), formatCPU(&item.CpuLimits), formatCPU(item.CpuUsage),
+			formatMemory(&item.MemoryRequests), formatMemory(&item.MemoryLimits), formatMemory(item.MemoryUsage))
+	}
+	return w.Flush()
+}
+
+func runQuotas(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("quotas", flag.ContinueOnError)
+	namespace := flags.String("namespace", "", "Only report on this namespace")
+	team := flags.String("team", "", "Only report on the namespaces of this team")
+	asCSV := flags.Bool("csv", false, "Print the report as CSV")
+	pos, err := parseArgs(flags, args, "CLUSTER")
+	if err != nil {
+		return err
+	}
+
+	params := client.GetQuotaReportParams{Namespace: optionalFlag(*namespace), Team: optionalFlag(*team)}
+	if *asCSV {
+		format := client.GetQuotaReportParamsFormatCsv
+		params.Format = &format
+	}
+	resp, err := e.client.GetQuotaReportWithResponse(ctx, pos[0], &params)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+	if *asCSV {
+		_, err := e.stdout.Write(resp.Body)
+		return err
+	}
+
+	if e.json {
+		return e.printJSON(resp.JSON200)
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tQUOTA\tRESOURCE\tUSED\tHARD\tPERCENT")
+	for _, item := range resp.JSON200.Items {
+		percent := "-"
+		if item.Percent != nil {
+			percent = fmt.Sprintf("%.1f%%", *item.Percent)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", item.Namespace, item.Quota, item.Resource, item.Used, item.Hard, percent)
+	}
+	return w.Flush()
+}
+
+func runWorkloads(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("workloads", flag.ContinueOnError)
+	namespace := flags.String("namespace", "", "Only report on this namespace")
+	team := flags.String("team", "", "Only report on the namespaces of this team")
+	threshold := flags.Float64("threshold", 0.5, "Fraction of its requests below which a workload is over-provisioned")
+	over := flags.Bool("over", false, "Only list over-provisioned workloads")
+	asCSV := flags.Bool("csv", false, "Print the report as CSV")
+	pos, err := parseArgs(flags, args, "CLUSTER")
+	if err != nil {
+		return err
+	}
+
+	params := client.GetWorkloadUsageReportParams{
+		Namespace:       optionalFlag(*namespace),
+		Team:            optionalFlag(*team),
+		Threshold:       threshold,
+		OverProvisioned: over,
+	}
+	if *asCSV {
+		format := client.GetWorkloadUsageReportParamsFormatCsv
+		params.Format = &format
+	}
+	resp, err := e.client.GetWorkloadUsageReportWithResponse(ctx, pos[0], &params)
+	if err != nil {
+		return err
+	}
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+	if *asCSV {
+		_, err := e.stdout.Write(resp.Body)
+		return err
+	}
+
+	if e.json {
+		return e.printJSON(resp.JSON200)
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tWORKLOAD\tPODS\tCPU REQUESTS\tCPU USAGE\tMEMORY REQUESTS\tMEMORY USAGE\tOVER-PROVISIONED")
+	for _, item := range resp.JSON200.Items {
+		fmt.Fprintf(w, "%s\t%s/%s\t%d\t%s\t%s\t%s\t%s\t%t\n", item.Namespace, item.Kind, item.Name, item.Pods,
+			formatCPU(&item.CpuRequests), formatCPU(item.CpuUsage),
+			formatMemory(&item.MemoryRequests), formatMemory(item.MemoryUsage), item.OverProvisioned)
+	}
+	return w.Flush()
+}
+
+// optionalFlag returns nil for an unset string flag.
+func optionalFlag(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+// formatCPU formats millicores, "-" for nil.
+func formatCPU(m *int64) string {
+	if m == nil {
+		return "-"
+	}
+	return strconv.FormatInt(*m, 10) + "m"
+}
+
+// formatMemory formats bytes in MiB, "-" for nil.
+func formatMemory(b *int64) string {
+	if b == nil {
+		return "-"
+	}
+	return strconv.FormatInt(*b>>20, 10) + "Mi"
+}
@@ -0,0 +1,218 @@
+// Command iuctl is a command-line client for the IU K8s API.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"iu-k8s.linecorp.com/server/internal/version"
+	"iu-k8s.linecorp.com/server/pkg/client"
+)
+
+// Exit codes, matching those of the server binary.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// env is what every command runs with.
+type env struct {
+	client *client.ClientWithResponses
+	json   bool
+	stdout io.Writer
+}
+
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(ctx context.Context, e *env, args []string) error
+}
+
+var commands = []command{
+	{"clusters", "", "List the clusters you may access", runClusters},
+	{"logs", "[-c CONTAINER] [-f] [-tail N] [-since D] [-timestamps] [-previous] CLUSTER NAMESPACE POD", "Print or follow a container log", runLogs},
+	{"scale", "[-wait] CLUSTER NAMESPACE WORKLOAD NAME REPLICAS", "Scale a deployment or statefulset", runScale},
+	{"restart", "[-wait] CLUSTER NAMESPACE WORKLOAD NAME", "Restart the pods of a workload", runRestart},
+	{"rollback", "[-wait] CLUSTER NAMESPACE WORKLOAD NAME REVISION", "Roll a workload back to a revision", runRollback},
+	{"cordon", "CLUSTER NODE", "Mark a node unschedulable", runCordon},
+	{"uncordon", "CLUSTER NODE", "Mark a node schedulable", runUncordon},
+	{"drain", "[-wait] [-force] [-delete-emptydir-data] [-grace-period N] [-timeout D] CLUSTER NODE", "Evict the pods of a node", runDrain},
+	{"provision", "-template T -team TEAM [-groups G,...] CLUSTER NAMESPACE", "Provision a namespace from a template", runProvision},
+	{"deprovision", "CLUSTER NAMESPACE", "Delete a provisioned namespace and what was created in it", runDeprovision},
+	{"operation", "[-wait] ID", "Show a long-running operation", runOperation},
+	{"approvals", "[-status S] [-n N]", "List approvals you requested or may decide, newest first", runApprovals},
+	{"approve", "[-comment C] ID", "Approve a pending operation, which then runs", runApprove},
+	{"reject", "[-comment C] ID", "Reject a pending operation", runReject},
+	{"audit", "[-principal P] [-cluster C] [-namespace N] [-action A] [-outcome O] [-since T] [-until T] [-n N]", "List audit entries, newest first", runAudit},
+	{"events", "[-namespace N] [-kind K] [-name N] [-reason R] [-type T] [-since T] [-until T] [-n N] CLUSTER", "Search events collected from a cluster, most recent first", runEvents},
+	{"timeline", "[-namespace N] [-since T] [-until T] [-n N] CLUSTER RESOURCE NAME", "Show the events and audit entries of an object, newest first", runTimeline},
+	{"usage", "[-namespace N] [-team T] [-by namespace|team|cluster] [-csv] CLUSTER", "Report CPU and memory requested, limited and used", runUsage},
+	{"quotas", "[-namespace N] [-team T] [-csv] CLUSTER", "Report ResourceQuota utilisation", runQuotas},
+	{"workloads", "[-namespace N] [-team T] [-threshold F] [-over] [-csv] CLUSTER", "Report workload usage and over-provisioning", runWorkloads},
+	{"version", "", "Print the iuctl version", runVersion},
+}
+
+// usageError is returned for invalid arguments.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usageErrorf(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	flags := flag.NewFlagSet("iuctl", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	server := flags.String("server", getEnv("IUCTL_SERVER", "http://localhost:8080"), "API server URL (IUCTL_SERVER)")
+	token := flags.String("token", os.Getenv("IUCTL_TOKEN"), "Bearer token (IUCTL_TOKEN)")
+	output := flags.String("o", "text", "Output format: text or json")
+	if err := flags.Parse(args); err != nil {
+		return fail(usageErrorf("%v", err), "")
+	}
+	if *output != "text" && *output != "json" {
+		return fail(usageErrorf("unknown output format %q", *output), "")
+	}
+	if flags.NArg() == 0 {
+		return fail(usageErrorf("no command given"), "")
+	}
+	name, rest := flags.Arg(0), flags.Args()[1:]
+	if name == "help" {
+		printUsage(os.Stdout)
+		return exitOK
+	}
+
+	opts := []client.ClientOption{
+		client.WithUserAgent("iuctl/" + version.Version),
+		client.WithRetry(client.DefaultRetryPolicy),
+	}
+	if *token != "" {
+		opts = append(opts, client.WithBearerToken(*token))
+	}
+	c, err := client.New(*server, opts...)
+	if err != nil {
+		return fail(usageErrorf("invalid server: %v", err), "")
+	}
+	e := &env{client: c, json: *output == "json", stdout: os.Stdout}
+
+	// One request ID per invocation ties every call to the server's logs.
+	requestID := newRequestID()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx = client.WithRequestID(ctx, requestID)
+	// Every command sends at most one mutating request, so the request ID
+	// also serves as its idempotency key and retries cannot apply it twice.
+	ctx = client.WithIdempotencyKey(ctx, requestID)
+
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return fail(cmd.run(ctx, e, rest), requestID)
+		}
+	}
+	return fail(usageErrorf("unknown command %q", name), "")
+}
+
+// fail reports err and returns the exit code it maps to.
+func fail(err error, requestID string) int {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return exitOK
+	}
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	var usage *usageError
+	if errors.As(err, &usage) {
+		fmt.Fprintln(os.Stderr)
+		printUsage(os.Stderr)
+		return exitUsage
+	}
+	var pending *client.PendingApprovalError
+	if errors.As(err, &pending) {
+		fmt.Fprintf(os.Stderr, "another user may approve it with: iuctl approve %s\n", pending.Approval.Id)
+	}
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		// The server generates its own ID if it rejected ours.
+		if apiErr.RequestId != nil {
+			requestID = *apiErr.RequestId
+		}
+		if requestID != "" {
+			fmt.Fprintf(os.Stderr, "request ID: %s\n", requestID)
+		}
+	}
+	return exitError
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: iuctl [-server URL] [-token TOKEN] [-o text|json] command [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+		if cmd.args != "" {
+			fmt.Fprintf(w, "  %-10s   iuctl %s %s\n", "", cmd.name, cmd.args)
+		}
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "WORKLOAD is deployments or statefulsets.")
+	fmt.Fprintln(w, "Exit codes: 0 success, 1 failure, 2 invalid usage.")
+}
+
+func runVersion(ctx context.Context, e *env, args []string) error {
+	if len(args) > 0 {
+		return usageErrorf("version takes no arguments")
+	}
+	fmt.Fprintf(e.stdout, "iuctl %s (%s, built %s)\n", version.Version, version.Commit, version.BuildDate)
+	return nil
+}
+
+// parseArgs parses flags and requires exactly the named positional
+// arguments, which it returns in order.
+func parseArgs(flags *flag.FlagSet, args []string, names ...string) ([]string, error) {
+	flags.SetOutput(io.Discard)
+	if err := flags.Parse(args); err != nil {
+		return nil, usageErrorf("%s: %v", flags.Name(), err)
+	}
+	if flags.NArg() != len(names) {
+		return nil, usageErrorf("%s needs %s", flags.Name(), strings.Join(names, " "))
+	}
+	return flags.Args(), nil
+}
+
+// printJSON writes v as indented JSON.
+func (e *env) printJSON(v any) error {
+	enc := json.NewEncoder(e.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return "iuctl-" + hex.EncodeToString(b)
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
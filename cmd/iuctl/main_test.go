@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/apitest"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/events"
+	"iu-k8s.linecorp.com/server/internal/operation"
+	"iu-k8s.linecorp.com/server/pkg/client"
+)
+
+func newEnv(t *testing.T, srv *apitest.Server, token string) (*env, *bytes.Buffer) {
+	t.Helper()
+	c, err := client.New(srv.URL, client.WithBearerToken(token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stdout bytes.Buffer
+	return &env{client: c, stdout: &stdout}, &stdout
+}
+
+func TestRunExitCodes(t *testing.T) {
+	srv := apitest.New(t)
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"success", []string{"-token", apitest.AdminToken, "audit", "-n", "1"}, exitOK},
+		{"forbidden", []string{"-token", apitest.UserToken, "audit"}, exitError},
+		{"unauthorized", []string{"-token", "nope", "audit"}, exitError},
+		{"no command", nil, exitUsage},
+		{"unknown command", []string{"nope"}, exitUsage},
+		{"unknown output", []string{"-o", "yaml", "clusters"}, exitUsage},
+		{"missing argument", []string{"-token", apitest.AdminToken, "operation"}, exitUsage},
+		{"invalid flag", []string{"-token", apitest.AdminToken, "audit", "-since", "yesterday"}, exitUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := append([]string{"-server", srv.URL}, tt.args...)
+			if got := run(args); got != tt.want {
+				t.Errorf("run(%q) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunSendsOneRequestID(t *testing.T) {
+	srv := apitest.New(t)
+	op := srv.Operations.Start(operation.Operation{Type: "test.run", Principal: auth.Principal{Name: apitest.Admin, Source: auth.SourceToken}},
+		func(ctx context.Context, report func(operation.Progress)) error { return nil })
+	if _, err := srv.Operations.Wait(context.Background(), op.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := run([]string{"-server", srv.URL, "-token", apitest.AdminToken, "operation", "-wait", op.ID}); got != exitOK {
+		t.Fatalf("run = %d, want %d", got, exitOK)
+	}
+	requests := srv.Requests()
+	if len(requests) == 0 {
+		t.Fatal("run sent no requests")
+	}
+	id := requests[0].Header.Get("X-Request-ID")
+	if id == "" {
+		t.Fatal("run sent no request ID")
+	}
+	for _, r := range requests {
+		if got := r.Header.Get("X-Request-ID"); got != id {
+			t.Errorf("request ID %q, want %q on every request of the invocation", got, id)
+		}
+		if got := r.UserAgent(); !strings.HasPrefix(got, "iuctl/") {
+			t.Errorf("User-Agent %q, want iuctl/...", got)
+		}
+	}
+}
+
+func TestAudit(t *testing.T) {
+	srv := apitest.New(t)
+	ctx := context.Background()
+	for i := range 5 {
+		err := srv.Audit.Record(ctx, audit.Entry{
+			Time:      time.Now(),
+			Principal: apitest.Admin,
+			Action:    "deployments.scale",
+			Target:    audit.Target{Cluster: apitest.Cluster, Namespace: "shop", Resource: "deployments", Name: fmt.Sprint("web-", i)},
+			Outcome:   audit.OutcomeSuccess,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	e, stdout := newEnv(t, srv, apitest.AdminToken)
+	if err := runAudit(ctx, e, []string{"-action", "deployments.scale", "-n", "3"}); err != nil {
+		t.Fatalf("runAudit: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "TIME") {
+		t.Fatalf("printed %q, want a header and 3 entries", stdout)
+	}
+	for i, line := range lines[1:] {
+		target := fmt.Sprintf("dev/shop/deployments/web-%d", 4-i)
+		if !strings.Contains(line, target) || !strings.Contains(line, "success") {
+			t.Errorf("line %q, want %s newest first", line, target)
+		}
+	}
+
+	e, stdout = newEnv(t, srv, apitest.AdminToken)
+	e.json = true
+	if err := runAudit(ctx, e, []string{"-action", "deployments.scale"}); err != nil {
+		t.Fatalf("runAudit: %v", err)
+	}
+	var entries []client.AuditEntry
+	if err := json.Unmarshal(stdout.Bytes(), &entries); err != nil {
+		t.Fatalf("output is not JSON: %v", err)
+	}
+	if len(entries) != 5 {
+		t.Errorf("printed %d entries, want 5", len(entries))
+	}
+
+	e, _ = newEnv(t, srv, apitest.UserToken)
+	var apiErr *client.APIError
+	if err := runAudit(ctx, e, nil); !errors.As(err, &apiErr) || apiErr.ErrorResponse.Error != "forbidden" {
+		t.Errorf("runAudit without permission = %v, want forbidden", err)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	srv := apitest.New(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	for i, reason := range []string{"BackOff", "Pulled", "BackOff"} {
+		err := srv.Events.SaveEvent(ctx, events.Event{
+			Cluster:   apitest.Cluster,
+			UID:       fmt.Sprint("uid-", i),
+			Namespace: "shop",
+			Name:      fmt.Sprint("event-", i),
+			Type:      "Warning",
+			Reason:    reason,
+			Message:   fmt.Sprint("message ", i),
+			Object:    events.ObjectReference{Kind: "Pod", Namespace: "shop", Name: "web"},
+			Count:     1,
+			FirstSeen: now.Add(time.Duration(i) * time.Second),
+			LastSeen:  now.Add(time.Duration(i) * time.Second),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	e, stdout := newEnv(t, srv, apitest.AdminToken)
+	if err := runEvents(ctx, e, []string{"-reason", "BackOff", apitest.Cluster}); err != nil {
+		t.Fatalf("runEvents: %v", err)
+	}
+	out := stdout.String()
+	if !strings.Contains(out, "message 2") || !strings.Contains(out, "message 0") || strings.Contains(out, "message 1") {
+		t.Errorf("printed %q, want only the BackOff events", out)
+	}
+	if strings.Index(out, "message 2") > strings.Index(out, "message 0") {
+		t.Errorf("printed %q, want the most recent first", out)
+	}
+
+	if err := runEvents(ctx, e, nil); err == nil {
+		t.Error("runEvents without a cluster succeeded")
+	}
+}
+
+func TestOperation(t *testing.T) {
+	srv := apitest.New(t)
+	ctx := context.Background()
+	op := srv.Operations.Start(operation.Operation{Type: "test.fail", Principal: auth.Principal{Name: apitest.Admin, Source: auth.SourceToken}},
+		func(ctx context.Context, report func(operation.Progress)) error {
+			time.Sleep(50 * time.Millisecond)
+			return errors.New("boom")
+		})
+
+	e, stdout := newEnv(t, srv, apitest.AdminToken)
+	err := runOperation(ctx, e, []string{"-wait", op.ID})
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("runOperation -wait = %v, want the failure", err)
+	}
+	fields := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		key, value, _ := strings.Cut(line, ":")
+		fields[key] = strings.TrimSpace(value)
+	}
+	if fields["ID"] != op.ID || fields["Status"] != "failed" || fields["Error"] != "boom" {
+		t.Errorf("printed %q, want the failed operation", stdout)
+	}
+
+	var apiErr *client.APIError
+	if err := runOperation(ctx, e, []string{"missing"}); !errors.As(err, &apiErr) || apiErr.ErrorResponse.Error != "operation_not_found" {
+		t.Errorf("runOperation of a missing operation = %v, want operation_not_found", err)
+	}
+}
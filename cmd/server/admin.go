@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/go-chi/chi/v5"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/handlers"
+	"iu-k8s.linecorp.com/server/internal/loadshed"
+	"iu-k8s.linecorp.com/server/internal/metrics"
+	"iu-k8s.linecorp.com/server/internal/middleware"
+)
+
+// readinessPath is answered without credentials so that probes need none.
+const readinessPath = "/readyz"
+
+// newAdminRouter builds the router of the admin listener: the management
+// operations of the API, which include the diagnostics, and Prometheus
+// metrics. Its tokens are separate from those of the public API. Its
+// requests take precedence in inFlight, which is nil without a limit.
+func newAdminRouter(cfg *config.Config, si api.ServerInterface, auditSink audit.Sink, inFlight *loadshed.Limiter) (http.Handler, error) {
+	var authenticator auth.Authenticator
+	if cfg.Admin.TokenFile != "" {
+		tokens, err := auth.LoadTokenFile(cfg.Admin.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load admin token file: %w", err)
+		}
+		authenticator = tokens
+	} else {
+		slog.Warn("No ADMIN_TOKEN_FILE configured, the admin listener is unauthenticated")
+	}
+
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID(cfg.Requests.IDHeaders, cfg.Requests.IDMaxLength))
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recovery)
+	if inFlight != nil {
+		r.Use(middleware.Shed(inFlight, loadshed.High, r))
+	}
+	r.Use(middleware.Timeout(cfg.Requests.Timeout, cfg.Requests.TimeoutOverrides, r))
+	if cfg.Compression.Enabled {
+		r.Use(middleware.Compress(cfg.Compression.MinSize, cfg.Compression.Types, r))
+	}
+	r.Use(middleware.Authenticate(authenticator))
+	if authenticator != nil {
+		r.Use(middleware.RequireAuthenticated(readinessPath))
+	}
+	r.Use(middleware.Audit(auditSink))
+
+	r.Handle("/metrics", metrics.Handler())
+
+	api.HandlerWithOptions(si, api.ChiServerOptions{
+		BaseRouter:       api.RoutesWithTag(r, api.ManagementTag),
+		ErrorHandlerFunc: handlers.RequestErrorHandler,
+	})
+	return r, nil
+}
+
+// adminListeners opens the TCP address and unix socket of the admin
+// listener, whichever are configured.
+func adminListeners(cfg config.AdminConfig) ([]net.Listener, error) {
+	var listeners []net.Listener
+	if cfg.Addr != "" {
+		l, err := net.Listen("tcp", cfg.Addr)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, l)
+	}
+	if cfg.Socket != "" {
+		l, err := listenUnix(cfg.Socket)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, l)
+	}
+	return listeners, nil
+}
+
+// listenUnix listens on a unix socket only the server's user can connect
+// to. A socket left behind by a previous run is replaced.
+func listenUnix(path string) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode().Type() != fs.ModeSocket {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		l.Close()
+		return nil, err
+	}
+	return l, nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+// runConfig implements config validate and config print.
+func runConfig(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return usageErrorf("config needs validate or print")
+	}
+	switch args[0] {
+	case "validate":
+		if len(args) > 1 {
+			return usageErrorf("config validate takes no arguments")
+		}
+		if err := cfg.Validate(); err != nil {
+			return fmt.Errorf("invalid configuration:\n%w", err)
+		}
+		fmt.Println("configuration is valid")
+		return nil
+	case "print":
+		flags := newFlagSet("config print")
+		asJSON := flags.Bool("json", false, "Print the settings as a JSON object")
+		if err := parseFlags(flags, args[1:]); err != nil {
+			return err
+		}
+		settings := cfg.Settings()
+		if *asJSON {
+			values := make(map[string]string, len(settings))
+			for _, s := range settings {
+				values[s.Name] = s.Value
+			}
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(values)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, s := range settings {
+			fmt.Fprintf(w, "%s\t%s\n", s.Name, s.Value)
+		}
+		return w.Flush()
+	default:
+		return usageErrorf("unknown config command %q", args[0])
+	}
+}
+
+// newFlagSet creates a flag set whose errors are reported by the caller as
+// usage errors rather than printed.
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	return flags
+}
+
+// parseFlags parses args into flags and rejects positional arguments.
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		return usageErrorf("%s: %v", flags.Name(), err)
+	}
+	if flags.NArg() > 0 {
+		return usageErrorf("%s: unexpected argument %q", flags.Name(), flags.Arg(0))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+// runHealthCheck probes the readiness endpoint of the admin listener of a
+// running server. It is the container health check, so it needs nothing but
+// the binary.
+func runHealthCheck(cfg *config.Config, args []string) error {
+	// With only a socket configured the probe goes through it.
+	defaultSocket := ""
+	if cfg.Admin.Addr == "" {
+		defaultSocket = cfg.Admin.Socket
+	}
+	flags := newFlagSet("health-check")
+	url := flags.String("url", adminURL(cfg.Admin)+readinessPath, "Readiness URL to probe")
+	socket := flags.String("socket", defaultSocket, "Unix socket to connect to instead of the host of -url")
+	timeout := flags.Duration("timeout", 2*time.Second, "Timeout of the probe")
+	insecure := flags.Bool("insecure", false, "Skip verification of the server certificate of an https URL")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if *insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	if *socket != "" {
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", *socket)
+		}
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, *url, nil)
+	if err != nil {
+		return usageErrorf("health-check: %v", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", *url, resp.Status)
+	}
+	return nil
+}
+
+// adminURL is the base URL the admin listener is reachable at from the
+// local host. With only a socket configured the host is a placeholder.
+func adminURL(cfg config.AdminConfig) string {
+	host, port, err := net.SplitHostPort(cfg.Addr)
+	if err != nil {
+		return "http://localhost"
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "127.0.0.1"
+	}
+	return "http://" + net.JoinHostPort(host, port)
+}
@@ -1,93 +1,128 @@
 package main
 
 import (
-	"context"
-	"log"
-	"net/http"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
+	"strings"
 
-	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/cors"
-	"github.com/go-chi/render"
-	"iu-k8s.linecorp.com/server/internal/api"
 	"iu-k8s.linecorp.com/server/internal/config"
-	"iu-k8s.linecorp.com/server/internal/handlers"
-	"iu-k8s.linecorp.com/server/internal/middleware"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/version"
 )
 
-func main() {
-	// Load configuration
-	cfg := config.Load()
+// Exit codes shared by every command, so scripts can tell a failed command
+// from a mistyped one.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
 
-	handler := handlers.New()
+// command is a subcommand of the server binary.
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(cfg *config.Config, args []string) error
+}
 
-	// Create router
-	r := chi.NewRouter()
+var commands = []command{
+	{"serve", "", "Run the API server (default)", runServe},
+	{"health-check", "[-url URL] [-socket PATH] [-timeout D] [-insecure]", "Exit 0 if the admin listener reports ready", runHealthCheck},
+	{"migrate", "up | down [steps] | status", "Manage the database schema", runMigrate},
+	{"config", "validate | print [-json]", "Check or show the configuration, with secrets redacted", runConfig},
+	{"openapi", "dump [-format yaml|json]", "Write the OpenAPI specification to stdout", runOpenAPI},
+	{"version", "", "Print build information", runVersion},
+}
 
-	// Configure middleware
-	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
-	r.Use(middleware.Logger)
-	r.Use(middleware.Recovery)
+// usageError is returned by commands invoked with invalid arguments.
+type usageError struct {
+	msg string
+}
 
-	// Configure CORS
-	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
-		AllowCredentials: false,
-		MaxAge:           300,
-	}))
+func (e *usageError) Error() string {
+	return e.msg
+}
 
-	// Configure JSON render
-	render.Respond = func(w http.ResponseWriter, r *http.Request, v interface{}) {
-		if err, ok := v.(error); ok {
-			render.DefaultResponder(w, r, render.M{"error": err.Error()})
-			return
-		}
-		render.DefaultResponder(w, r, v)
-	}
+func usageErrorf(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
 
-	// Mount the generated API routes
-	api.HandlerFromMux(
-		api.NewStrictHandler(handler, []api.StrictMiddlewareFunc{}),
-		r,
-	)
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
 
-	// Create HTTP server
-	srv := &http.Server{
-		Addr:    ":" + cfg.Server.Port,
-		Handler: r,
+func run(args []string) int {
+	flags := flag.NewFlagSet("server", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	logLevel := flags.String("log-level", "", "Log level, overriding LOG_LEVEL")
+	logFormat := flags.String("log-format", "", "Log format, overriding LOG_FORMAT")
+	if err := flags.Parse(args); err != nil {
+		return fail(usageErrorf("%v", err))
 	}
 
-	// Channel to listen for interrupt signals
-	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
-
-	// Start server in a goroutine
-	go func() {
-		log.Printf("Starting server on port %s", cfg.Server.Port)
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to start server: %v", err)
+	// Logging is configured from LOG_LEVEL and LOG_FORMAT when the log
+	// package loads; the flags take precedence.
+	if *logLevel != "" {
+		if err := log.SetLevel(*logLevel); err != nil {
+			return fail(usageErrorf("%v", err))
 		}
-	}()
+	}
+	if *logFormat != "" {
+		if err := log.SetFormat(*logFormat); err != nil {
+			return fail(usageErrorf("%v", err))
+		}
+	}
 
-	// Wait for interrupt signal
-	<-stop
-	log.Println("Shutting down server...")
+	name, rest := "serve", flags.Args()
+	if len(rest) > 0 {
+		name, rest = rest[0], rest[1:]
+	}
+	if name == "help" || name == "-h" || name == "--help" {
+		printUsage(os.Stdout)
+		return exitOK
+	}
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return fail(cmd.run(config.Load(), rest))
+		}
+	}
+	return fail(usageErrorf("unknown command %q", name))
+}
 
-	// Create a context with timeout for shutdown
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+// fail reports err and returns the exit code it maps to.
+func fail(err error) int {
+	if err == nil {
+		return exitOK
+	}
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	var usage *usageError
+	if errors.As(err, &usage) {
+		fmt.Fprintln(os.Stderr)
+		printUsage(os.Stderr)
+		return exitUsage
+	}
+	return exitError
+}
 
-	// Shutdown server gracefully
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatalf("Server shutdown failed: %v", err)
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: server [-log-level LEVEL] [-log-format FORMAT] [command]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-40s %s\n", strings.TrimSpace(cmd.name+" "+cmd.args), cmd.summary)
 	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Exit codes: 0 success, 1 failure, 2 invalid usage.")
+}
 
-	log.Println("Server stopped")
+func runVersion(cfg *config.Config, args []string) error {
+	if len(args) > 0 {
+		return usageErrorf("version takes no arguments")
+	}
+	fmt.Printf("version:    %s\ncommit:     %s\nbuild date: %s\n", version.Version, version.Commit, version.BuildDate)
+	return nil
 }
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/storage"
+)
+
+// runMigrate implements the migrate subcommand against the configured
+// database.
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return usageErrorf("migrate needs up, down or status")
+	}
+	if cfg.Database.Driver == "" {
+		return fmt.Errorf("DATABASE_DRIVER is not set")
+	}
+
+	db, err := storage.Open(cfg.Database)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	migrator, err := storage.NewMigrator(db)
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		if len(args) > 1 {
+			return usageErrorf("migrate up takes no arguments")
+		}
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied %d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+	case "down":
+		steps := 1
+		switch {
+		case len(args) > 2:
+			return usageErrorf("migrate down takes at most one argument")
+		case len(args) == 2:
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return usageErrorf("invalid steps %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+		if len(reverted) == 0 {
+			fmt.Println("no applied migrations")
+		}
+	case "status":
+		if len(args) > 1 {
+			return usageErrorf("migrate status takes no arguments")
+		}
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to read migration status: %w", err)
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.AppliedAt != nil {
+				state = "applied " + s.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			fmt.Printf("%04d_%s\t%s\n", s.Version, s.Name, state)
+		}
+	default:
+		return usageErrorf("unknown migrate command %q", args[0])
+	}
+	return nil
+}
+
+// openDatabase opens the configured database for the server and makes sure
+// its schema is current, migrating it when DATABASE_AUTO_MIGRATE is set.
+func openDatabase(cfg config.DatabaseConfig) (*storage.DB, error) {
+	db, err := storage.Open(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	migrator, err := storage.NewMigrator(db)
+	if err == nil {
+		if cfg.AutoMigrate {
+			var applied []storage.Migration
+			applied, err = migrator.Up(ctx)
+			for _, m := range applied {
+				slog.Info("Applied migration", "version", m.Version, "name", m.Name)
+			}
+		} else if err = migrator.EnsureMigrated(ctx); err != nil {
+			err = fmt.Errorf("%w; run `server migrate up` or set DATABASE_AUTO_MIGRATE=true", err)
+		}
+	}
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
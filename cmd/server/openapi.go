@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"sigs.k8s.io/yaml"
+)
+
+// runOpenAPI implements openapi dump, which writes the specification
+// embedded in the binary.
+func runOpenAPI(cfg *config.Config, args []string) error {
+	if len(args) == 0 || args[0] != "dump" {
+		return usageErrorf("openapi needs dump")
+	}
+	flags := newFlagSet("openapi dump")
+	format := flags.String("format", "yaml", "Output format: yaml or json")
+	if err := parseFlags(flags, args[1:]); err != nil {
+		return err
+	}
+
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("failed to load embedded specification: %w", err)
+	}
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return err
+	}
+	switch *format {
+	case "json":
+		data = append(data, '\n')
+	case "yaml":
+		if data, err = yaml.JSONToYAML(data); err != nil {
+			return err
+		}
+	default:
+		return usageErrorf("openapi dump: unknown format %q", *format)
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
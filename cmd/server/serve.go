@@ -0,0 +1,380 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/approval"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/corsconfig"
+	"iu-k8s.linecorp.com/server/internal/diagnostics"
+	"iu-k8s.linecorp.com/server/internal/events"
+	"iu-k8s.linecorp.com/server/internal/handlers"
+	"iu-k8s.linecorp.com/server/internal/idempotency"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/loadshed"
+	"iu-k8s.linecorp.com/server/internal/metrics"
+	"iu-k8s.linecorp.com/server/internal/middleware"
+	"iu-k8s.linecorp.com/server/internal/notify"
+	"iu-k8s.linecorp.com/server/internal/operation"
+	"iu-k8s.linecorp.com/server/internal/ratelimit"
+	"iu-k8s.linecorp.com/server/internal/storage"
+	"iu-k8s.linecorp.com/server/internal/tlsconfig"
+	"iu-k8s.linecorp.com/server/internal/version"
+)
+
+// runServe runs the API server until it receives SIGINT or SIGTERM.
+func runServe(cfg *config.Config, args []string) error {
+	if len(args) > 0 {
+		return usageErrorf("serve takes no arguments")
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	slog.Info("Starting iu-k8s", "version", version.Version, "commit", version.Commit)
+
+	clusters, err := kube.Load(cfg.Kube)
+	if err != nil {
+		return fmt.Errorf("failed to load clusters: %w", err)
+	}
+	slog.Info("Registered clusters", "clusters", clusters.Names())
+
+	var authenticator auth.Authenticator
+	if cfg.Auth.TokenFile != "" {
+		tokens, err := auth.LoadTokenFile(cfg.Auth.TokenFile)
+		if err != nil {
+			return fmt.Errorf("failed to load token file: %w", err)
+		}
+		authenticator = tokens
+	}
+
+	// Without a policy file every authorization check is denied.
+	policy := &auth.Policy{}
+	if cfg.Auth.PolicyFile != "" {
+		if policy, err = auth.LoadPolicyFile(cfg.Auth.PolicyFile); err != nil {
+			return fmt.Errorf("failed to load policy file: %w", err)
+		}
+	} else {
+		slog.Warn("No AUTH_POLICY_FILE configured, denying all authorized operations")
+	}
+
+	// The repository stays a nil interface without a database so handlers
+	// can tell it is absent.
+	var repo storage.Repository
+	var db *storage.DB
+	if cfg.Database.Driver != "" {
+		if db, err = openDatabase(cfg.Database); err != nil {
+			return err
+		}
+		defer db.Close()
+		repo = db
+	}
+
+	var auditSink audit.Sink
+	if cfg.Audit.Sink == "database" {
+		auditSink = storage.AuditSink(repo)
+	} else if auditSink, err = audit.Open(cfg.Audit); err != nil {
+		return fmt.Errorf("failed to open audit sink: %w", err)
+	}
+	defer auditSink.Close()
+
+	watches := kube.NewWatchHub(kube.WatchOptions{
+		BufferSize:  cfg.Watch.BufferSize,
+		HistorySize: cfg.Watch.HistorySize,
+		SyncTimeout: cfg.Watch.SyncTimeout,
+	})
+
+	var destinations []notify.Destination
+	if cfg.Notify.SinksFile != "" {
+		if destinations, err = notify.LoadSinks(cfg.Notify.SinksFile); err != nil {
+			return fmt.Errorf("failed to load notification sinks: %w", err)
+		}
+	}
+	var deadLetters notify.DeadLetterStore = notify.NewMemory()
+	if cfg.Notify.DeadLetterStore == "database" {
+		deadLetters = db
+	}
+	notifier := notify.New(destinations, deadLetters, notify.Options{
+		Timeout:     cfg.Notify.Timeout,
+		MaxAttempts: cfg.Notify.MaxAttempts,
+		Backoff:     cfg.Notify.RetryBackoff,
+		QueueSize:   cfg.Notify.QueueSize,
+		Retention:   cfg.Notify.DeadLetterRetention,
+	})
+	defer notifier.Shutdown()
+
+	operations := operation.NewManager(operation.Options{
+		Timeout:   cfg.Operations.Timeout,
+		Retention: cfg.Operations.Retention,
+		OnFinish: func(op operation.Operation) {
+			notifier.Notify(notify.OperationEvent(op))
+		},
+	})
+
+	var templates kube.NamespaceTemplates
+	if cfg.Provisioning.TemplatesFile != "" {
+		if templates, err = kube.LoadNamespaceTemplates(cfg.Provisioning.TemplatesFile); err != nil {
+			return fmt.Errorf("failed to load namespace templates: %w", err)
+		}
+	}
+
+	captures := diagnostics.NewCapturer()
+	defer captures.Shutdown()
+
+	for _, rule := range policy.Approvals {
+		for _, operationID := range rule.Operations {
+			if !api.HasOperation(operationID) {
+				return fmt.Errorf("AUTH_POLICY_FILE: unknown operation %q in approvals", operationID)
+			}
+		}
+	}
+	var approvalStore approval.Store = approval.NewMemory()
+	if cfg.Approvals.Store == "database" {
+		approvalStore = db
+	}
+	approvals := approval.NewManager(approvalStore, auditSink, approval.Options{
+		TTL:       cfg.Approvals.TTL,
+		Retention: cfg.Approvals.Retention,
+		OnChange: func(a approval.Approval) {
+			notifier.Notify(notify.ApprovalEvent(a))
+		},
+	})
+	defer approvals.Shutdown()
+
+	// The event store stays a nil interface unless events are collected.
+	var eventStore events.Store
+	if cfg.Events.Enabled {
+		eventStore = events.NewMemory()
+		if cfg.Events.Store == "database" {
+			eventStore = db
+		}
+		collector := events.NewCollector(clusters, eventStore, cfg.Events.Retention)
+		defer collector.Shutdown()
+	}
+
+	handler := handlers.New(handlers.Dependencies{
+		Config:     cfg,
+		Clusters:   clusters,
+		Authorizer: policy,
+		Audit:      auditSink,
+		Watches:    watches,
+		Operations: operations,
+		Captures:   captures,
+		Templates:  templates,
+		Approvals:  approvals,
+		Notifier:   notifier,
+		Events:     eventStore,
+		Repository: repo,
+	})
+
+	// Create router
+	r := chi.NewRouter()
+
+	var limiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		var store ratelimit.Store = ratelimit.NewMemory()
+		if cfg.RateLimit.Store == "database" {
+			store = db
+		}
+		if limiter, err = ratelimit.New(cfg.RateLimit, store, api.HasOperation); err != nil {
+			return fmt.Errorf("failed to configure rate limiting: %w", err)
+		}
+	}
+
+	// Validate has checked the CIDRs.
+	var trustedProxies []netip.Prefix
+	for _, cidr := range cfg.Server.TrustedProxies {
+		trustedProxies = append(trustedProxies, netip.MustParsePrefix(cidr).Masked())
+	}
+
+	for operationID := range cfg.Requests.TimeoutOverrides {
+		if !api.HasOperation(operationID) {
+			return fmt.Errorf("REQUEST_TIMEOUT_OVERRIDES: unknown operation %q", operationID)
+		}
+	}
+	// The public and admin listeners share the in-flight limit; admin
+	// requests are admitted first.
+	var inFlight *loadshed.Limiter
+	if cfg.Requests.MaxInFlight > 0 {
+		inFlight = loadshed.New(cfg.Requests.MaxInFlight, cfg.Requests.MaxQueueDelay)
+	}
+
+	corsPolicy, err := corsconfig.New(cfg.CORS)
+	if err != nil {
+		return fmt.Errorf("failed to configure CORS: %w", err)
+	}
+	if cfg.CORS.PolicyFile != "" {
+		reloadCtx, stopReload := context.WithCancel(context.Background())
+		defer stopReload()
+		go corsPolicy.Run(reloadCtx, cfg.CORS.ReloadInterval)
+	}
+	// Browsers present client certificates on their own, so WebSocket
+	// sessions are then only opened from allowed origins.
+	var execOrigins func(string) bool
+	if cfg.TLS.ClientCAFile != "" {
+		execOrigins = corsPolicy.OriginAllowed
+	}
+	execHandler := handlers.NewExecHandler(clusters, policy, auditSink, cfg.Exec, execOrigins)
+
+	// Configure middleware
+	r.Use(metrics.Middleware)
+	r.Use(middleware.RequestID(cfg.Requests.IDHeaders, cfg.Requests.IDMaxLength))
+	r.Use(middleware.RealIP(trustedProxies))
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recovery)
+	// CORS comes before anything that can reject a request so that browsers
+	// can read the rejection, and answers preflights itself.
+	r.Use(corsPolicy.Handler)
+	if inFlight != nil {
+		r.Use(middleware.Shed(inFlight, loadshed.Low, r))
+	}
+	r.Use(middleware.Timeout(cfg.Requests.Timeout, cfg.Requests.TimeoutOverrides, r))
+	if cfg.Compression.Enabled {
+		r.Use(middleware.Compress(cfg.Compression.MinSize, cfg.Compression.Types, r))
+	}
+	// Conditional hashes the body before it is compressed, so that every
+	// content coding shares the ETag.
+	r.Use(middleware.Conditional(r))
+	r.Use(middleware.Authenticate(authenticator))
+	if limiter != nil {
+		r.Use(middleware.RateLimit(limiter, r))
+	}
+	r.Use(middleware.Audit(auditSink))
+
+	// Configure JSON render
+	render.Respond = func(w http.ResponseWriter, r *http.Request, v interface{}) {
+		if err, ok := v.(error); ok {
+			render.DefaultResponder(w, r, render.M{"error": err.Error()})
+			return
+		}
+		render.DefaultResponder(w, r, v)
+	}
+
+	// Mount the WebSocket routes, which need the raw request to upgrade
+	execHandler.Routes(r)
+
+	// Mount the generated API routes. Management operations are only served
+	// by the admin listener.
+	var idempotencyStore idempotency.Store = idempotency.NewMemory()
+	if cfg.Idempotency.Store == "database" {
+		idempotencyStore = db
+	}
+	// Each strict middleware wraps the ones before it, so AuditOperation
+	// names the operation of requests Idempotency answers by itself too.
+	si := api.NewStrictHandlerWithOptions(handler, []api.StrictMiddlewareFunc{
+		middleware.Approval(policy, approvals, cfg.Apply.MaxBodySize, handler.AuthorizeRequest, handlers.WritePendingApproval),
+		middleware.Idempotency(idempotencyStore, cfg.Idempotency.TTL, cfg.Apply.MaxBodySize),
+		middleware.AuditOperation,
+	}, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  handlers.RequestErrorHandler,
+		ResponseErrorHandlerFunc: handlers.ResponseErrorHandler,
+	})
+	api.HandlerWithOptions(si, api.ChiServerOptions{
+		BaseRouter:       api.RoutesWithoutTag(r, api.ManagementTag),
+		ErrorHandlerFunc: handlers.RequestErrorHandler,
+	})
+
+	adminRouter, err := newAdminRouter(cfg, si, auditSink, inFlight)
+	if err != nil {
+		return err
+	}
+	adminListeners, err := adminListeners(cfg.Admin)
+	if err != nil {
+		return fmt.Errorf("failed to open admin listener: %w", err)
+	}
+	adminSrv := &http.Server{
+		Handler:      adminRouter,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+		IdleTimeout:  cfg.Server.IdleTimeout,
+	}
+
+	// Create HTTP server
+	srv := &http.Server{
+		Addr:         ":" + cfg.Server.Port,
+		Handler:      r,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+		IdleTimeout:  cfg.Server.IdleTimeout,
+	}
+	if cfg.TLS.CertFile != "" {
+		tlsConfig, reloader, err := tlsconfig.New(cfg.TLS)
+		if err != nil {
+			return fmt.Errorf("failed to configure TLS: %w", err)
+		}
+		srv.TLSConfig = tlsConfig
+		reloadCtx, stopReload := context.WithCancel(context.Background())
+		defer stopReload()
+		go reloader.Run(reloadCtx, cfg.TLS.ReloadInterval)
+	}
+
+	// Channel to listen for interrupt signals
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	// Start the servers in goroutines
+	serveErr := make(chan error, 1+len(adminListeners))
+	for _, l := range adminListeners {
+		go func() {
+			slog.Info("Starting admin server", "addr", l.Addr().String())
+			if err := adminSrv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				serveErr <- err
+			}
+		}()
+	}
+	go func() {
+		slog.Info("Starting server", "port", cfg.Server.Port, "tls", srv.TLSConfig != nil)
+		var err error
+		if srv.TLSConfig != nil {
+			// The certificates come from TLSConfig.GetCertificate.
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+	}()
+
+	// Wait for interrupt signal
+	select {
+	case err := <-serveErr:
+		adminSrv.Close()
+		operations.Shutdown()
+		return fmt.Errorf("failed to start server: %w", err)
+	case <-stop:
+	}
+	slog.Info("Shutting down server...")
+
+	// Create a context with timeout for shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Shutdown server gracefully. The admin server stops last so that it
+	// keeps answering probes while requests drain.
+	if err := srv.Shutdown(ctx); err != nil {
+		return fmt.Errorf("server shutdown failed: %w", err)
+	}
+	if err := adminSrv.Shutdown(ctx); err != nil {
+		return fmt.Errorf("admin server shutdown failed: %w", err)
+	}
+
+	// Fail operations still being tracked rather than leaving them running
+	operations.Shutdown()
+
+	slog.Info("Server stopped")
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -22,12 +23,181 @@ import (
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
+// Defines values for ApplyDocumentResultStatus.
+const (
+	ApplyDocumentResultStatusConfigured ApplyDocumentResultStatus = "configured"
+	ApplyDocumentResultStatusCreated    ApplyDocumentResultStatus = "created"
+	ApplyDocumentResultStatusFailed     ApplyDocumentResultStatus = "failed"
+	ApplyDocumentResultStatusUnchanged  ApplyDocumentResultStatus = "unchanged"
+)
+
+// Defines values for ApprovalStatus.
+const (
+	ApprovalStatusApproved ApprovalStatus = "approved"
+	ApprovalStatusExpired  ApprovalStatus = "expired"
+	ApprovalStatusPending  ApprovalStatus = "pending"
+	ApprovalStatusRejected ApprovalStatus = "rejected"
+)
+
+// Defines values for AuditEntryOutcome.
+const (
+	AuditEntryOutcomeDenied  AuditEntryOutcome = "denied"
+	AuditEntryOutcomeFailure AuditEntryOutcome = "failure"
+	AuditEntryOutcomeSuccess AuditEntryOutcome = "success"
+)
+
+// Defines values for CaptureStatus.
+const (
+	CaptureFailed    CaptureStatus = "failed"
+	CaptureRunning   CaptureStatus = "running"
+	CaptureSucceeded CaptureStatus = "succeeded"
+)
+
+// Defines values for CaptureKind.
+const (
+	CaptureCPU   CaptureKind = "cpu"
+	CaptureTrace CaptureKind = "trace"
+)
+
+// Defines values for DiffEntryOp.
+const (
+	Add     DiffEntryOp = "add"
+	Remove  DiffEntryOp = "remove"
+	Replace DiffEntryOp = "replace"
+)
+
+// Defines values for OperationStatus.
+const (
+	OperationStatusFailed    OperationStatus = "failed"
+	OperationStatusPending   OperationStatus = "pending"
+	OperationStatusRunning   OperationStatus = "running"
+	OperationStatusSucceeded OperationStatus = "succeeded"
+)
+
 // Defines values for ReadinessResponseStatus.
 const (
 	NotReady ReadinessResponseStatus = "not_ready"
 	Ready    ReadinessResponseStatus = "ready"
 )
 
+// Defines values for TimelineItemKind.
+const (
+	Audit TimelineItemKind = "audit"
+	Event TimelineItemKind = "event"
+)
+
+// Defines values for UsageReportGroupBy.
+const (
+	UsageReportGroupByCluster   UsageReportGroupBy = "cluster"
+	UsageReportGroupByNamespace UsageReportGroupBy = "namespace"
+	UsageReportGroupByTeam      UsageReportGroupBy = "team"
+)
+
+// Defines values for ReportFormat.
+const (
+	ReportFormatCsv  ReportFormat = "csv"
+	ReportFormatJson ReportFormat = "json"
+)
+
+// Defines values for Workload.
+const (
+	WorkloadDeployments  Workload = "deployments"
+	WorkloadStatefulsets Workload = "statefulsets"
+)
+
+// Defines values for ListApprovalsParamsStatus.
+const (
+	ListApprovalsParamsStatusApproved ListApprovalsParamsStatus = "approved"
+	ListApprovalsParamsStatusExpired  ListApprovalsParamsStatus = "expired"
+	ListApprovalsParamsStatusPending  ListApprovalsParamsStatus = "pending"
+	ListApprovalsParamsStatusRejected ListApprovalsParamsStatus = "rejected"
+)
+
+// Defines values for ListAuditEntriesParamsOutcome.
+const (
+	ListAuditEntriesParamsOutcomeDenied  ListAuditEntriesParamsOutcome = "denied"
+	ListAuditEntriesParamsOutcomeFailure ListAuditEntriesParamsOutcome = "failure"
+	ListAuditEntriesParamsOutcomeSuccess ListAuditEntriesParamsOutcome = "success"
+)
+
+// Defines values for ApplyManifestsParamsDryRun.
+const (
+	Server ApplyManifestsParamsDryRun = "server"
+)
+
+// Defines values for SearchEventsParamsType.
+const (
+	Normal  SearchEventsParamsType = "Normal"
+	Warning SearchEventsParamsType = "Warning"
+)
+
+// Defines values for PauseWorkloadParamsWorkload.
+const (
+	PauseWorkloadParamsWorkloadDeployments  PauseWorkloadParamsWorkload = "deployments"
+	PauseWorkloadParamsWorkloadStatefulsets PauseWorkloadParamsWorkload = "statefulsets"
+)
+
+// Defines values for RestartWorkloadParamsWorkload.
+const (
+	RestartWorkloadParamsWorkloadDeployments  RestartWorkloadParamsWorkload = "deployments"
+	RestartWorkloadParamsWorkloadStatefulsets RestartWorkloadParamsWorkload = "statefulsets"
+)
+
+// Defines values for ResumeWorkloadParamsWorkload.
+const (
+	ResumeWorkloadParamsWorkloadDeployments  ResumeWorkloadParamsWorkload = "deployments"
+	ResumeWorkloadParamsWorkloadStatefulsets ResumeWorkloadParamsWorkload = "statefulsets"
+)
+
+// Defines values for ListWorkloadRevisionsParamsWorkload.
+const (
+	ListWorkloadRevisionsParamsWorkloadDeployments  ListWorkloadRevisionsParamsWorkload = "deployments"
+	ListWorkloadRevisionsParamsWorkloadStatefulsets ListWorkloadRevisionsParamsWorkload = "statefulsets"
+)
+
+// Defines values for RollbackWorkloadParamsWorkload.
+const (
+	RollbackWorkloadParamsWorkloadDeployments  RollbackWorkloadParamsWorkload = "deployments"
+	RollbackWorkloadParamsWorkloadStatefulsets RollbackWorkloadParamsWorkload = "statefulsets"
+)
+
+// Defines values for ScaleWorkloadParamsWorkload.
+const (
+	ScaleWorkloadParamsWorkloadDeployments  ScaleWorkloadParamsWorkload = "deployments"
+	ScaleWorkloadParamsWorkloadStatefulsets ScaleWorkloadParamsWorkload = "statefulsets"
+)
+
+// Defines values for GetWorkloadStatusParamsWorkload.
+const (
+	GetWorkloadStatusParamsWorkloadDeployments  GetWorkloadStatusParamsWorkload = "deployments"
+	GetWorkloadStatusParamsWorkloadStatefulsets GetWorkloadStatusParamsWorkload = "statefulsets"
+)
+
+// Defines values for GetUsageReportParamsGroupBy.
+const (
+	GetUsageReportParamsGroupByCluster   GetUsageReportParamsGroupBy = "cluster"
+	GetUsageReportParamsGroupByNamespace GetUsageReportParamsGroupBy = "namespace"
+	GetUsageReportParamsGroupByTeam      GetUsageReportParamsGroupBy = "team"
+)
+
+// Defines values for GetUsageReportParamsFormat.
+const (
+	GetUsageReportParamsFormatCsv  GetUsageReportParamsFormat = "csv"
+	GetUsageReportParamsFormatJson GetUsageReportParamsFormat = "json"
+)
+
+// Defines values for GetQuotaReportParamsFormat.
+const (
+	GetQuotaReportParamsFormatCsv  GetQuotaReportParamsFormat = "csv"
+	GetQuotaReportParamsFormatJson GetQuotaReportParamsFormat = "json"
+)
+
+// Defines values for GetWorkloadUsageReportParamsFormat.
+const (
+	GetWorkloadUsageReportParamsFormatCsv  GetWorkloadUsageReportParamsFormat = "csv"
+	GetWorkloadUsageReportParamsFormatJson GetWorkloadUsageReportParamsFormat = "json"
+)
+
 // Defines values for SetLogLevelParamsLevel.
 const (
 	Debug SetLogLevelParamsLevel = "debug"
@@ -42,364 +212,8554 @@ const (
 	Text SetLogLevelParamsFormat = "text"
 )
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	// Details Additional error details
-	Details *map[string]interface{} `json:"details,omitempty"`
+// Defines values for GetProfileParamsProfile.
+const (
+	ProfileAllocs       GetProfileParamsProfile = "allocs"
+	ProfileBlock        GetProfileParamsProfile = "block"
+	ProfileGoroutine    GetProfileParamsProfile = "goroutine"
+	ProfileHeap         GetProfileParamsProfile = "heap"
+	ProfileMutex        GetProfileParamsProfile = "mutex"
+	ProfileThreadcreate GetProfileParamsProfile = "threadcreate"
+)
 
-	// Error Error code
-	Error string `json:"error"`
+// ApplyDocumentResult defines model for ApplyDocumentResult.
+type ApplyDocumentResult struct {
+	ApiVersion *string      `json:"apiVersion,omitempty"`
+	Diff       *[]DiffEntry `json:"diff,omitempty"`
 
-	// Message Human-readable error message
-	Message string `json:"message"`
+	// Error Why the document failed
+	Error *string `json:"error,omitempty"`
 
-	// Timestamp Error timestamp
-	Timestamp *time.Time `json:"timestamp,omitempty"`
+	// Index Zero-based position of the document in the request
+	Index     int                       `json:"index"`
+	Kind      *string                   `json:"kind,omitempty"`
+	Name      *string                   `json:"name,omitempty"`
+	Namespace *string                   `json:"namespace,omitempty"`
+	Status    ApplyDocumentResultStatus `json:"status"`
 }
 
-// MetadataPagination defines model for MetadataPagination.
-type MetadataPagination struct {
-	// Cursor Cursor for pagination
-	Cursor int `json:"cursor"`
+// ApplyDocumentResultStatus defines model for ApplyDocumentResult.Status.
+type ApplyDocumentResultStatus string
 
-	// HasMore Whether there are more items to fetch
-	HasMore bool `json:"hasMore"`
+// ApplyResult defines model for ApplyResult.
+type ApplyResult struct {
+	DryRun bool `json:"dryRun"`
+
+	// Failed Number of documents that failed
+	Failed  int                   `json:"failed"`
+	Results []ApplyDocumentResult `json:"results"`
+
+	// Succeeded Number of documents applied successfully
+	Succeeded int `json:"succeeded"`
 }
 
-// ReadinessResponse defines model for ReadinessResponse.
-type ReadinessResponse struct {
-	// Message Human-readable message
-	Message *string `json:"message,omitempty"`
+// Approval defines model for Approval.
+type Approval struct {
+	Comment   *string    `json:"comment,omitempty"`
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+	DecidedBy *string    `json:"decidedBy,omitempty"`
 
-	// Status Readiness status
-	Status ReadinessResponseStatus `json:"status"`
+	// ExpiresAt When the approval expires unless it is decided
+	ExpiresAt time.Time `json:"expiresAt"`
+	Id        string    `json:"id"`
 
-	// Timestamp Timestamp of the readiness check
-	Timestamp time.Time `json:"timestamp"`
+	// OperationId The operation waiting for approval
+	OperationId string    `json:"operationId"`
+	RequestedAt time.Time `json:"requestedAt"`
+	RequestedBy string    `json:"requestedBy"`
 
-	// Version Application version
-	Version string `json:"version"`
-}
+	// Result The response of the operation once it ran
+	Result *ApprovalResult `json:"result,omitempty"`
+	Status ApprovalStatus  `json:"status"`
 
-// ReadinessResponseStatus Readiness status
-type ReadinessResponseStatus string
+	// Summary Method and URI of the request
+	Summary string          `json:"summary"`
+	Target  OperationTarget `json:"target"`
+}
 
-// SetLogLevelParams defines parameters for SetLogLevel.
-type SetLogLevelParams struct {
-	// Level The desired log level. If not provided, the current level is maintained.
-	Level *SetLogLevelParamsLevel `form:"level,omitempty" json:"level,omitempty"`
+// ApprovalStatus defines model for Approval.Status.
+type ApprovalStatus string
 
-	// Format The desired log format. If not provided, the current format is maintained.
-	Format *SetLogLevelParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+// ApprovalDecision defines model for ApprovalDecision.
+type ApprovalDecision struct {
+	// Comment Why the operation was approved or rejected
+	Comment *string `json:"comment,omitempty"`
 }
 
-// SetLogLevelParamsLevel defines parameters for SetLogLevel.
-type SetLogLevelParamsLevel string
+// ApprovalList defines model for ApprovalList.
+type ApprovalList struct {
+	Items []Approval `json:"items"`
+}
 
-// SetLogLevelParamsFormat defines parameters for SetLogLevel.
-type SetLogLevelParamsFormat string
+// ApprovalResult The response of the operation once it ran
+type ApprovalResult struct {
+	// Body JSON body of the response
+	Body interface{} `json:"body,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Sets the log level and format dynamically
-	// (GET /debug/log)
-	SetLogLevel(w http.ResponseWriter, r *http.Request, params SetLogLevelParams)
-	// Readiness check endpoint
-	// (GET /readyz)
-	GetReadiness(w http.ResponseWriter, r *http.Request)
+	// Status HTTP status of the response
+	Status int `json:"status"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action     string                  `json:"action"`
+	Details    *map[string]interface{} `json:"details,omitempty"`
+	DurationMs int64                   `json:"durationMs"`
+	Error      *string                 `json:"error,omitempty"`
+	Id         int64                   `json:"id"`
 
-type Unimplemented struct{}
+	// OperationId API operation that was called
+	OperationId *string           `json:"operationId,omitempty"`
+	Outcome     AuditEntryOutcome `json:"outcome"`
+	Principal   string            `json:"principal"`
+	RequestId   *string           `json:"requestId,omitempty"`
+	SourceIp    *string           `json:"sourceIp,omitempty"`
 
-// Sets the log level and format dynamically
-// (GET /debug/log)
-func (_ Unimplemented) SetLogLevel(w http.ResponseWriter, r *http.Request, params SetLogLevelParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Summary Method and URI of the request
+	Summary *string     `json:"summary,omitempty"`
+	Target  AuditTarget `json:"target"`
+	Time    time.Time   `json:"time"`
 }
 
-// Readiness check endpoint
-// (GET /readyz)
-func (_ Unimplemented) GetReadiness(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// AuditEntryOutcome defines model for AuditEntry.Outcome.
+type AuditEntryOutcome string
+
+// AuditEntryList defines model for AuditEntryList.
+type AuditEntryList struct {
+	Items    []AuditEntry       `json:"items"`
+	Metadata MetadataPagination `json:"metadata"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// AuditTarget defines model for AuditTarget.
+type AuditTarget struct {
+	Cluster   *string `json:"cluster,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+	Resource  *string `json:"resource,omitempty"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// BlockingPod defines model for BlockingPod.
+type BlockingPod struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Reason    string `json:"reason"`
+}
 
-// SetLogLevel operation middleware
-func (siw *ServerInterfaceWrapper) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+// Capture defines model for Capture.
+type Capture struct {
+	DurationSeconds int `json:"durationSeconds"`
 
-	var err error
+	// Error Why the capture failed
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Id         string     `json:"id"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SetLogLevelParams
+	// Kind A CPU profile or an execution trace
+	Kind CaptureKind `json:"kind"`
 
-	// ------------- Optional query parameter "level" -------------
+	// Principal Caller that started the capture
+	Principal string `json:"principal"`
 
-	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
-		return
-	}
+	// Size Size of the recorded data in bytes
+	Size      *int64        `json:"size,omitempty"`
+	StartedAt time.Time     `json:"startedAt"`
+	Status    CaptureStatus `json:"status"`
+}
 
-	// ------------- Optional query parameter "format" -------------
+// CaptureStatus defines model for Capture.Status.
+type CaptureStatus string
 
-	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
-		return
-	}
+// CaptureKind A CPU profile or an execution trace
+type CaptureKind string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetLogLevel(w, r, params)
-	}))
+// CaptureList defines model for CaptureList.
+type CaptureList struct {
+	Items []Capture `json:"items"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// CaptureRequest defines model for CaptureRequest.
+type CaptureRequest struct {
+	// DurationSeconds How long to record
+	DurationSeconds *int `json:"durationSeconds,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Kind A CPU profile or an execution trace
+	Kind CaptureKind `json:"kind"`
 }
 
-// GetReadiness operation middleware
-func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+// ClusterEvent A Kubernetes event collected from a cluster
+type ClusterEvent struct {
+	Cluster string `json:"cluster"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetReadiness(w, r)
-	}))
+	// Count How often the event occurred from firstSeen to lastSeen
+	Count     int       `json:"count"`
+	FirstSeen time.Time `json:"firstSeen"`
+	Id        int64     `json:"id"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// InvolvedObject The object an event is about
+	InvolvedObject InvolvedObject `json:"involvedObject"`
+	LastSeen       time.Time      `json:"lastSeen"`
+	Message        string         `json:"message"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Name Name of the Event object
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Reason    string `json:"reason"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// Source Component that reported the event
+	Source *string `json:"source,omitempty"`
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+	// Type Normal or Warning
+	Type string `json:"type"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+// ClusterEventList defines model for ClusterEventList.
+type ClusterEventList struct {
+	Items    []ClusterEvent     `json:"items"`
+	Metadata MetadataPagination `json:"metadata"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+// ClusterInfo defines model for ClusterInfo.
+type ClusterInfo struct {
+	// Name Name used in cluster paths
+	Name string `json:"name"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+// ClusterList defines model for ClusterList.
+type ClusterList struct {
+	Items []ClusterInfo `json:"items"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// ConfigDump defines model for ConfigDump.
+type ConfigDump struct {
+	Settings []ConfigSetting `json:"settings"`
 }
 
-type RequiredParamError struct {
-	ParamName string
-}
+// ConfigSetting defines model for ConfigSetting.
+type ConfigSetting struct {
+	// Name Section and field of the setting
+	Name string `json:"name"`
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	// Value Value of the setting, or [redacted] for a secret
+	Value string `json:"value"`
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	Attempts int `json:"attempts"`
+
+	// Error Error of the last attempt
+	Error    string            `json:"error"`
+	Event    NotificationEvent `json:"event"`
+	FailedAt time.Time         `json:"failedAt"`
+	Id       string            `json:"id"`
+
+	// Sink Name of the sink the notification was for
+	Sink string `json:"sink"`
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// DeadLetterList defines model for DeadLetterList.
+type DeadLetterList struct {
+	Items []DeadLetter `json:"items"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// DiffEntry defines model for DiffEntry.
+type DiffEntry struct {
+	// After Value after the apply
+	After interface{} `json:"after,omitempty"`
+
+	// Before Live value before the apply
+	Before interface{} `json:"before,omitempty"`
+	Op     DiffEntryOp `json:"op"`
+
+	// Path JSON pointer of the changed field
+	Path string `json:"path"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// DiffEntryOp defines model for DiffEntry.Op.
+type DiffEntryOp string
+
+// DrainProgress defines model for DrainProgress.
+type DrainProgress struct {
+	// Blocking Pods whose eviction was refused in the last attempt
+	Blocking []BlockingPod `json:"blocking"`
+
+	// Evicted Number of pods gone from the node
+	Evicted int    `json:"evicted"`
+	Node    string `json:"node"`
+
+	// Total Number of pods to evict
+	Total int `json:"total"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// DrainRequest defines model for DrainRequest.
+type DrainRequest struct {
+	// DeleteEmptyDirData Also evict pods using emptyDir volumes, losing their data
+	DeleteEmptyDirData *bool `json:"deleteEmptyDirData,omitempty"`
+
+	// Force Also evict pods not managed by a controller; they are not recreated elsewhere
+	Force *bool `json:"force,omitempty"`
+
+	// GracePeriodSeconds Grace period given to each pod. Defaults to the server's configured grace period, or the pod's own when none is configured.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// TimeoutSeconds How long to keep evicting before giving up. Defaults to the server's configured drain timeout. The drain operation runs for this long, whatever the timeout of other operations.
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	// Details Additional error details
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// Error Error code
+	Error string `json:"error"`
+
+	// Message Human-readable error message
+	Message string `json:"message"`
+
+	// RequestId ID of the request, also sent in the X-Request-ID response header. Quote it when reporting a problem.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Timestamp Error timestamp
+	Timestamp *time.Time `json:"timestamp,omitempty"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// GCStats defines model for GCStats.
+type GCStats struct {
+	// CpuFraction Fraction of CPU time used by the garbage collector since the process started
+	CpuFraction float64 `json:"cpuFraction"`
+
+	// LastGC End of the last garbage collection; absent before the first
+	LastGC *time.Time `json:"lastGC,omitempty"`
+
+	// NextGC Heap size at which the next collection starts
+	NextGC       int64 `json:"nextGC"`
+	NumForcedGC  int64 `json:"numForcedGC"`
+	NumGC        int64 `json:"numGC"`
+	PauseTotalNs int64 `json:"pauseTotalNs"`
+
+	// RecentPausesNs Stop-the-world pauses of the latest collections, newest first
+	RecentPausesNs []int64 `json:"recentPausesNs"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// InvolvedObject The object an event is about
+type InvolvedObject struct {
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// FieldPath Part of the object, such as a container of a pod
+	FieldPath *string `json:"fieldPath,omitempty"`
+	Kind      string  `json:"kind"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Uid       *string `json:"uid,omitempty"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// MemoryStats Byte counts and object counts from runtime.MemStats
+type MemoryStats struct {
+	Alloc        int64 `json:"alloc"`
+	Frees        int64 `json:"frees"`
+	HeapAlloc    int64 `json:"heapAlloc"`
+	HeapIdle     int64 `json:"heapIdle"`
+	HeapInuse    int64 `json:"heapInuse"`
+	HeapObjects  int64 `json:"heapObjects"`
+	HeapReleased int64 `json:"heapReleased"`
+	HeapSys      int64 `json:"heapSys"`
+	Mallocs      int64 `json:"mallocs"`
+	StackInuse   int64 `json:"stackInuse"`
+	StackSys     int64 `json:"stackSys"`
+	Sys          int64 `json:"sys"`
+	TotalAlloc   int64 `json:"totalAlloc"`
 }
 
-type ChiServerOptions struct {
+// MetadataPagination defines model for MetadataPagination.
+type MetadataPagination struct {
+	// Cursor Cursor for pagination
+	Cursor int `json:"cursor"`
+
+	// HasMore Whether there are more items to fetch
+	HasMore bool `json:"hasMore"`
+}
+
+// NamespaceTemplate defines model for NamespaceTemplate.
+type NamespaceTemplate struct {
+	Annotations *map[string]string `json:"annotations,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Labels      *map[string]string `json:"labels,omitempty"`
+	Name        string             `json:"name"`
+
+	// RequiresGroups Whether the template binds roles, so that requests must name the team's groups
+	RequiresGroups *bool `json:"requiresGroups,omitempty"`
+
+	// Resources The objects a namespace provisioned from the template starts with
+	Resources []ProvisionedResource `json:"resources"`
+}
+
+// NamespaceTemplateList defines model for NamespaceTemplateList.
+type NamespaceTemplateList struct {
+	Items []NamespaceTemplate `json:"items"`
+}
+
+// NodeSchedulingStatus defines model for NodeSchedulingStatus.
+type NodeSchedulingStatus struct {
+	Cluster       string `json:"cluster"`
+	Name          string `json:"name"`
+	Unschedulable bool   `json:"unschedulable"`
+}
+
+// NotificationEvent defines model for NotificationEvent.
+type NotificationEvent struct {
+	Details *map[string]interface{} `json:"details,omitempty"`
+	Id      string                  `json:"id"`
+
+	// Principal Who caused the event, if anyone
+	Principal *string     `json:"principal,omitempty"`
+	Summary   string      `json:"summary"`
+	Target    AuditTarget `json:"target"`
+	Time      time.Time   `json:"time"`
+	Type      string      `json:"type"`
+}
+
+// Operation defines model for Operation.
+type Operation struct {
+	// Error Why the operation failed
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Id Operation ID
+	Id string `json:"id"`
+
+	// Principal Caller that started the operation
+	Principal string            `json:"principal"`
+	Progress  OperationProgress `json:"progress"`
+	StartedAt time.Time         `json:"startedAt"`
+	Status    OperationStatus   `json:"status"`
+	Target    OperationTarget   `json:"target"`
+
+	// Type Kind of operation, e.g. deployments.scale
+	Type string `json:"type"`
+}
+
+// OperationStatus defines model for Operation.Status.
+type OperationStatus string
+
+// OperationProgress defines model for OperationProgress.
+type OperationProgress struct {
+	Drain   *DrainProgress `json:"drain,omitempty"`
+	Message *string        `json:"message,omitempty"`
+
+	// Percent Completion estimate
+	Percent int            `json:"percent"`
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// OperationTarget defines model for OperationTarget.
+type OperationTarget struct {
+	Cluster   string  `json:"cluster"`
+	Name      *string `json:"name,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+	Resource  *string `json:"resource,omitempty"`
+}
+
+// ProvisionNamespaceRequest defines model for ProvisionNamespaceRequest.
+type ProvisionNamespaceRequest struct {
+	// Annotations Annotations of the namespace, over those of the template
+	Annotations *map[string]string `json:"annotations,omitempty"`
+
+	// Groups Groups of the team, bound to the roles of the template
+	Groups *[]string `json:"groups,omitempty"`
+
+	// Labels Labels of the namespace, over those of the template
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Name Name of the namespace, a DNS label. default and kube-* are reserved.
+	Name string `json:"name"`
+
+	// Team Team owning the namespace, a DNS label
+	Team     string `json:"team"`
+	Template string `json:"template"`
+}
+
+// ProvisionedNamespace defines model for ProvisionedNamespace.
+type ProvisionedNamespace struct {
+	Cluster       string     `json:"cluster"`
+	Groups        []string   `json:"groups"`
+	Name          string     `json:"name"`
+	ProvisionedAt *time.Time `json:"provisionedAt,omitempty"`
+	ProvisionedBy string     `json:"provisionedBy"`
+
+	// Resources Objects created in the namespace, in the order they were created
+	Resources []ProvisionedResource `json:"resources"`
+	Team      string                `json:"team"`
+	Template  string                `json:"template"`
+}
+
+// ProvisionedResource defines model for ProvisionedResource.
+type ProvisionedResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// QuotaReport defines model for QuotaReport.
+type QuotaReport struct {
+	Cluster string             `json:"cluster"`
+	Items   []QuotaUtilization `json:"items"`
+}
+
+// QuotaUtilization One resource of a ResourceQuota
+type QuotaUtilization struct {
+	Hard      string `json:"hard"`
+	Namespace string `json:"namespace"`
+
+	// Percent Percentage of hard used, left out when hard is zero
+	Percent *float64 `json:"percent,omitempty"`
+
+	// Quota Name of the ResourceQuota
+	Quota    string  `json:"quota"`
+	Resource string  `json:"resource"`
+	Team     *string `json:"team,omitempty"`
+	Used     string  `json:"used"`
+}
+
+// ReadinessResponse defines model for ReadinessResponse.
+type ReadinessResponse struct {
+	// Message Human-readable message
+	Message *string `json:"message,omitempty"`
+
+	// Status Readiness status
+	Status ReadinessResponseStatus `json:"status"`
+
+	// Timestamp Timestamp of the readiness check
+	Timestamp time.Time `json:"timestamp"`
+
+	// Version Application version
+	Version string `json:"version"`
+}
+
+// ReadinessResponseStatus Readiness status
+type ReadinessResponseStatus string
+
+// ResourceUsage CPU in millicores and memory in bytes of the running pods of a
+// namespace, team or cluster. The usage is left out without metrics.
+type ResourceUsage struct {
+	CpuLimits      int64   `json:"cpuLimits"`
+	CpuRequests    int64   `json:"cpuRequests"`
+	CpuUsage       *int64  `json:"cpuUsage,omitempty"`
+	MemoryLimits   int64   `json:"memoryLimits"`
+	MemoryRequests int64   `json:"memoryRequests"`
+	MemoryUsage    *int64  `json:"memoryUsage,omitempty"`
+	Namespace      *string `json:"namespace,omitempty"`
+	Pods           int     `json:"pods"`
+
+	// Team Team label of the namespace, or the team grouped by
+	Team *string `json:"team,omitempty"`
+}
+
+// RollbackRequest defines model for RollbackRequest.
+type RollbackRequest struct {
+	// Revision Revision to roll back to, as listed by listWorkloadRevisions
+	Revision int64 `json:"revision"`
+}
+
+// RolloutStatus defines model for RolloutStatus.
+type RolloutStatus struct {
+	AvailableReplicas int32  `json:"availableReplicas"`
+	Cluster           string `json:"cluster"`
+
+	// Complete Whether the latest spec is fully rolled out and available
+	Complete        bool    `json:"complete"`
+	CurrentRevision *string `json:"currentRevision,omitempty"`
+
+	// Failed Whether the rollout exceeded its progress deadline
+	Failed     bool  `json:"failed"`
+	Generation int64 `json:"generation"`
+
+	// Message Human-readable rollout progress
+	Message            string `json:"message"`
+	Name               string `json:"name"`
+	Namespace          string `json:"namespace"`
+	ObservedGeneration int64  `json:"observedGeneration"`
+	Paused             bool   `json:"paused"`
+	ReadyReplicas      int32  `json:"readyReplicas"`
+
+	// Replicas Desired number of replicas
+	Replicas        int32   `json:"replicas"`
+	UpdateRevision  *string `json:"updateRevision,omitempty"`
+	UpdatedReplicas int32   `json:"updatedReplicas"`
+
+	// Workload Workload resource (deployments or statefulsets)
+	Workload string `json:"workload"`
+}
+
+// RuntimeStats defines model for RuntimeStats.
+type RuntimeStats struct {
+	Gc         GCStats `json:"gc"`
+	GoVersion  string  `json:"goVersion"`
+	Gomaxprocs int     `json:"gomaxprocs"`
+	Goroutines int     `json:"goroutines"`
+
+	// Memory Byte counts and object counts from runtime.MemStats
+	Memory MemoryStats `json:"memory"`
+	NumCPU int         `json:"numCPU"`
+
+	// OpenFileDescriptors Open file descriptors of the process; absent where the platform does not expose them
+	OpenFileDescriptors *int `json:"openFileDescriptors,omitempty"`
+
+	// StartedAt When the process started
+	StartedAt time.Time `json:"startedAt"`
+
+	// Threads OS threads created by the runtime
+	Threads int `json:"threads"`
+}
+
+// ScaleRequest defines model for ScaleRequest.
+type ScaleRequest struct {
+	// Replicas Desired number of replicas
+	Replicas int32 `json:"replicas"`
+}
+
+// Timeline defines model for Timeline.
+type Timeline struct {
+	// AuditIncluded Whether audit entries were merged into the timeline
+	AuditIncluded bool `json:"auditIncluded"`
+
+	// HasMore Whether older items were left out by the limit
+	HasMore bool           `json:"hasMore"`
+	Items   []TimelineItem `json:"items"`
+}
+
+// TimelineItem An event or an audit entry, as told by kind
+type TimelineItem struct {
+	AuditEntry *AuditEntry `json:"auditEntry,omitempty"`
+
+	// Event A Kubernetes event collected from a cluster
+	Event *ClusterEvent    `json:"event,omitempty"`
+	Kind  TimelineItemKind `json:"kind"`
+	Time  time.Time        `json:"time"`
+}
+
+// TimelineItemKind defines model for TimelineItem.Kind.
+type TimelineItemKind string
+
+// UsageReport defines model for UsageReport.
+type UsageReport struct {
+	Cluster string             `json:"cluster"`
+	GroupBy UsageReportGroupBy `json:"groupBy"`
+	Items   []ResourceUsage    `json:"items"`
+
+	// MetricsAvailable Whether actual usage was read from metrics.k8s.io
+	MetricsAvailable bool `json:"metricsAvailable"`
+}
+
+// UsageReportGroupBy defines model for UsageReport.GroupBy.
+type UsageReportGroupBy string
+
+// WorkloadRevision defines model for WorkloadRevision.
+type WorkloadRevision struct {
+	// ChangeCause Value of the kubernetes.io/change-cause annotation
+	ChangeCause *string   `json:"changeCause,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+
+	// Current Whether this is the revision the workload is rolled out to
+	Current  bool     `json:"current"`
+	Images   []string `json:"images"`
+	Revision int64    `json:"revision"`
+}
+
+// WorkloadRevisionList defines model for WorkloadRevisionList.
+type WorkloadRevisionList struct {
+	Items []WorkloadRevision `json:"items"`
+}
+
+// WorkloadUsage CPU in millicores and memory in bytes of the running pods of a
+// workload. The usage is left out without metrics.
+type WorkloadUsage struct {
+	CpuLimits       int64   `json:"cpuLimits"`
+	CpuRequests     int64   `json:"cpuRequests"`
+	CpuUsage        *int64  `json:"cpuUsage,omitempty"`
+	Kind            string  `json:"kind"`
+	MemoryLimits    int64   `json:"memoryLimits"`
+	MemoryRequests  int64   `json:"memoryRequests"`
+	MemoryUsage     *int64  `json:"memoryUsage,omitempty"`
+	Name            string  `json:"name"`
+	Namespace       string  `json:"namespace"`
+	OverProvisioned bool    `json:"overProvisioned"`
+	Pods            int     `json:"pods"`
+	Team            *string `json:"team,omitempty"`
+}
+
+// WorkloadUsageReport defines model for WorkloadUsageReport.
+type WorkloadUsageReport struct {
+	Cluster string          `json:"cluster"`
+	Items   []WorkloadUsage `json:"items"`
+
+	// MetricsAvailable Whether actual usage was read from metrics.k8s.io
+	MetricsAvailable bool    `json:"metricsAvailable"`
+	Threshold        float64 `json:"threshold"`
+}
+
+// ApprovalId defines model for ApprovalId.
+type ApprovalId = string
+
+// CaptureID defines model for CaptureID.
+type CaptureID = string
+
+// Cluster defines model for Cluster.
+type Cluster = string
+
+// Container defines model for Container.
+type Container = string
+
+// DeadLetterID defines model for DeadLetterID.
+type DeadLetterID = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// LabelSelector defines model for LabelSelector.
+type LabelSelector = string
+
+// LastEventID defines model for LastEventID.
+type LastEventID = string
+
+// Name defines model for Name.
+type Name = string
+
+// Namespace defines model for Namespace.
+type Namespace = string
+
+// Node defines model for Node.
+type Node = string
+
+// Pod defines model for Pod.
+type Pod = string
+
+// ReportFormat defines model for ReportFormat.
+type ReportFormat string
+
+// Resource defines model for Resource.
+type Resource = string
+
+// Stdin defines model for Stdin.
+type Stdin = bool
+
+// TimelineLimit defines model for TimelineLimit.
+type TimelineLimit = int
+
+// TimelineSince defines model for TimelineSince.
+type TimelineSince = time.Time
+
+// TimelineUntil defines model for TimelineUntil.
+type TimelineUntil = time.Time
+
+// Tty defines model for Tty.
+type Tty = bool
+
+// UsageNamespace defines model for UsageNamespace.
+type UsageNamespace = string
+
+// UsageTeam defines model for UsageTeam.
+type UsageTeam = string
+
+// Workload defines model for Workload.
+type Workload string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// Conflict defines model for Conflict.
+type Conflict = ErrorResponse
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// GatewayTimeout defines model for GatewayTimeout.
+type GatewayTimeout = ErrorResponse
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// NotFound defines model for NotFound.
+type NotFound = ErrorResponse
+
+// NotImplemented defines model for NotImplemented.
+type NotImplemented = ErrorResponse
+
+// OperationAccepted defines model for OperationAccepted.
+type OperationAccepted = Operation
+
+// Overloaded defines model for Overloaded.
+type Overloaded = ErrorResponse
+
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = ErrorResponse
+
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = ErrorResponse
+
+// ServiceUnavailable defines model for ServiceUnavailable.
+type ServiceUnavailable = ErrorResponse
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// ListApprovalsParams defines parameters for ListApprovals.
+type ListApprovalsParams struct {
+	// Status Only list approvals in this state
+	Status *ListApprovalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of approvals to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListApprovalsParamsStatus defines parameters for ListApprovals.
+type ListApprovalsParamsStatus string
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	// Cursor Cursor of the previous page
+	Cursor *int64 `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of entries to return
+	Limit     *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Principal *string `form:"principal,omitempty" json:"principal,omitempty"`
+	Cluster   *string `form:"cluster,omitempty" json:"cluster,omitempty"`
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Action Action such as deployments.scale
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// OperationId API operation that was called
+	OperationId *string                        `form:"operationId,omitempty" json:"operationId,omitempty"`
+	Outcome     *ListAuditEntriesParamsOutcome `form:"outcome,omitempty" json:"outcome,omitempty"`
+
+	// Since Only entries recorded at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only entries recorded before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// ListAuditEntriesParamsOutcome defines parameters for ListAuditEntries.
+type ListAuditEntriesParamsOutcome string
+
+// ApplyManifestsParams defines parameters for ApplyManifests.
+type ApplyManifestsParams struct {
+	// Namespace Namespace for namespaced objects that do not set one
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// FieldManager Field manager recorded in managedFields. Defaults to the server's configured manager.
+	FieldManager *string `form:"fieldManager,omitempty" json:"fieldManager,omitempty"`
+
+	// Force Take ownership of fields owned by other field managers instead of failing with a conflict
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// DryRun Set to "server" to validate and diff without persisting
+	DryRun *ApplyManifestsParamsDryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ApplyManifestsParamsDryRun defines parameters for ApplyManifests.
+type ApplyManifestsParamsDryRun string
+
+// SearchEventsParams defines parameters for SearchEvents.
+type SearchEventsParams struct {
+	// Namespace Namespace of the events. Events about cluster-scoped objects are usually in default.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Kind Kind of the object the events are about, such as Pod
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// Name Name of the object the events are about
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Reason Reason such as BackOff or FailedScheduling
+	Reason *string                 `form:"reason,omitempty" json:"reason,omitempty"`
+	Type   *SearchEventsParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Since Only events that last occurred at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only events that first occurred before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Cursor Cursor of the previous page
+	Cursor *int64 `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of events to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SearchEventsParamsType defines parameters for SearchEvents.
+type SearchEventsParamsType string
+
+// GetPodLogsParams defines parameters for GetPodLogs.
+type GetPodLogsParams struct {
+	// Container Container name. Defaults to the pod's only or default container.
+	Container *Container `form:"container,omitempty" json:"container,omitempty"`
+
+	// Follow Stream new log lines as they are written
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// TailLines Number of lines from the end of the log to start with
+	TailLines *int64 `form:"tailLines,omitempty" json:"tailLines,omitempty"`
+
+	// SinceSeconds Only return lines newer than this many seconds
+	SinceSeconds *int64 `form:"sinceSeconds,omitempty" json:"sinceSeconds,omitempty"`
+
+	// Timestamps Prefix every line with its RFC 3339 timestamp
+	Timestamps *bool `form:"timestamps,omitempty" json:"timestamps,omitempty"`
+
+	// Previous Return the log of the previous, terminated container instance
+	Previous *bool `form:"previous,omitempty" json:"previous,omitempty"`
+}
+
+// PauseWorkloadParams defines parameters for PauseWorkload.
+type PauseWorkloadParams struct {
+	// IfMatch ETag of the workload from getWorkloadStatus. The change is only
+	// made if the workload is still at that version; `*` matches any.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PauseWorkloadParamsWorkload defines parameters for PauseWorkload.
+type PauseWorkloadParamsWorkload string
+
+// RestartWorkloadParams defines parameters for RestartWorkload.
+type RestartWorkloadParams struct {
+	// IfMatch ETag of the workload from getWorkloadStatus. The change is only
+	// made if the workload is still at that version; `*` matches any.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RestartWorkloadParamsWorkload defines parameters for RestartWorkload.
+type RestartWorkloadParamsWorkload string
+
+// ResumeWorkloadParams defines parameters for ResumeWorkload.
+type ResumeWorkloadParams struct {
+	// IfMatch ETag of the workload from getWorkloadStatus. The change is only
+	// made if the workload is still at that version; `*` matches any.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ResumeWorkloadParamsWorkload defines parameters for ResumeWorkload.
+type ResumeWorkloadParamsWorkload string
+
+// ListWorkloadRevisionsParamsWorkload defines parameters for ListWorkloadRevisions.
+type ListWorkloadRevisionsParamsWorkload string
+
+// RollbackWorkloadParams defines parameters for RollbackWorkload.
+type RollbackWorkloadParams struct {
+	// IfMatch ETag of the workload from getWorkloadStatus. The change is only
+	// made if the workload is still at that version; `*` matches any.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RollbackWorkloadParamsWorkload defines parameters for RollbackWorkload.
+type RollbackWorkloadParamsWorkload string
+
+// ScaleWorkloadParams defines parameters for ScaleWorkload.
+type ScaleWorkloadParams struct {
+	// IfMatch ETag of the workload from getWorkloadStatus. The change is only
+	// made if the workload is still at that version; `*` matches any.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ScaleWorkloadParamsWorkload defines parameters for ScaleWorkload.
+type ScaleWorkloadParamsWorkload string
+
+// GetWorkloadStatusParamsWorkload defines parameters for GetWorkloadStatus.
+type GetWorkloadStatusParamsWorkload string
+
+// GetNamespacedTimelineParams defines parameters for GetNamespacedTimeline.
+type GetNamespacedTimelineParams struct {
+	// Since Only items at or after this time
+	Since *TimelineSince `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only items before this time
+	Until *TimelineUntil `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *TimelineLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTimelineParams defines parameters for GetTimeline.
+type GetTimelineParams struct {
+	// Since Only items at or after this time
+	Since *TimelineSince `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only items before this time
+	Until *TimelineUntil `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *TimelineLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsageReportParams defines parameters for GetUsageReport.
+type GetUsageReportParams struct {
+	// Namespace Only report on this namespace
+	Namespace *UsageNamespace `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Team Only report on the namespaces of this team, as told by their iu-k8s.linecorp.com/team label
+	Team *UsageTeam `form:"team,omitempty" json:"team,omitempty"`
+
+	// GroupBy Whether to report per namespace, per team or for the whole cluster
+	GroupBy *GetUsageReportParamsGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// Format Return the report as JSON, or its items as CSV with a header row
+	Format *GetUsageReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetUsageReportParamsGroupBy defines parameters for GetUsageReport.
+type GetUsageReportParamsGroupBy string
+
+// GetUsageReportParamsFormat defines parameters for GetUsageReport.
+type GetUsageReportParamsFormat string
+
+// GetQuotaReportParams defines parameters for GetQuotaReport.
+type GetQuotaReportParams struct {
+	// Namespace Only report on this namespace
+	Namespace *UsageNamespace `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Team Only report on the namespaces of this team, as told by their iu-k8s.linecorp.com/team label
+	Team *UsageTeam `form:"team,omitempty" json:"team,omitempty"`
+
+	// Format Return the report as JSON, or its items as CSV with a header row
+	Format *GetQuotaReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetQuotaReportParamsFormat defines parameters for GetQuotaReport.
+type GetQuotaReportParamsFormat string
+
+// GetWorkloadUsageReportParams defines parameters for GetWorkloadUsageReport.
+type GetWorkloadUsageReportParams struct {
+	// Namespace Only report on this namespace
+	Namespace *UsageNamespace `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Team Only report on the namespaces of this team, as told by their iu-k8s.linecorp.com/team label
+	Team *UsageTeam `form:"team,omitempty" json:"team,omitempty"`
+
+	// Threshold Fraction of its requests below which a workload is over-provisioned
+	Threshold *float64 `form:"threshold,omitempty" json:"threshold,omitempty"`
+
+	// OverProvisioned Only list the over-provisioned workloads
+	OverProvisioned *bool `form:"overProvisioned,omitempty" json:"overProvisioned,omitempty"`
+
+	// Format Return the report as JSON, or its items as CSV with a header row
+	Format *GetWorkloadUsageReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetWorkloadUsageReportParamsFormat defines parameters for GetWorkloadUsageReport.
+type GetWorkloadUsageReportParamsFormat string
+
+// WatchNamespacedResourcesParams defines parameters for WatchNamespacedResources.
+type WatchNamespacedResourcesParams struct {
+	// LabelSelector Kubernetes label selector restricting the returned objects
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// LastEventID resourceVersion of the last event received, to resume a stream
+	LastEventID *LastEventID `json:"Last-Event-ID,omitempty"`
+}
+
+// WatchResourcesParams defines parameters for WatchResources.
+type WatchResourcesParams struct {
+	// LabelSelector Kubernetes label selector restricting the returned objects
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// LastEventID resourceVersion of the last event received, to resume a stream
+	LastEventID *LastEventID `json:"Last-Event-ID,omitempty"`
+}
+
+// GetOperationParams defines parameters for GetOperation.
+type GetOperationParams struct {
+	// WaitSeconds Seconds to wait for the operation to finish before responding
+	WaitSeconds *int `form:"waitSeconds,omitempty" json:"waitSeconds,omitempty"`
+}
+
+// SetLogLevelParams defines parameters for SetLogLevel.
+type SetLogLevelParams struct {
+	// Level The desired log level. If not provided, the current level is maintained.
+	Level *SetLogLevelParamsLevel `form:"level,omitempty" json:"level,omitempty"`
+
+	// Format The desired log format. If not provided, the current format is maintained.
+	Format *SetLogLevelParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// SetLogLevelParamsLevel defines parameters for SetLogLevel.
+type SetLogLevelParamsLevel string
+
+// SetLogLevelParamsFormat defines parameters for SetLogLevel.
+type SetLogLevelParamsFormat string
+
+// GetProfileParams defines parameters for GetProfile.
+type GetProfileParams struct {
+	// Debug Return the profile as text instead of in the protocol buffer format; 2 dumps goroutines with full stacks
+	Debug *int `form:"debug,omitempty" json:"debug,omitempty"`
+
+	// Gc Run a garbage collection before taking a heap profile
+	Gc *bool `form:"gc,omitempty" json:"gc,omitempty"`
+}
+
+// GetProfileParamsProfile defines parameters for GetProfile.
+type GetProfileParamsProfile string
+
+// ListDeadLettersParams defines parameters for ListDeadLetters.
+type ListDeadLettersParams struct {
+	// Limit Maximum number of dead letters to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ApproveOperationJSONRequestBody defines body for ApproveOperation for application/json ContentType.
+type ApproveOperationJSONRequestBody = ApprovalDecision
+
+// RejectOperationJSONRequestBody defines body for RejectOperation for application/json ContentType.
+type RejectOperationJSONRequestBody = ApprovalDecision
+
+// ProvisionNamespaceJSONRequestBody defines body for ProvisionNamespace for application/json ContentType.
+type ProvisionNamespaceJSONRequestBody = ProvisionNamespaceRequest
+
+// RollbackWorkloadJSONRequestBody defines body for RollbackWorkload for application/json ContentType.
+type RollbackWorkloadJSONRequestBody = RollbackRequest
+
+// ScaleWorkloadJSONRequestBody defines body for ScaleWorkload for application/json ContentType.
+type ScaleWorkloadJSONRequestBody = ScaleRequest
+
+// DrainNodeJSONRequestBody defines body for DrainNode for application/json ContentType.
+type DrainNodeJSONRequestBody = DrainRequest
+
+// StartCaptureJSONRequestBody defines body for StartCapture for application/json ContentType.
+type StartCaptureJSONRequestBody = CaptureRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List approvals
+	// (GET /api/v1/approvals)
+	ListApprovals(w http.ResponseWriter, r *http.Request, params ListApprovalsParams)
+	// Get an approval
+	// (GET /api/v1/approvals/{approvalId})
+	GetApproval(w http.ResponseWriter, r *http.Request, approvalId ApprovalId)
+	// Approve an operation
+	// (POST /api/v1/approvals/{approvalId}/approve)
+	ApproveOperation(w http.ResponseWriter, r *http.Request, approvalId ApprovalId)
+	// Reject an operation
+	// (POST /api/v1/approvals/{approvalId}/reject)
+	RejectOperation(w http.ResponseWriter, r *http.Request, approvalId ApprovalId)
+	// Query the audit trail
+	// (GET /api/v1/audit)
+	ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams)
+	// List registered clusters
+	// (GET /api/v1/clusters)
+	ListClusters(w http.ResponseWriter, r *http.Request)
+	// Apply Kubernetes manifests with server-side apply
+	// (POST /api/v1/clusters/{cluster}/apply)
+	ApplyManifests(w http.ResponseWriter, r *http.Request, cluster Cluster, params ApplyManifestsParams)
+	// Search collected Kubernetes events
+	// (GET /api/v1/clusters/{cluster}/events)
+	SearchEvents(w http.ResponseWriter, r *http.Request, cluster Cluster, params SearchEventsParams)
+	// Provision a namespace
+	// (POST /api/v1/clusters/{cluster}/namespaces)
+	ProvisionNamespace(w http.ResponseWriter, r *http.Request, cluster Cluster)
+	// Deprovision a namespace
+	// (DELETE /api/v1/clusters/{cluster}/namespaces/{namespace})
+	DeprovisionNamespace(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace)
+	// Read the log of a container
+	// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/log)
+	GetPodLogs(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, pod Pod, params GetPodLogsParams)
+	// Get the provisioning record of a namespace
+	// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/provisioning)
+	GetNamespaceProvisioning(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace)
+	// Pause the rollout of a workload
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/pause)
+	PauseWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload PauseWorkloadParamsWorkload, name Name, params PauseWorkloadParams)
+	// Restart a workload
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/restart)
+	RestartWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload RestartWorkloadParamsWorkload, name Name, params RestartWorkloadParams)
+	// Resume the rollout of a workload
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/resume)
+	ResumeWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ResumeWorkloadParamsWorkload, name Name, params ResumeWorkloadParams)
+	// List the rollout history of a workload
+	// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/revisions)
+	ListWorkloadRevisions(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ListWorkloadRevisionsParamsWorkload, name Name)
+	// Roll a workload back to a revision
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/rollback)
+	RollbackWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload RollbackWorkloadParamsWorkload, name Name, params RollbackWorkloadParams)
+	// Scale a workload
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/scale)
+	ScaleWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ScaleWorkloadParamsWorkload, name Name, params ScaleWorkloadParams)
+	// Get the rollout status of a workload
+	// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/status)
+	GetWorkloadStatus(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload GetWorkloadStatusParamsWorkload, name Name)
+	// Cordon a node
+	// (POST /api/v1/clusters/{cluster}/nodes/{node}/cordon)
+	CordonNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node)
+	// Drain a node
+	// (POST /api/v1/clusters/{cluster}/nodes/{node}/drain)
+	DrainNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node)
+	// Uncordon a node
+	// (POST /api/v1/clusters/{cluster}/nodes/{node}/uncordon)
+	UncordonNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node)
+	// Timeline of an object in a namespace
+	// (GET /api/v1/clusters/{cluster}/timeline/namespaces/{namespace}/{resource}/{name})
+	GetNamespacedTimeline(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, resource Resource, name Name, params GetNamespacedTimelineParams)
+	// Timeline of a cluster-scoped object
+	// (GET /api/v1/clusters/{cluster}/timeline/{resource}/{name})
+	GetTimeline(w http.ResponseWriter, r *http.Request, cluster Cluster, resource Resource, name Name, params GetTimelineParams)
+	// Report CPU and memory usage
+	// (GET /api/v1/clusters/{cluster}/usage)
+	GetUsageReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetUsageReportParams)
+	// Report ResourceQuota utilisation
+	// (GET /api/v1/clusters/{cluster}/usage/quotas)
+	GetQuotaReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetQuotaReportParams)
+	// Report workload usage and over-provisioning
+	// (GET /api/v1/clusters/{cluster}/usage/workloads)
+	GetWorkloadUsageReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetWorkloadUsageReportParams)
+	// Stream changes of a resource in a namespace
+	// (GET /api/v1/clusters/{cluster}/watch/namespaces/{namespace}/{resource})
+	WatchNamespacedResources(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, resource Resource, params WatchNamespacedResourcesParams)
+	// Stream changes of a resource across all namespaces
+	// (GET /api/v1/clusters/{cluster}/watch/{resource})
+	WatchResources(w http.ResponseWriter, r *http.Request, cluster Cluster, resource Resource, params WatchResourcesParams)
+	// List namespace templates
+	// (GET /api/v1/namespace-templates)
+	ListNamespaceTemplates(w http.ResponseWriter, r *http.Request)
+	// Get a long-running operation
+	// (GET /api/v1/operations/{operationId})
+	GetOperation(w http.ResponseWriter, r *http.Request, operationId string, params GetOperationParams)
+	// List captures
+	// (GET /debug/captures)
+	ListCaptures(w http.ResponseWriter, r *http.Request)
+	// Start a capture
+	// (POST /debug/captures)
+	StartCapture(w http.ResponseWriter, r *http.Request)
+	// Show a capture
+	// (GET /debug/captures/{captureId})
+	GetCapture(w http.ResponseWriter, r *http.Request, captureId CaptureID)
+	// Download a capture
+	// (GET /debug/captures/{captureId}/download)
+	DownloadCapture(w http.ResponseWriter, r *http.Request, captureId CaptureID)
+	// Show the effective configuration
+	// (GET /debug/config)
+	GetConfig(w http.ResponseWriter, r *http.Request)
+	// Dump the stacks of all goroutines
+	// (GET /debug/goroutines)
+	GetGoroutineDump(w http.ResponseWriter, r *http.Request)
+	// Sets the log level and format dynamically
+	// (GET /debug/log)
+	SetLogLevel(w http.ResponseWriter, r *http.Request, params SetLogLevelParams)
+	// Download a runtime profile
+	// (GET /debug/pprof/{profile})
+	GetProfile(w http.ResponseWriter, r *http.Request, profile GetProfileParamsProfile, params GetProfileParams)
+	// Show runtime statistics
+	// (GET /debug/runtime)
+	GetRuntimeStats(w http.ResponseWriter, r *http.Request)
+	// List dead letters
+	// (GET /notifications/dead-letters)
+	ListDeadLetters(w http.ResponseWriter, r *http.Request, params ListDeadLettersParams)
+	// Retry a dead letter
+	// (POST /notifications/dead-letters/{deadLetterId}/retry)
+	RetryDeadLetter(w http.ResponseWriter, r *http.Request, deadLetterId DeadLetterID)
+	// Readiness check endpoint
+	// (GET /readyz)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// List approvals
+// (GET /api/v1/approvals)
+func (_ Unimplemented) ListApprovals(w http.ResponseWriter, r *http.Request, params ListApprovalsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an approval
+// (GET /api/v1/approvals/{approvalId})
+func (_ Unimplemented) GetApproval(w http.ResponseWriter, r *http.Request, approvalId ApprovalId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve an operation
+// (POST /api/v1/approvals/{approvalId}/approve)
+func (_ Unimplemented) ApproveOperation(w http.ResponseWriter, r *http.Request, approvalId ApprovalId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reject an operation
+// (POST /api/v1/approvals/{approvalId}/reject)
+func (_ Unimplemented) RejectOperation(w http.ResponseWriter, r *http.Request, approvalId ApprovalId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Query the audit trail
+// (GET /api/v1/audit)
+func (_ Unimplemented) ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List registered clusters
+// (GET /api/v1/clusters)
+func (_ Unimplemented) ListClusters(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Apply Kubernetes manifests with server-side apply
+// (POST /api/v1/clusters/{cluster}/apply)
+func (_ Unimplemented) ApplyManifests(w http.ResponseWriter, r *http.Request, cluster Cluster, params ApplyManifestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search collected Kubernetes events
+// (GET /api/v1/clusters/{cluster}/events)
+func (_ Unimplemented) SearchEvents(w http.ResponseWriter, r *http.Request, cluster Cluster, params SearchEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Provision a namespace
+// (POST /api/v1/clusters/{cluster}/namespaces)
+func (_ Unimplemented) ProvisionNamespace(w http.ResponseWriter, r *http.Request, cluster Cluster) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Deprovision a namespace
+// (DELETE /api/v1/clusters/{cluster}/namespaces/{namespace})
+func (_ Unimplemented) DeprovisionNamespace(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read the log of a container
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/log)
+func (_ Unimplemented) GetPodLogs(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, pod Pod, params GetPodLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the provisioning record of a namespace
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/provisioning)
+func (_ Unimplemented) GetNamespaceProvisioning(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Pause the rollout of a workload
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/pause)
+func (_ Unimplemented) PauseWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload PauseWorkloadParamsWorkload, name Name, params PauseWorkloadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restart a workload
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/restart)
+func (_ Unimplemented) RestartWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload RestartWorkloadParamsWorkload, name Name, params RestartWorkloadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume the rollout of a workload
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/resume)
+func (_ Unimplemented) ResumeWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ResumeWorkloadParamsWorkload, name Name, params ResumeWorkloadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the rollout history of a workload
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/revisions)
+func (_ Unimplemented) ListWorkloadRevisions(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ListWorkloadRevisionsParamsWorkload, name Name) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Roll a workload back to a revision
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/rollback)
+func (_ Unimplemented) RollbackWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload RollbackWorkloadParamsWorkload, name Name, params RollbackWorkloadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Scale a workload
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/scale)
+func (_ Unimplemented) ScaleWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ScaleWorkloadParamsWorkload, name Name, params ScaleWorkloadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the rollout status of a workload
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/status)
+func (_ Unimplemented) GetWorkloadStatus(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload GetWorkloadStatusParamsWorkload, name Name) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cordon a node
+// (POST /api/v1/clusters/{cluster}/nodes/{node}/cordon)
+func (_ Unimplemented) CordonNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Drain a node
+// (POST /api/v1/clusters/{cluster}/nodes/{node}/drain)
+func (_ Unimplemented) DrainNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Uncordon a node
+// (POST /api/v1/clusters/{cluster}/nodes/{node}/uncordon)
+func (_ Unimplemented) UncordonNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Timeline of an object in a namespace
+// (GET /api/v1/clusters/{cluster}/timeline/namespaces/{namespace}/{resource}/{name})
+func (_ Unimplemented) GetNamespacedTimeline(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, resource Resource, name Name, params GetNamespacedTimelineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Timeline of a cluster-scoped object
+// (GET /api/v1/clusters/{cluster}/timeline/{resource}/{name})
+func (_ Unimplemented) GetTimeline(w http.ResponseWriter, r *http.Request, cluster Cluster, resource Resource, name Name, params GetTimelineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report CPU and memory usage
+// (GET /api/v1/clusters/{cluster}/usage)
+func (_ Unimplemented) GetUsageReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetUsageReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report ResourceQuota utilisation
+// (GET /api/v1/clusters/{cluster}/usage/quotas)
+func (_ Unimplemented) GetQuotaReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetQuotaReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report workload usage and over-provisioning
+// (GET /api/v1/clusters/{cluster}/usage/workloads)
+func (_ Unimplemented) GetWorkloadUsageReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetWorkloadUsageReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream changes of a resource in a namespace
+// (GET /api/v1/clusters/{cluster}/watch/namespaces/{namespace}/{resource})
+func (_ Unimplemented) WatchNamespacedResources(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, resource Resource, params WatchNamespacedResourcesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream changes of a resource across all namespaces
+// (GET /api/v1/clusters/{cluster}/watch/{resource})
+func (_ Unimplemented) WatchResources(w http.ResponseWriter, r *http.Request, cluster Cluster, resource Resource, params WatchResourcesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List namespace templates
+// (GET /api/v1/namespace-templates)
+func (_ Unimplemented) ListNamespaceTemplates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a long-running operation
+// (GET /api/v1/operations/{operationId})
+func (_ Unimplemented) GetOperation(w http.ResponseWriter, r *http.Request, operationId string, params GetOperationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List captures
+// (GET /debug/captures)
+func (_ Unimplemented) ListCaptures(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a capture
+// (POST /debug/captures)
+func (_ Unimplemented) StartCapture(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Show a capture
+// (GET /debug/captures/{captureId})
+func (_ Unimplemented) GetCapture(w http.ResponseWriter, r *http.Request, captureId CaptureID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a capture
+// (GET /debug/captures/{captureId}/download)
+func (_ Unimplemented) DownloadCapture(w http.ResponseWriter, r *http.Request, captureId CaptureID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Show the effective configuration
+// (GET /debug/config)
+func (_ Unimplemented) GetConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dump the stacks of all goroutines
+// (GET /debug/goroutines)
+func (_ Unimplemented) GetGoroutineDump(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sets the log level and format dynamically
+// (GET /debug/log)
+func (_ Unimplemented) SetLogLevel(w http.ResponseWriter, r *http.Request, params SetLogLevelParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a runtime profile
+// (GET /debug/pprof/{profile})
+func (_ Unimplemented) GetProfile(w http.ResponseWriter, r *http.Request, profile GetProfileParamsProfile, params GetProfileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Show runtime statistics
+// (GET /debug/runtime)
+func (_ Unimplemented) GetRuntimeStats(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List dead letters
+// (GET /notifications/dead-letters)
+func (_ Unimplemented) ListDeadLetters(w http.ResponseWriter, r *http.Request, params ListDeadLettersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Retry a dead letter
+// (POST /notifications/dead-letters/{deadLetterId}/retry)
+func (_ Unimplemented) RetryDeadLetter(w http.ResponseWriter, r *http.Request, deadLetterId DeadLetterID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Readiness check endpoint
+// (GET /readyz)
+func (_ Unimplemented) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListApprovals operation middleware
+func (siw *ServerInterfaceWrapper) ListApprovals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApprovalsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApprovals(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApproval operation middleware
+func (siw *ServerInterfaceWrapper) GetApproval(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "approvalId" -------------
+	var approvalId ApprovalId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "approvalId", chi.URLParam(r, "approvalId"), &approvalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "approvalId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApproval(w, r, approvalId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveOperation operation middleware
+func (siw *ServerInterfaceWrapper) ApproveOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "approvalId" -------------
+	var approvalId ApprovalId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "approvalId", chi.URLParam(r, "approvalId"), &approvalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "approvalId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveOperation(w, r, approvalId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectOperation operation middleware
+func (siw *ServerInterfaceWrapper) RejectOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "approvalId" -------------
+	var approvalId ApprovalId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "approvalId", chi.URLParam(r, "approvalId"), &approvalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "approvalId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectOperation(w, r, approvalId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAuditEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEntriesParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "principal" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "principal", r.URL.Query(), &params.Principal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "principal", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cluster" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cluster", r.URL.Query(), &params.Cluster)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "operationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operationId", r.URL.Query(), &params.OperationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operationId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "outcome" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "outcome", r.URL.Query(), &params.Outcome)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "outcome", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditEntries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListClusters operation middleware
+func (siw *ServerInterfaceWrapper) ListClusters(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClusters(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyManifests operation middleware
+func (siw *ServerInterfaceWrapper) ApplyManifests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApplyManifestsParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldManager" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldManager", r.URL.Query(), &params.FieldManager)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldManager", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyManifests(w, r, cluster, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchEvents operation middleware
+func (siw *ServerInterfaceWrapper) SearchEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchEventsParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", r.URL.Query(), &params.Reason)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reason", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchEvents(w, r, cluster, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProvisionNamespace operation middleware
+func (siw *ServerInterfaceWrapper) ProvisionNamespace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProvisionNamespace(w, r, cluster)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeprovisionNamespace operation middleware
+func (siw *ServerInterfaceWrapper) DeprovisionNamespace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeprovisionNamespace(w, r, cluster, namespace)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPodLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetPodLogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "pod" -------------
+	var pod Pod
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pod", chi.URLParam(r, "pod"), &pod, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pod", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPodLogsParams
+
+	// ------------- Optional query parameter "container" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "container", r.URL.Query(), &params.Container)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "container", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tailLines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tailLines", r.URL.Query(), &params.TailLines)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tailLines", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sinceSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sinceSeconds", r.URL.Query(), &params.SinceSeconds)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sinceSeconds", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timestamps" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timestamps", r.URL.Query(), &params.Timestamps)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timestamps", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", r.URL.Query(), &params.Previous)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "previous", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPodLogs(w, r, cluster, namespace, pod, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNamespaceProvisioning operation middleware
+func (siw *ServerInterfaceWrapper) GetNamespaceProvisioning(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNamespaceProvisioning(w, r, cluster, namespace)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseWorkload operation middleware
+func (siw *ServerInterfaceWrapper) PauseWorkload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workload" -------------
+	var workload PauseWorkloadParamsWorkload
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workload", chi.URLParam(r, "workload"), &workload, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workload", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PauseWorkloadParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseWorkload(w, r, cluster, namespace, workload, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestartWorkload operation middleware
+func (siw *ServerInterfaceWrapper) RestartWorkload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workload" -------------
+	var workload RestartWorkloadParamsWorkload
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workload", chi.URLParam(r, "workload"), &workload, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workload", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestartWorkloadParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestartWorkload(w, r, cluster, namespace, workload, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeWorkload operation middleware
+func (siw *ServerInterfaceWrapper) ResumeWorkload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workload" -------------
+	var workload ResumeWorkloadParamsWorkload
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workload", chi.URLParam(r, "workload"), &workload, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workload", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResumeWorkloadParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeWorkload(w, r, cluster, namespace, workload, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWorkloadRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkloadRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workload" -------------
+	var workload ListWorkloadRevisionsParamsWorkload
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workload", chi.URLParam(r, "workload"), &workload, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workload", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkloadRevisions(w, r, cluster, namespace, workload, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackWorkload operation middleware
+func (siw *ServerInterfaceWrapper) RollbackWorkload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workload" -------------
+	var workload RollbackWorkloadParamsWorkload
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workload", chi.URLParam(r, "workload"), &workload, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workload", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackWorkloadParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackWorkload(w, r, cluster, namespace, workload, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ScaleWorkload operation middleware
+func (siw *ServerInterfaceWrapper) ScaleWorkload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workload" -------------
+	var workload ScaleWorkloadParamsWorkload
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workload", chi.URLParam(r, "workload"), &workload, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workload", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScaleWorkloadParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScaleWorkload(w, r, cluster, namespace, workload, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkloadStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkloadStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workload" -------------
+	var workload GetWorkloadStatusParamsWorkload
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workload", chi.URLParam(r, "workload"), &workload, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workload", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkloadStatus(w, r, cluster, namespace, workload, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CordonNode operation middleware
+func (siw *ServerInterfaceWrapper) CordonNode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "node" -------------
+	var node Node
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node", chi.URLParam(r, "node"), &node, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CordonNode(w, r, cluster, node)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DrainNode operation middleware
+func (siw *ServerInterfaceWrapper) DrainNode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "node" -------------
+	var node Node
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node", chi.URLParam(r, "node"), &node, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DrainNode(w, r, cluster, node)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UncordonNode operation middleware
+func (siw *ServerInterfaceWrapper) UncordonNode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "node" -------------
+	var node Node
+
+	err = runtime.BindStyledParameterWithOptions("simple", "node", chi.URLParam(r, "node"), &node, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UncordonNode(w, r, cluster, node)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNamespacedTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetNamespacedTimeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "resource" -------------
+	var resource Resource
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resource", chi.URLParam(r, "resource"), &resource, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNamespacedTimelineParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNamespacedTimeline(w, r, cluster, namespace, resource, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "resource" -------------
+	var resource Resource
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resource", chi.URLParam(r, "resource"), &resource, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name Name
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimelineParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTimeline(w, r, cluster, resource, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsageReport operation middleware
+func (siw *ServerInterfaceWrapper) GetUsageReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsageReportParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsageReport(w, r, cluster, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQuotaReport operation middleware
+func (siw *ServerInterfaceWrapper) GetQuotaReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQuotaReportParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuotaReport(w, r, cluster, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkloadUsageReport operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkloadUsageReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkloadUsageReportParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "threshold" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "threshold", r.URL.Query(), &params.Threshold)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "threshold", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "overProvisioned" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overProvisioned", r.URL.Query(), &params.OverProvisioned)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "overProvisioned", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkloadUsageReport(w, r, cluster, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WatchNamespacedResources operation middleware
+func (siw *ServerInterfaceWrapper) WatchNamespacedResources(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", chi.URLParam(r, "namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "resource" -------------
+	var resource Resource
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resource", chi.URLParam(r, "resource"), &resource, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchNamespacedResourcesParams
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WatchNamespacedResources(w, r, cluster, namespace, resource, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WatchResources operation middleware
+func (siw *ServerInterfaceWrapper) WatchResources(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cluster" -------------
+	var cluster Cluster
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cluster", chi.URLParam(r, "cluster"), &cluster, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "resource" -------------
+	var resource Resource
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resource", chi.URLParam(r, "resource"), &resource, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchResourcesParams
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WatchResources(w, r, cluster, resource, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListNamespaceTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListNamespaceTemplates(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNamespaceTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOperation operation middleware
+func (siw *ServerInterfaceWrapper) GetOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "operationId" -------------
+	var operationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operationId", chi.URLParam(r, "operationId"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOperationParams
+
+	// ------------- Optional query parameter "waitSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "waitSeconds", r.URL.Query(), &params.WaitSeconds)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "waitSeconds", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOperation(w, r, operationId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCaptures operation middleware
+func (siw *ServerInterfaceWrapper) ListCaptures(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCaptures(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartCapture operation middleware
+func (siw *ServerInterfaceWrapper) StartCapture(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartCapture(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCapture operation middleware
+func (siw *ServerInterfaceWrapper) GetCapture(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "captureId" -------------
+	var captureId CaptureID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "captureId", chi.URLParam(r, "captureId"), &captureId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "captureId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapture(w, r, captureId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadCapture operation middleware
+func (siw *ServerInterfaceWrapper) DownloadCapture(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "captureId" -------------
+	var captureId CaptureID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "captureId", chi.URLParam(r, "captureId"), &captureId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "captureId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadCapture(w, r, captureId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGoroutineDump operation middleware
+func (siw *ServerInterfaceWrapper) GetGoroutineDump(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGoroutineDump(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetLogLevelParams
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetLogLevel(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetProfile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "profile" -------------
+	var profile GetProfileParamsProfile
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profile", chi.URLParam(r, "profile"), &profile, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "profile", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileParams
+
+	// ------------- Optional query parameter "debug" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "debug", r.URL.Query(), &params.Debug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "debug", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "gc" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gc", r.URL.Query(), &params.Gc)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gc", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProfile(w, r, profile, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRuntimeStats operation middleware
+func (siw *ServerInterfaceWrapper) GetRuntimeStats(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRuntimeStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDeadLettersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDeadLetters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) RetryDeadLetter(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deadLetterId" -------------
+	var deadLetterId DeadLetterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deadLetterId", chi.URLParam(r, "deadLetterId"), &deadLetterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deadLetterId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryDeadLetter(w, r, deadLetterId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
 	BaseURL          string
 	BaseRouter       chi.Router
 	Middlewares      []MiddlewareFunc
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/approvals", wrapper.ListApprovals)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/approvals/{approvalId}", wrapper.GetApproval)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/approvals/{approvalId}/approve", wrapper.ApproveOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/approvals/{approvalId}/reject", wrapper.RejectOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/audit", wrapper.ListAuditEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters", wrapper.ListClusters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/apply", wrapper.ApplyManifests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/events", wrapper.SearchEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces", wrapper.ProvisionNamespace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}", wrapper.DeprovisionNamespace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/log", wrapper.GetPodLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/provisioning", wrapper.GetNamespaceProvisioning)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/pause", wrapper.PauseWorkload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/restart", wrapper.RestartWorkload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/resume", wrapper.ResumeWorkload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/revisions", wrapper.ListWorkloadRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/rollback", wrapper.RollbackWorkload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/scale", wrapper.ScaleWorkload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/status", wrapper.GetWorkloadStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/nodes/{node}/cordon", wrapper.CordonNode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/nodes/{node}/drain", wrapper.DrainNode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/clusters/{cluster}/nodes/{node}/uncordon", wrapper.UncordonNode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/timeline/namespaces/{namespace}/{resource}/{name}", wrapper.GetNamespacedTimeline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/timeline/{resource}/{name}", wrapper.GetTimeline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/usage", wrapper.GetUsageReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/usage/quotas", wrapper.GetQuotaReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/usage/workloads", wrapper.GetWorkloadUsageReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/watch/namespaces/{namespace}/{resource}", wrapper.WatchNamespacedResources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clusters/{cluster}/watch/{resource}", wrapper.WatchResources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/namespace-templates", wrapper.ListNamespaceTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/operations/{operationId}", wrapper.GetOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/debug/captures", wrapper.ListCaptures)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/debug/captures", wrapper.StartCapture)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/debug/captures/{captureId}", wrapper.GetCapture)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/debug/captures/{captureId}/download", wrapper.DownloadCapture)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/debug/config", wrapper.GetConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/debug/goroutines", wrapper.GetGoroutineDump)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/debug/log", wrapper.SetLogLevel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/debug/pprof/{profile}", wrapper.GetProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/debug/runtime", wrapper.GetRuntimeStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/dead-letters", wrapper.ListDeadLetters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/dead-letters/{deadLetterId}/retry", wrapper.RetryDeadLetter)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/readyz", wrapper.GetReadiness)
+	})
+
+	return r
+}
+
+type BadRequestJSONResponse ErrorResponse
+
+type ConflictJSONResponse ErrorResponse
+
+type ForbiddenJSONResponse ErrorResponse
+
+type GatewayTimeoutJSONResponse ErrorResponse
+
+type InternalErrorJSONResponse ErrorResponse
+
+type NotFoundJSONResponse ErrorResponse
+
+type NotImplementedJSONResponse ErrorResponse
+
+type OperationAcceptedResponseHeaders struct {
+	Location string
+}
+type OperationAcceptedJSONResponse struct {
+	Body Operation
+
+	Headers OperationAcceptedResponseHeaders
+}
+
+type OverloadedResponseHeaders struct {
+	RetryAfter int
+}
+type OverloadedJSONResponse struct {
+	Body ErrorResponse
+
+	Headers OverloadedResponseHeaders
+}
+
+type PayloadTooLargeJSONResponse ErrorResponse
+
+type PreconditionFailedJSONResponse ErrorResponse
+
+type ServiceUnavailableJSONResponse ErrorResponse
+
+type TimelineJSONResponse Timeline
+
+type TooManyRequestsResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitPolicy    string
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+type TooManyRequestsJSONResponse struct {
+	Body ErrorResponse
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type UnauthorizedJSONResponse ErrorResponse
+
+type WatchStreamTexteventStreamResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+type ListApprovalsRequestObject struct {
+	Params ListApprovalsParams
+}
+
+type ListApprovalsResponseObject interface {
+	VisitListApprovalsResponse(w http.ResponseWriter) error
+}
+
+type ListApprovals200JSONResponse ApprovalList
+
+func (response ListApprovals200JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApprovals400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListApprovals400JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApprovals401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListApprovals401JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApprovals429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListApprovals429JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListApprovals500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListApprovals500JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApprovals503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ListApprovals503JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListApprovals504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ListApprovals504JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApprovalRequestObject struct {
+	ApprovalId ApprovalId `json:"approvalId"`
+}
+
+type GetApprovalResponseObject interface {
+	VisitGetApprovalResponse(w http.ResponseWriter) error
+}
+
+type GetApproval200JSONResponse Approval
+
+func (response GetApproval200JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApproval401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetApproval401JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApproval403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetApproval403JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApproval404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetApproval404JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApproval429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetApproval429JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApproval500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetApproval500JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApproval503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetApproval503JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApproval504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetApproval504JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOperationRequestObject struct {
+	ApprovalId ApprovalId `json:"approvalId"`
+	Body       *ApproveOperationJSONRequestBody
+}
+
+type ApproveOperationResponseObject interface {
+	VisitApproveOperationResponse(w http.ResponseWriter) error
+}
+
+type ApproveOperation200JSONResponse Approval
+
+func (response ApproveOperation200JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOperation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApproveOperation400JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOperation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApproveOperation401JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOperation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ApproveOperation403JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOperation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApproveOperation404JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOperation409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ApproveOperation409JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOperation429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ApproveOperation429JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ApproveOperation500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ApproveOperation500JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOperation503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ApproveOperation503JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ApproveOperation504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ApproveOperation504JSONResponse) VisitApproveOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectOperationRequestObject struct {
+	ApprovalId ApprovalId `json:"approvalId"`
+	Body       *RejectOperationJSONRequestBody
+}
+
+type RejectOperationResponseObject interface {
+	VisitRejectOperationResponse(w http.ResponseWriter) error
+}
+
+type RejectOperation200JSONResponse Approval
+
+func (response RejectOperation200JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectOperation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RejectOperation400JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectOperation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RejectOperation401JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectOperation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RejectOperation403JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectOperation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RejectOperation404JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectOperation409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RejectOperation409JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectOperation429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RejectOperation429JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RejectOperation500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RejectOperation500JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectOperation503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response RejectOperation503JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RejectOperation504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response RejectOperation504JSONResponse) VisitRejectOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntriesRequestObject struct {
+	Params ListAuditEntriesParams
+}
+
+type ListAuditEntriesResponseObject interface {
+	VisitListAuditEntriesResponse(w http.ResponseWriter) error
+}
+
+type ListAuditEntries200JSONResponse AuditEntryList
+
+func (response ListAuditEntries200JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListAuditEntries400JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListAuditEntries401JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListAuditEntries403JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListAuditEntries429JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListAuditEntries500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListAuditEntries500JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response ListAuditEntries501JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ListAuditEntries503JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListAuditEntries504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ListAuditEntries504JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClustersRequestObject struct {
+}
+
+type ListClustersResponseObject interface {
+	VisitListClustersResponse(w http.ResponseWriter) error
+}
+
+type ListClusters200JSONResponse ClusterList
+
+func (response ListClusters200JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusters401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListClusters401JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusters429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListClusters429JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListClusters503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ListClusters503JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListClusters504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ListClusters504JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyManifestsRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Params  ApplyManifestsParams
+	Body    io.Reader
+}
+
+type ApplyManifestsResponseObject interface {
+	VisitApplyManifestsResponse(w http.ResponseWriter) error
+}
+
+type ApplyManifests200JSONResponse ApplyResult
+
+func (response ApplyManifests200JSONResponse) VisitApplyManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyManifests400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApplyManifests400JSONResponse) VisitApplyManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyManifests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApplyManifests401JSONResponse) VisitApplyManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyManifests404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApplyManifests404JSONResponse) VisitApplyManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyManifests413JSONResponse struct{ PayloadTooLargeJSONResponse }
+
+func (response ApplyManifests413JSONResponse) VisitApplyManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyManifests429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ApplyManifests429JSONResponse) VisitApplyManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ApplyManifests503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ApplyManifests503JSONResponse) VisitApplyManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ApplyManifests504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ApplyManifests504JSONResponse) VisitApplyManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchEventsRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Params  SearchEventsParams
+}
+
+type SearchEventsResponseObject interface {
+	VisitSearchEventsResponse(w http.ResponseWriter) error
+}
+
+type SearchEvents200JSONResponse ClusterEventList
+
+func (response SearchEvents200JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchEvents400JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SearchEvents401JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SearchEvents403JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchEvents404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SearchEvents404JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchEvents429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SearchEvents429JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SearchEvents500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response SearchEvents500JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchEvents501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response SearchEvents501JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchEvents503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response SearchEvents503JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SearchEvents504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response SearchEvents504JSONResponse) VisitSearchEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionNamespaceRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Body    *ProvisionNamespaceJSONRequestBody
+}
+
+type ProvisionNamespaceResponseObject interface {
+	VisitProvisionNamespaceResponse(w http.ResponseWriter) error
+}
+
+type ProvisionNamespace201JSONResponse ProvisionedNamespace
+
+func (response ProvisionNamespace201JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionNamespace400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ProvisionNamespace400JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ProvisionNamespace401JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ProvisionNamespace403JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionNamespace404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ProvisionNamespace404JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionNamespace409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ProvisionNamespace409JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionNamespace429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ProvisionNamespace429JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ProvisionNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ProvisionNamespace500JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionNamespace503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ProvisionNamespace503JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ProvisionNamespace504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ProvisionNamespace504JSONResponse) VisitProvisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionNamespaceRequestObject struct {
+	Cluster   Cluster   `json:"cluster"`
+	Namespace Namespace `json:"namespace"`
+}
+
+type DeprovisionNamespaceResponseObject interface {
+	VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error
+}
+
+type DeprovisionNamespace200JSONResponse ProvisionedNamespace
+
+func (response DeprovisionNamespace200JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionNamespace400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeprovisionNamespace400JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeprovisionNamespace401JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeprovisionNamespace403JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionNamespace404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeprovisionNamespace404JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionNamespace409JSONResponse struct{ ConflictJSONResponse }
+
+func (response DeprovisionNamespace409JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionNamespace429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DeprovisionNamespace429JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeprovisionNamespace500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeprovisionNamespace500JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeprovisionNamespace503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response DeprovisionNamespace503JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeprovisionNamespace504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response DeprovisionNamespace504JSONResponse) VisitDeprovisionNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPodLogsRequestObject struct {
+	Cluster   Cluster   `json:"cluster"`
+	Namespace Namespace `json:"namespace"`
+	Pod       Pod       `json:"pod"`
+	Params    GetPodLogsParams
+}
+
+type GetPodLogsResponseObject interface {
+	VisitGetPodLogsResponse(w http.ResponseWriter) error
+}
+
+type GetPodLogs200TextResponse string
+
+func (response GetPodLogs200TextResponse) VisitGetPodLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetPodLogs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPodLogs400JSONResponse) VisitGetPodLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPodLogs401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPodLogs401JSONResponse) VisitGetPodLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPodLogs403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPodLogs403JSONResponse) VisitGetPodLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPodLogs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPodLogs404JSONResponse) VisitGetPodLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPodLogs429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetPodLogs429JSONResponse) VisitGetPodLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPodLogs500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetPodLogs500JSONResponse) VisitGetPodLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceProvisioningRequestObject struct {
+	Cluster   Cluster   `json:"cluster"`
+	Namespace Namespace `json:"namespace"`
+}
+
+type GetNamespaceProvisioningResponseObject interface {
+	VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error
+}
+
+type GetNamespaceProvisioning200JSONResponse ProvisionedNamespace
+
+func (response GetNamespaceProvisioning200JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceProvisioning400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetNamespaceProvisioning400JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceProvisioning401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetNamespaceProvisioning401JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceProvisioning403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetNamespaceProvisioning403JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceProvisioning404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetNamespaceProvisioning404JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceProvisioning409JSONResponse struct{ ConflictJSONResponse }
+
+func (response GetNamespaceProvisioning409JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceProvisioning429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetNamespaceProvisioning429JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetNamespaceProvisioning500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetNamespaceProvisioning500JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespaceProvisioning503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetNamespaceProvisioning503JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetNamespaceProvisioning504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetNamespaceProvisioning504JSONResponse) VisitGetNamespaceProvisioningResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseWorkloadRequestObject struct {
+	Cluster   Cluster                     `json:"cluster"`
+	Namespace Namespace                   `json:"namespace"`
+	Workload  PauseWorkloadParamsWorkload `json:"workload"`
+	Name      Name                        `json:"name"`
+	Params    PauseWorkloadParams
+}
+
+type PauseWorkloadResponseObject interface {
+	VisitPauseWorkloadResponse(w http.ResponseWriter) error
+}
+
+type PauseWorkload202JSONResponse struct{ OperationAcceptedJSONResponse }
+
+func (response PauseWorkload202JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PauseWorkload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PauseWorkload400JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseWorkload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PauseWorkload401JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseWorkload403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PauseWorkload403JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseWorkload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PauseWorkload404JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseWorkload409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PauseWorkload409JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseWorkload412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response PauseWorkload412JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseWorkload429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PauseWorkload429JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PauseWorkload500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PauseWorkload500JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseWorkload503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response PauseWorkload503JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PauseWorkload504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response PauseWorkload504JSONResponse) VisitPauseWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartWorkloadRequestObject struct {
+	Cluster   Cluster                       `json:"cluster"`
+	Namespace Namespace                     `json:"namespace"`
+	Workload  RestartWorkloadParamsWorkload `json:"workload"`
+	Name      Name                          `json:"name"`
+	Params    RestartWorkloadParams
+}
+
+type RestartWorkloadResponseObject interface {
+	VisitRestartWorkloadResponse(w http.ResponseWriter) error
+}
+
+type RestartWorkload202JSONResponse struct{ OperationAcceptedJSONResponse }
+
+func (response RestartWorkload202JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestartWorkload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RestartWorkload400JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartWorkload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RestartWorkload401JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartWorkload403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RestartWorkload403JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartWorkload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestartWorkload404JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartWorkload409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RestartWorkload409JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartWorkload412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response RestartWorkload412JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartWorkload429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RestartWorkload429JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestartWorkload500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RestartWorkload500JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartWorkload503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response RestartWorkload503JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestartWorkload504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response RestartWorkload504JSONResponse) VisitRestartWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkloadRequestObject struct {
+	Cluster   Cluster                      `json:"cluster"`
+	Namespace Namespace                    `json:"namespace"`
+	Workload  ResumeWorkloadParamsWorkload `json:"workload"`
+	Name      Name                         `json:"name"`
+	Params    ResumeWorkloadParams
+}
+
+type ResumeWorkloadResponseObject interface {
+	VisitResumeWorkloadResponse(w http.ResponseWriter) error
+}
+
+type ResumeWorkload202JSONResponse struct{ OperationAcceptedJSONResponse }
+
+func (response ResumeWorkload202JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ResumeWorkload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ResumeWorkload400JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ResumeWorkload401JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkload403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ResumeWorkload403JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ResumeWorkload404JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkload409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ResumeWorkload409JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkload412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response ResumeWorkload412JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkload429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ResumeWorkload429JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ResumeWorkload500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ResumeWorkload500JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeWorkload503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ResumeWorkload503JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ResumeWorkload504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ResumeWorkload504JSONResponse) VisitResumeWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkloadRevisionsRequestObject struct {
+	Cluster   Cluster                             `json:"cluster"`
+	Namespace Namespace                           `json:"namespace"`
+	Workload  ListWorkloadRevisionsParamsWorkload `json:"workload"`
+	Name      Name                                `json:"name"`
+}
+
+type ListWorkloadRevisionsResponseObject interface {
+	VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error
+}
+
+type ListWorkloadRevisions200JSONResponse WorkloadRevisionList
+
+func (response ListWorkloadRevisions200JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkloadRevisions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListWorkloadRevisions400JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkloadRevisions401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWorkloadRevisions401JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkloadRevisions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListWorkloadRevisions403JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkloadRevisions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListWorkloadRevisions404JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkloadRevisions429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListWorkloadRevisions429JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWorkloadRevisions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListWorkloadRevisions500JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkloadRevisions503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ListWorkloadRevisions503JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWorkloadRevisions504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ListWorkloadRevisions504JSONResponse) VisitListWorkloadRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackWorkloadRequestObject struct {
+	Cluster   Cluster                        `json:"cluster"`
+	Namespace Namespace                      `json:"namespace"`
+	Workload  RollbackWorkloadParamsWorkload `json:"workload"`
+	Name      Name                           `json:"name"`
+	Params    RollbackWorkloadParams
+	Body      *RollbackWorkloadJSONRequestBody
+}
+
+type RollbackWorkloadResponseObject interface {
+	VisitRollbackWorkloadResponse(w http.ResponseWriter) error
+}
+
+type RollbackWorkload202JSONResponse struct{ OperationAcceptedJSONResponse }
+
+func (response RollbackWorkload202JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RollbackWorkload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RollbackWorkload400JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackWorkload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RollbackWorkload401JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackWorkload403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RollbackWorkload403JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackWorkload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RollbackWorkload404JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackWorkload409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RollbackWorkload409JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackWorkload412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response RollbackWorkload412JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackWorkload429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RollbackWorkload429JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RollbackWorkload500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RollbackWorkload500JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackWorkload503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response RollbackWorkload503JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RollbackWorkload504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response RollbackWorkload504JSONResponse) VisitRollbackWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleWorkloadRequestObject struct {
+	Cluster   Cluster                     `json:"cluster"`
+	Namespace Namespace                   `json:"namespace"`
+	Workload  ScaleWorkloadParamsWorkload `json:"workload"`
+	Name      Name                        `json:"name"`
+	Params    ScaleWorkloadParams
+	Body      *ScaleWorkloadJSONRequestBody
+}
+
+type ScaleWorkloadResponseObject interface {
+	VisitScaleWorkloadResponse(w http.ResponseWriter) error
+}
+
+type ScaleWorkload202JSONResponse struct{ OperationAcceptedJSONResponse }
+
+func (response ScaleWorkload202JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ScaleWorkload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ScaleWorkload400JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleWorkload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ScaleWorkload401JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleWorkload403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ScaleWorkload403JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleWorkload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ScaleWorkload404JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleWorkload409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ScaleWorkload409JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleWorkload412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response ScaleWorkload412JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleWorkload429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ScaleWorkload429JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ScaleWorkload500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ScaleWorkload500JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleWorkload503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ScaleWorkload503JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ScaleWorkload504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ScaleWorkload504JSONResponse) VisitScaleWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadStatusRequestObject struct {
+	Cluster   Cluster                         `json:"cluster"`
+	Namespace Namespace                       `json:"namespace"`
+	Workload  GetWorkloadStatusParamsWorkload `json:"workload"`
+	Name      Name                            `json:"name"`
+}
+
+type GetWorkloadStatusResponseObject interface {
+	VisitGetWorkloadStatusResponse(w http.ResponseWriter) error
+}
+
+type GetWorkloadStatus200ResponseHeaders struct {
+	ETag string
+}
+
+type GetWorkloadStatus200JSONResponse struct {
+	Body    RolloutStatus
+	Headers GetWorkloadStatus200ResponseHeaders
+}
+
+func (response GetWorkloadStatus200JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWorkloadStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetWorkloadStatus400JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetWorkloadStatus401JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadStatus403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetWorkloadStatus403JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetWorkloadStatus404JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadStatus429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetWorkloadStatus429JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWorkloadStatus500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetWorkloadStatus500JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadStatus503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetWorkloadStatus503JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWorkloadStatus504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetWorkloadStatus504JSONResponse) VisitGetWorkloadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonNodeRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Node    Node    `json:"node"`
+}
+
+type CordonNodeResponseObject interface {
+	VisitCordonNodeResponse(w http.ResponseWriter) error
+}
+
+type CordonNode200JSONResponse NodeSchedulingStatus
+
+func (response CordonNode200JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonNode400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CordonNode400JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonNode401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CordonNode401JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonNode403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CordonNode403JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonNode404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CordonNode404JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonNode409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CordonNode409JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonNode429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CordonNode429JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CordonNode500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CordonNode500JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CordonNode503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response CordonNode503JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CordonNode504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response CordonNode504JSONResponse) VisitCordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainNodeRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Node    Node    `json:"node"`
+	Body    *DrainNodeJSONRequestBody
+}
+
+type DrainNodeResponseObject interface {
+	VisitDrainNodeResponse(w http.ResponseWriter) error
+}
+
+type DrainNode202JSONResponse struct{ OperationAcceptedJSONResponse }
+
+func (response DrainNode202JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DrainNode400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DrainNode400JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainNode401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DrainNode401JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainNode403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DrainNode403JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainNode404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DrainNode404JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainNode409JSONResponse struct{ ConflictJSONResponse }
+
+func (response DrainNode409JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainNode429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DrainNode429JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DrainNode500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DrainNode500JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DrainNode503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response DrainNode503JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DrainNode504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response DrainNode504JSONResponse) VisitDrainNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonNodeRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Node    Node    `json:"node"`
+}
+
+type UncordonNodeResponseObject interface {
+	VisitUncordonNodeResponse(w http.ResponseWriter) error
+}
+
+type UncordonNode200JSONResponse NodeSchedulingStatus
+
+func (response UncordonNode200JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonNode400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UncordonNode400JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonNode401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UncordonNode401JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonNode403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UncordonNode403JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonNode404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UncordonNode404JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonNode409JSONResponse struct{ ConflictJSONResponse }
+
+func (response UncordonNode409JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonNode429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response UncordonNode429JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UncordonNode500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UncordonNode500JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UncordonNode503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response UncordonNode503JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UncordonNode504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response UncordonNode504JSONResponse) VisitUncordonNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespacedTimelineRequestObject struct {
+	Cluster   Cluster   `json:"cluster"`
+	Namespace Namespace `json:"namespace"`
+	Resource  Resource  `json:"resource"`
+	Name      Name      `json:"name"`
+	Params    GetNamespacedTimelineParams
+}
+
+type GetNamespacedTimelineResponseObject interface {
+	VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error
+}
+
+type GetNamespacedTimeline200JSONResponse struct{ TimelineJSONResponse }
+
+func (response GetNamespacedTimeline200JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespacedTimeline400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetNamespacedTimeline400JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespacedTimeline401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetNamespacedTimeline401JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespacedTimeline403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetNamespacedTimeline403JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespacedTimeline404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetNamespacedTimeline404JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespacedTimeline429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetNamespacedTimeline429JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetNamespacedTimeline500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetNamespacedTimeline500JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespacedTimeline501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response GetNamespacedTimeline501JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNamespacedTimeline503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetNamespacedTimeline503JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetNamespacedTimeline504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetNamespacedTimeline504JSONResponse) VisitGetNamespacedTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimelineRequestObject struct {
+	Cluster  Cluster  `json:"cluster"`
+	Resource Resource `json:"resource"`
+	Name     Name     `json:"name"`
+	Params   GetTimelineParams
+}
+
+type GetTimelineResponseObject interface {
+	VisitGetTimelineResponse(w http.ResponseWriter) error
+}
+
+type GetTimeline200JSONResponse struct{ TimelineJSONResponse }
+
+func (response GetTimeline200JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeline400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTimeline400JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeline401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTimeline401JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeline403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTimeline403JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeline404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetTimeline404JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeline429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTimeline429JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTimeline500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTimeline500JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeline501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response GetTimeline501JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeline503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetTimeline503JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTimeline504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetTimeline504JSONResponse) VisitGetTimelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageReportRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Params  GetUsageReportParams
+}
+
+type GetUsageReportResponseObject interface {
+	VisitGetUsageReportResponse(w http.ResponseWriter) error
+}
+
+type GetUsageReport200JSONResponse UsageReport
+
+func (response GetUsageReport200JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetUsageReport200TextcsvResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUsageReport400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUsageReport400JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageReport401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUsageReport401JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageReport403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetUsageReport403JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageReport404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUsageReport404JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageReport429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetUsageReport429JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsageReport500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUsageReport500JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageReport503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetUsageReport503JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsageReport504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetUsageReport504JSONResponse) VisitGetUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaReportRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Params  GetQuotaReportParams
+}
+
+type GetQuotaReportResponseObject interface {
+	VisitGetQuotaReportResponse(w http.ResponseWriter) error
+}
+
+type GetQuotaReport200JSONResponse QuotaReport
+
+func (response GetQuotaReport200JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetQuotaReport200TextcsvResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetQuotaReport400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetQuotaReport400JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaReport401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetQuotaReport401JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaReport403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetQuotaReport403JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaReport404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetQuotaReport404JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaReport429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetQuotaReport429JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetQuotaReport500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetQuotaReport500JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaReport503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetQuotaReport503JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetQuotaReport504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetQuotaReport504JSONResponse) VisitGetQuotaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadUsageReportRequestObject struct {
+	Cluster Cluster `json:"cluster"`
+	Params  GetWorkloadUsageReportParams
+}
+
+type GetWorkloadUsageReportResponseObject interface {
+	VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error
+}
+
+type GetWorkloadUsageReport200JSONResponse WorkloadUsageReport
+
+func (response GetWorkloadUsageReport200JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadUsageReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetWorkloadUsageReport200TextcsvResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetWorkloadUsageReport400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetWorkloadUsageReport400JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadUsageReport401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetWorkloadUsageReport401JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadUsageReport403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetWorkloadUsageReport403JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadUsageReport404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetWorkloadUsageReport404JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadUsageReport429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetWorkloadUsageReport429JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWorkloadUsageReport500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetWorkloadUsageReport500JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkloadUsageReport503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetWorkloadUsageReport503JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWorkloadUsageReport504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetWorkloadUsageReport504JSONResponse) VisitGetWorkloadUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchNamespacedResourcesRequestObject struct {
+	Cluster   Cluster   `json:"cluster"`
+	Namespace Namespace `json:"namespace"`
+	Resource  Resource  `json:"resource"`
+	Params    WatchNamespacedResourcesParams
+}
+
+type WatchNamespacedResourcesResponseObject interface {
+	VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error
+}
+
+type WatchNamespacedResources200TexteventStreamResponse struct {
+	WatchStreamTexteventStreamResponse
+}
+
+func (response WatchNamespacedResources200TexteventStreamResponse) VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WatchNamespacedResources400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response WatchNamespacedResources400JSONResponse) VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchNamespacedResources401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response WatchNamespacedResources401JSONResponse) VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchNamespacedResources403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response WatchNamespacedResources403JSONResponse) VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchNamespacedResources404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response WatchNamespacedResources404JSONResponse) VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchNamespacedResources429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response WatchNamespacedResources429JSONResponse) VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WatchNamespacedResources503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response WatchNamespacedResources503JSONResponse) VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchResourcesRequestObject struct {
+	Cluster  Cluster  `json:"cluster"`
+	Resource Resource `json:"resource"`
+	Params   WatchResourcesParams
+}
+
+type WatchResourcesResponseObject interface {
+	VisitWatchResourcesResponse(w http.ResponseWriter) error
+}
+
+type WatchResources200TexteventStreamResponse struct {
+	WatchStreamTexteventStreamResponse
+}
+
+func (response WatchResources200TexteventStreamResponse) VisitWatchResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WatchResources400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response WatchResources400JSONResponse) VisitWatchResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchResources401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response WatchResources401JSONResponse) VisitWatchResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchResources403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response WatchResources403JSONResponse) VisitWatchResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchResources404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response WatchResources404JSONResponse) VisitWatchResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchResources429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response WatchResources429JSONResponse) VisitWatchResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WatchResources503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response WatchResources503JSONResponse) VisitWatchResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNamespaceTemplatesRequestObject struct {
+}
+
+type ListNamespaceTemplatesResponseObject interface {
+	VisitListNamespaceTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListNamespaceTemplates200JSONResponse NamespaceTemplateList
+
+func (response ListNamespaceTemplates200JSONResponse) VisitListNamespaceTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNamespaceTemplates401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListNamespaceTemplates401JSONResponse) VisitListNamespaceTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNamespaceTemplates429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListNamespaceTemplates429JSONResponse) VisitListNamespaceTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListNamespaceTemplates503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response ListNamespaceTemplates503JSONResponse) VisitListNamespaceTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListNamespaceTemplates504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response ListNamespaceTemplates504JSONResponse) VisitListNamespaceTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperationRequestObject struct {
+	OperationId string `json:"operationId"`
+	Params      GetOperationParams
+}
+
+type GetOperationResponseObject interface {
+	VisitGetOperationResponse(w http.ResponseWriter) error
+}
+
+type GetOperation200JSONResponse Operation
+
+func (response GetOperation200JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetOperation400JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetOperation401JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetOperation403JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetOperation404JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetOperation429JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Policy", fmt.Sprint(response.Headers.RateLimitPolicy))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOperation503JSONResponse struct{ OverloadedJSONResponse }
+
+func (response GetOperation503JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOperation504JSONResponse struct{ GatewayTimeoutJSONResponse }
+
+func (response GetOperation504JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCapturesRequestObject struct {
+}
+
+type ListCapturesResponseObject interface {
+	VisitListCapturesResponse(w http.ResponseWriter) error
+}
+
+type ListCaptures200JSONResponse CaptureList
+
+func (response ListCaptures200JSONResponse) VisitListCapturesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCaptures401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListCaptures401JSONResponse) VisitListCapturesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCaptureRequestObject struct {
+	Body *StartCaptureJSONRequestBody
+}
+
+type StartCaptureResponseObject interface {
+	VisitStartCaptureResponse(w http.ResponseWriter) error
+}
+
+type StartCapture202ResponseHeaders struct {
+	Location string
+}
+
+type StartCapture202JSONResponse struct {
+	Body    Capture
+	Headers StartCapture202ResponseHeaders
+}
+
+func (response StartCapture202JSONResponse) VisitStartCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StartCapture400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StartCapture400JSONResponse) VisitStartCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCapture401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response StartCapture401JSONResponse) VisitStartCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCapture409JSONResponse struct{ ConflictJSONResponse }
+
+func (response StartCapture409JSONResponse) VisitStartCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCaptureRequestObject struct {
+	CaptureId CaptureID `json:"captureId"`
+}
+
+type GetCaptureResponseObject interface {
+	VisitGetCaptureResponse(w http.ResponseWriter) error
+}
+
+type GetCapture200JSONResponse Capture
+
+func (response GetCapture200JSONResponse) VisitGetCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapture401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCapture401JSONResponse) VisitGetCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapture404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCapture404JSONResponse) VisitGetCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadCaptureRequestObject struct {
+	CaptureId CaptureID `json:"captureId"`
+}
+
+type DownloadCaptureResponseObject interface {
+	VisitDownloadCaptureResponse(w http.ResponseWriter) error
+}
+
+type DownloadCapture200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type DownloadCapture200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       DownloadCapture200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadCapture200ApplicationoctetStreamResponse) VisitDownloadCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadCapture401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DownloadCapture401JSONResponse) VisitDownloadCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadCapture404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DownloadCapture404JSONResponse) VisitDownloadCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadCapture409JSONResponse ErrorResponse
+
+func (response DownloadCapture409JSONResponse) VisitDownloadCaptureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConfigRequestObject struct {
+}
+
+type GetConfigResponseObject interface {
+	VisitGetConfigResponse(w http.ResponseWriter) error
+}
+
+type GetConfig200JSONResponse ConfigDump
+
+func (response GetConfig200JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConfig401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetConfig401JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGoroutineDumpRequestObject struct {
+}
+
+type GetGoroutineDumpResponseObject interface {
+	VisitGetGoroutineDumpResponse(w http.ResponseWriter) error
+}
+
+type GetGoroutineDump200TextResponse string
+
+func (response GetGoroutineDump200TextResponse) VisitGetGoroutineDumpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetGoroutineDump401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetGoroutineDump401JSONResponse) VisitGetGoroutineDumpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetLogLevelRequestObject struct {
+	Params SetLogLevelParams
+}
+
+type SetLogLevelResponseObject interface {
+	VisitSetLogLevelResponse(w http.ResponseWriter) error
+}
+
+type SetLogLevel200JSONResponse struct {
+	// Format The new log format.
+	Format *string `json:"format,omitempty"`
+
+	// Level The new log level.
+	Level *string `json:"level,omitempty"`
+}
+
+func (response SetLogLevel200JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetLogLevel400JSONResponse ErrorResponse
+
+func (response SetLogLevel400JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetLogLevel401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetLogLevel401JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileRequestObject struct {
+	Profile GetProfileParamsProfile `json:"profile"`
+	Params  GetProfileParams
+}
+
+type GetProfileResponseObject interface {
+	VisitGetProfileResponse(w http.ResponseWriter) error
+}
+
+type GetProfile200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetProfile200ApplicationoctetStreamResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetProfile200TextResponse string
+
+func (response GetProfile200TextResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetProfile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetProfile400JSONResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetProfile401JSONResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuntimeStatsRequestObject struct {
+}
+
+type GetRuntimeStatsResponseObject interface {
+	VisitGetRuntimeStatsResponse(w http.ResponseWriter) error
+}
+
+type GetRuntimeStats200JSONResponse RuntimeStats
+
+func (response GetRuntimeStats200JSONResponse) VisitGetRuntimeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuntimeStats401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRuntimeStats401JSONResponse) VisitGetRuntimeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeadLettersRequestObject struct {
+	Params ListDeadLettersParams
+}
+
+type ListDeadLettersResponseObject interface {
+	VisitListDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type ListDeadLetters200JSONResponse DeadLetterList
+
+func (response ListDeadLetters200JSONResponse) VisitListDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeadLetters400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListDeadLetters400JSONResponse) VisitListDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeadLetters401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListDeadLetters401JSONResponse) VisitListDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryDeadLetterRequestObject struct {
+	DeadLetterId DeadLetterID `json:"deadLetterId"`
+}
+
+type RetryDeadLetterResponseObject interface {
+	VisitRetryDeadLetterResponse(w http.ResponseWriter) error
+}
+
+type RetryDeadLetter202JSONResponse DeadLetter
+
+func (response RetryDeadLetter202JSONResponse) VisitRetryDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryDeadLetter401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RetryDeadLetter401JSONResponse) VisitRetryDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryDeadLetter404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RetryDeadLetter404JSONResponse) VisitRetryDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryDeadLetter409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RetryDeadLetter409JSONResponse) VisitRetryDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadinessRequestObject struct {
+}
+
+type GetReadinessResponseObject interface {
+	VisitGetReadinessResponse(w http.ResponseWriter) error
+}
+
+type GetReadiness200JSONResponse ReadinessResponse
+
+func (response GetReadiness200JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadiness500JSONResponse ErrorResponse
+
+func (response GetReadiness500JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadiness503JSONResponse ReadinessResponse
+
+func (response GetReadiness503JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List approvals
+	// (GET /api/v1/approvals)
+	ListApprovals(ctx context.Context, request ListApprovalsRequestObject) (ListApprovalsResponseObject, error)
+	// Get an approval
+	// (GET /api/v1/approvals/{approvalId})
+	GetApproval(ctx context.Context, request GetApprovalRequestObject) (GetApprovalResponseObject, error)
+	// Approve an operation
+	// (POST /api/v1/approvals/{approvalId}/approve)
+	ApproveOperation(ctx context.Context, request ApproveOperationRequestObject) (ApproveOperationResponseObject, error)
+	// Reject an operation
+	// (POST /api/v1/approvals/{approvalId}/reject)
+	RejectOperation(ctx context.Context, request RejectOperationRequestObject) (RejectOperationResponseObject, error)
+	// Query the audit trail
+	// (GET /api/v1/audit)
+	ListAuditEntries(ctx context.Context, request ListAuditEntriesRequestObject) (ListAuditEntriesResponseObject, error)
+	// List registered clusters
+	// (GET /api/v1/clusters)
+	ListClusters(ctx context.Context, request ListClustersRequestObject) (ListClustersResponseObject, error)
+	// Apply Kubernetes manifests with server-side apply
+	// (POST /api/v1/clusters/{cluster}/apply)
+	ApplyManifests(ctx context.Context, request ApplyManifestsRequestObject) (ApplyManifestsResponseObject, error)
+	// Search collected Kubernetes events
+	// (GET /api/v1/clusters/{cluster}/events)
+	SearchEvents(ctx context.Context, request SearchEventsRequestObject) (SearchEventsResponseObject, error)
+	// Provision a namespace
+	// (POST /api/v1/clusters/{cluster}/namespaces)
+	ProvisionNamespace(ctx context.Context, request ProvisionNamespaceRequestObject) (ProvisionNamespaceResponseObject, error)
+	// Deprovision a namespace
+	// (DELETE /api/v1/clusters/{cluster}/namespaces/{namespace})
+	DeprovisionNamespace(ctx context.Context, request DeprovisionNamespaceRequestObject) (DeprovisionNamespaceResponseObject, error)
+	// Read the log of a container
+	// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/log)
+	GetPodLogs(ctx context.Context, request GetPodLogsRequestObject) (GetPodLogsResponseObject, error)
+	// Get the provisioning record of a namespace
+	// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/provisioning)
+	GetNamespaceProvisioning(ctx context.Context, request GetNamespaceProvisioningRequestObject) (GetNamespaceProvisioningResponseObject, error)
+	// Pause the rollout of a workload
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/pause)
+	PauseWorkload(ctx context.Context, request PauseWorkloadRequestObject) (PauseWorkloadResponseObject, error)
+	// Restart a workload
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/restart)
+	RestartWorkload(ctx context.Context, request RestartWorkloadRequestObject) (RestartWorkloadResponseObject, error)
+	// Resume the rollout of a workload
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/resume)
+	ResumeWorkload(ctx context.Context, request ResumeWorkloadRequestObject) (ResumeWorkloadResponseObject, error)
+	// List the rollout history of a workload
+	// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/revisions)
+	ListWorkloadRevisions(ctx context.Context, request ListWorkloadRevisionsRequestObject) (ListWorkloadRevisionsResponseObject, error)
+	// Roll a workload back to a revision
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/rollback)
+	RollbackWorkload(ctx context.Context, request RollbackWorkloadRequestObject) (RollbackWorkloadResponseObject, error)
+	// Scale a workload
+	// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/scale)
+	ScaleWorkload(ctx context.Context, request ScaleWorkloadRequestObject) (ScaleWorkloadResponseObject, error)
+	// Get the rollout status of a workload
+	// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/status)
+	GetWorkloadStatus(ctx context.Context, request GetWorkloadStatusRequestObject) (GetWorkloadStatusResponseObject, error)
+	// Cordon a node
+	// (POST /api/v1/clusters/{cluster}/nodes/{node}/cordon)
+	CordonNode(ctx context.Context, request CordonNodeRequestObject) (CordonNodeResponseObject, error)
+	// Drain a node
+	// (POST /api/v1/clusters/{cluster}/nodes/{node}/drain)
+	DrainNode(ctx context.Context, request DrainNodeRequestObject) (DrainNodeResponseObject, error)
+	// Uncordon a node
+	// (POST /api/v1/clusters/{cluster}/nodes/{node}/uncordon)
+	UncordonNode(ctx context.Context, request UncordonNodeRequestObject) (UncordonNodeResponseObject, error)
+	// Timeline of an object in a namespace
+	// (GET /api/v1/clusters/{cluster}/timeline/namespaces/{namespace}/{resource}/{name})
+	GetNamespacedTimeline(ctx context.Context, request GetNamespacedTimelineRequestObject) (GetNamespacedTimelineResponseObject, error)
+	// Timeline of a cluster-scoped object
+	// (GET /api/v1/clusters/{cluster}/timeline/{resource}/{name})
+	GetTimeline(ctx context.Context, request GetTimelineRequestObject) (GetTimelineResponseObject, error)
+	// Report CPU and memory usage
+	// (GET /api/v1/clusters/{cluster}/usage)
+	GetUsageReport(ctx context.Context, request GetUsageReportRequestObject) (GetUsageReportResponseObject, error)
+	// Report ResourceQuota utilisation
+	// (GET /api/v1/clusters/{cluster}/usage/quotas)
+	GetQuotaReport(ctx context.Context, request GetQuotaReportRequestObject) (GetQuotaReportResponseObject, error)
+	// Report workload usage and over-provisioning
+	// (GET /api/v1/clusters/{cluster}/usage/workloads)
+	GetWorkloadUsageReport(ctx context.Context, request GetWorkloadUsageReportRequestObject) (GetWorkloadUsageReportResponseObject, error)
+	// Stream changes of a resource in a namespace
+	// (GET /api/v1/clusters/{cluster}/watch/namespaces/{namespace}/{resource})
+	WatchNamespacedResources(ctx context.Context, request WatchNamespacedResourcesRequestObject) (WatchNamespacedResourcesResponseObject, error)
+	// Stream changes of a resource across all namespaces
+	// (GET /api/v1/clusters/{cluster}/watch/{resource})
+	WatchResources(ctx context.Context, request WatchResourcesRequestObject) (WatchResourcesResponseObject, error)
+	// List namespace templates
+	// (GET /api/v1/namespace-templates)
+	ListNamespaceTemplates(ctx context.Context, request ListNamespaceTemplatesRequestObject) (ListNamespaceTemplatesResponseObject, error)
+	// Get a long-running operation
+	// (GET /api/v1/operations/{operationId})
+	GetOperation(ctx context.Context, request GetOperationRequestObject) (GetOperationResponseObject, error)
+	// List captures
+	// (GET /debug/captures)
+	ListCaptures(ctx context.Context, request ListCapturesRequestObject) (ListCapturesResponseObject, error)
+	// Start a capture
+	// (POST /debug/captures)
+	StartCapture(ctx context.Context, request StartCaptureRequestObject) (StartCaptureResponseObject, error)
+	// Show a capture
+	// (GET /debug/captures/{captureId})
+	GetCapture(ctx context.Context, request GetCaptureRequestObject) (GetCaptureResponseObject, error)
+	// Download a capture
+	// (GET /debug/captures/{captureId}/download)
+	DownloadCapture(ctx context.Context, request DownloadCaptureRequestObject) (DownloadCaptureResponseObject, error)
+	// Show the effective configuration
+	// (GET /debug/config)
+	GetConfig(ctx context.Context, request GetConfigRequestObject) (GetConfigResponseObject, error)
+	// Dump the stacks of all goroutines
+	// (GET /debug/goroutines)
+	GetGoroutineDump(ctx context.Context, request GetGoroutineDumpRequestObject) (GetGoroutineDumpResponseObject, error)
+	// Sets the log level and format dynamically
+	// (GET /debug/log)
+	SetLogLevel(ctx context.Context, request SetLogLevelRequestObject) (SetLogLevelResponseObject, error)
+	// Download a runtime profile
+	// (GET /debug/pprof/{profile})
+	GetProfile(ctx context.Context, request GetProfileRequestObject) (GetProfileResponseObject, error)
+	// Show runtime statistics
+	// (GET /debug/runtime)
+	GetRuntimeStats(ctx context.Context, request GetRuntimeStatsRequestObject) (GetRuntimeStatsResponseObject, error)
+	// List dead letters
+	// (GET /notifications/dead-letters)
+	ListDeadLetters(ctx context.Context, request ListDeadLettersRequestObject) (ListDeadLettersResponseObject, error)
+	// Retry a dead letter
+	// (POST /notifications/dead-letters/{deadLetterId}/retry)
+	RetryDeadLetter(ctx context.Context, request RetryDeadLetterRequestObject) (RetryDeadLetterResponseObject, error)
+	// Readiness check endpoint
+	// (GET /readyz)
+	GetReadiness(ctx context.Context, request GetReadinessRequestObject) (GetReadinessResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListApprovals operation middleware
+func (sh *strictHandler) ListApprovals(w http.ResponseWriter, r *http.Request, params ListApprovalsParams) {
+	var request ListApprovalsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApprovals(ctx, request.(ListApprovalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApprovals")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListApprovalsResponseObject); ok {
+		if err := validResponse.VisitListApprovalsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApproval operation middleware
+func (sh *strictHandler) GetApproval(w http.ResponseWriter, r *http.Request, approvalId ApprovalId) {
+	var request GetApprovalRequestObject
+
+	request.ApprovalId = approvalId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApproval(ctx, request.(GetApprovalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApproval")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApprovalResponseObject); ok {
+		if err := validResponse.VisitGetApprovalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApproveOperation operation middleware
+func (sh *strictHandler) ApproveOperation(w http.ResponseWriter, r *http.Request, approvalId ApprovalId) {
+	var request ApproveOperationRequestObject
+
+	request.ApprovalId = approvalId
+
+	var body ApproveOperationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveOperation(ctx, request.(ApproveOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveOperationResponseObject); ok {
+		if err := validResponse.VisitApproveOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RejectOperation operation middleware
+func (sh *strictHandler) RejectOperation(w http.ResponseWriter, r *http.Request, approvalId ApprovalId) {
+	var request RejectOperationRequestObject
+
+	request.ApprovalId = approvalId
+
+	var body RejectOperationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectOperation(ctx, request.(RejectOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectOperationResponseObject); ok {
+		if err := validResponse.VisitRejectOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAuditEntries operation middleware
+func (sh *strictHandler) ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams) {
+	var request ListAuditEntriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditEntries(ctx, request.(ListAuditEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditEntries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditEntriesResponseObject); ok {
+		if err := validResponse.VisitListAuditEntriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListClusters operation middleware
+func (sh *strictHandler) ListClusters(w http.ResponseWriter, r *http.Request) {
+	var request ListClustersRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClusters(ctx, request.(ListClustersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClusters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClustersResponseObject); ok {
+		if err := validResponse.VisitListClustersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyManifests operation middleware
+func (sh *strictHandler) ApplyManifests(w http.ResponseWriter, r *http.Request, cluster Cluster, params ApplyManifestsParams) {
+	var request ApplyManifestsRequestObject
+
+	request.Cluster = cluster
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyManifests(ctx, request.(ApplyManifestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyManifests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyManifestsResponseObject); ok {
+		if err := validResponse.VisitApplyManifestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchEvents operation middleware
+func (sh *strictHandler) SearchEvents(w http.ResponseWriter, r *http.Request, cluster Cluster, params SearchEventsParams) {
+	var request SearchEventsRequestObject
+
+	request.Cluster = cluster
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchEvents(ctx, request.(SearchEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchEventsResponseObject); ok {
+		if err := validResponse.VisitSearchEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ProvisionNamespace operation middleware
+func (sh *strictHandler) ProvisionNamespace(w http.ResponseWriter, r *http.Request, cluster Cluster) {
+	var request ProvisionNamespaceRequestObject
+
+	request.Cluster = cluster
+
+	var body ProvisionNamespaceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ProvisionNamespace(ctx, request.(ProvisionNamespaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ProvisionNamespace")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ProvisionNamespaceResponseObject); ok {
+		if err := validResponse.VisitProvisionNamespaceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeprovisionNamespace operation middleware
+func (sh *strictHandler) DeprovisionNamespace(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace) {
+	var request DeprovisionNamespaceRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeprovisionNamespace(ctx, request.(DeprovisionNamespaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeprovisionNamespace")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeprovisionNamespaceResponseObject); ok {
+		if err := validResponse.VisitDeprovisionNamespaceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPodLogs operation middleware
+func (sh *strictHandler) GetPodLogs(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, pod Pod, params GetPodLogsParams) {
+	var request GetPodLogsRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Pod = pod
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPodLogs(ctx, request.(GetPodLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPodLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPodLogsResponseObject); ok {
+		if err := validResponse.VisitGetPodLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNamespaceProvisioning operation middleware
+func (sh *strictHandler) GetNamespaceProvisioning(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace) {
+	var request GetNamespaceProvisioningRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNamespaceProvisioning(ctx, request.(GetNamespaceProvisioningRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNamespaceProvisioning")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNamespaceProvisioningResponseObject); ok {
+		if err := validResponse.VisitGetNamespaceProvisioningResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PauseWorkload operation middleware
+func (sh *strictHandler) PauseWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload PauseWorkloadParamsWorkload, name Name, params PauseWorkloadParams) {
+	var request PauseWorkloadRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Workload = workload
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseWorkload(ctx, request.(PauseWorkloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseWorkload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PauseWorkloadResponseObject); ok {
+		if err := validResponse.VisitPauseWorkloadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestartWorkload operation middleware
+func (sh *strictHandler) RestartWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload RestartWorkloadParamsWorkload, name Name, params RestartWorkloadParams) {
+	var request RestartWorkloadRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Workload = workload
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestartWorkload(ctx, request.(RestartWorkloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestartWorkload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestartWorkloadResponseObject); ok {
+		if err := validResponse.VisitRestartWorkloadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumeWorkload operation middleware
+func (sh *strictHandler) ResumeWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ResumeWorkloadParamsWorkload, name Name, params ResumeWorkloadParams) {
+	var request ResumeWorkloadRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Workload = workload
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeWorkload(ctx, request.(ResumeWorkloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeWorkload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeWorkloadResponseObject); ok {
+		if err := validResponse.VisitResumeWorkloadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListWorkloadRevisions operation middleware
+func (sh *strictHandler) ListWorkloadRevisions(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ListWorkloadRevisionsParamsWorkload, name Name) {
+	var request ListWorkloadRevisionsRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Workload = workload
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkloadRevisions(ctx, request.(ListWorkloadRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkloadRevisions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWorkloadRevisionsResponseObject); ok {
+		if err := validResponse.VisitListWorkloadRevisionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RollbackWorkload operation middleware
+func (sh *strictHandler) RollbackWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload RollbackWorkloadParamsWorkload, name Name, params RollbackWorkloadParams) {
+	var request RollbackWorkloadRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Workload = workload
+	request.Name = name
+	request.Params = params
+
+	var body RollbackWorkloadJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RollbackWorkload(ctx, request.(RollbackWorkloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RollbackWorkload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RollbackWorkloadResponseObject); ok {
+		if err := validResponse.VisitRollbackWorkloadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScaleWorkload operation middleware
+func (sh *strictHandler) ScaleWorkload(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload ScaleWorkloadParamsWorkload, name Name, params ScaleWorkloadParams) {
+	var request ScaleWorkloadRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Workload = workload
+	request.Name = name
+	request.Params = params
+
+	var body ScaleWorkloadJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ScaleWorkload(ctx, request.(ScaleWorkloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScaleWorkload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ScaleWorkloadResponseObject); ok {
+		if err := validResponse.VisitScaleWorkloadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWorkloadStatus operation middleware
+func (sh *strictHandler) GetWorkloadStatus(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, workload GetWorkloadStatusParamsWorkload, name Name) {
+	var request GetWorkloadStatusRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Workload = workload
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorkloadStatus(ctx, request.(GetWorkloadStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorkloadStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWorkloadStatusResponseObject); ok {
+		if err := validResponse.VisitGetWorkloadStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CordonNode operation middleware
+func (sh *strictHandler) CordonNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node) {
+	var request CordonNodeRequestObject
+
+	request.Cluster = cluster
+	request.Node = node
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CordonNode(ctx, request.(CordonNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CordonNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CordonNodeResponseObject); ok {
+		if err := validResponse.VisitCordonNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DrainNode operation middleware
+func (sh *strictHandler) DrainNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node) {
+	var request DrainNodeRequestObject
+
+	request.Cluster = cluster
+	request.Node = node
+
+	var body DrainNodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DrainNode(ctx, request.(DrainNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DrainNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DrainNodeResponseObject); ok {
+		if err := validResponse.VisitDrainNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UncordonNode operation middleware
+func (sh *strictHandler) UncordonNode(w http.ResponseWriter, r *http.Request, cluster Cluster, node Node) {
+	var request UncordonNodeRequestObject
+
+	request.Cluster = cluster
+	request.Node = node
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UncordonNode(ctx, request.(UncordonNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UncordonNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UncordonNodeResponseObject); ok {
+		if err := validResponse.VisitUncordonNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNamespacedTimeline operation middleware
+func (sh *strictHandler) GetNamespacedTimeline(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, resource Resource, name Name, params GetNamespacedTimelineParams) {
+	var request GetNamespacedTimelineRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Resource = resource
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNamespacedTimeline(ctx, request.(GetNamespacedTimelineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNamespacedTimeline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNamespacedTimelineResponseObject); ok {
+		if err := validResponse.VisitGetNamespacedTimelineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTimeline operation middleware
+func (sh *strictHandler) GetTimeline(w http.ResponseWriter, r *http.Request, cluster Cluster, resource Resource, name Name, params GetTimelineParams) {
+	var request GetTimelineRequestObject
+
+	request.Cluster = cluster
+	request.Resource = resource
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTimeline(ctx, request.(GetTimelineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTimeline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTimelineResponseObject); ok {
+		if err := validResponse.VisitGetTimelineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsageReport operation middleware
+func (sh *strictHandler) GetUsageReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetUsageReportParams) {
+	var request GetUsageReportRequestObject
+
+	request.Cluster = cluster
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsageReport(ctx, request.(GetUsageReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsageReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsageReportResponseObject); ok {
+		if err := validResponse.VisitGetUsageReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetQuotaReport operation middleware
+func (sh *strictHandler) GetQuotaReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetQuotaReportParams) {
+	var request GetQuotaReportRequestObject
+
+	request.Cluster = cluster
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQuotaReport(ctx, request.(GetQuotaReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQuotaReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetQuotaReportResponseObject); ok {
+		if err := validResponse.VisitGetQuotaReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWorkloadUsageReport operation middleware
+func (sh *strictHandler) GetWorkloadUsageReport(w http.ResponseWriter, r *http.Request, cluster Cluster, params GetWorkloadUsageReportParams) {
+	var request GetWorkloadUsageReportRequestObject
+
+	request.Cluster = cluster
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorkloadUsageReport(ctx, request.(GetWorkloadUsageReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorkloadUsageReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWorkloadUsageReportResponseObject); ok {
+		if err := validResponse.VisitGetWorkloadUsageReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WatchNamespacedResources operation middleware
+func (sh *strictHandler) WatchNamespacedResources(w http.ResponseWriter, r *http.Request, cluster Cluster, namespace Namespace, resource Resource, params WatchNamespacedResourcesParams) {
+	var request WatchNamespacedResourcesRequestObject
+
+	request.Cluster = cluster
+	request.Namespace = namespace
+	request.Resource = resource
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WatchNamespacedResources(ctx, request.(WatchNamespacedResourcesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WatchNamespacedResources")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WatchNamespacedResourcesResponseObject); ok {
+		if err := validResponse.VisitWatchNamespacedResourcesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WatchResources operation middleware
+func (sh *strictHandler) WatchResources(w http.ResponseWriter, r *http.Request, cluster Cluster, resource Resource, params WatchResourcesParams) {
+	var request WatchResourcesRequestObject
+
+	request.Cluster = cluster
+	request.Resource = resource
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WatchResources(ctx, request.(WatchResourcesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WatchResources")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WatchResourcesResponseObject); ok {
+		if err := validResponse.VisitWatchResourcesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListNamespaceTemplates operation middleware
+func (sh *strictHandler) ListNamespaceTemplates(w http.ResponseWriter, r *http.Request) {
+	var request ListNamespaceTemplatesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListNamespaceTemplates(ctx, request.(ListNamespaceTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListNamespaceTemplates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListNamespaceTemplatesResponseObject); ok {
+		if err := validResponse.VisitListNamespaceTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+// GetOperation operation middleware
+func (sh *strictHandler) GetOperation(w http.ResponseWriter, r *http.Request, operationId string, params GetOperationParams) {
+	var request GetOperationRequestObject
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	request.OperationId = operationId
+	request.Params = params
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOperation(ctx, request.(GetOperationRequestObject))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOperation")
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/debug/log", wrapper.SetLogLevel)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/readyz", wrapper.GetReadiness)
-	})
+	response, err := handler(r.Context(), w, r, request)
 
-	return r
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOperationResponseObject); ok {
+		if err := validResponse.VisitGetOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type SetLogLevelRequestObject struct {
-	Params SetLogLevelParams
-}
+// ListCaptures operation middleware
+func (sh *strictHandler) ListCaptures(w http.ResponseWriter, r *http.Request) {
+	var request ListCapturesRequestObject
 
-type SetLogLevelResponseObject interface {
-	VisitSetLogLevelResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCaptures(ctx, request.(ListCapturesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCaptures")
+	}
 
-type SetLogLevel200JSONResponse struct {
-	// Format The new log format.
-	Format *string `json:"format,omitempty"`
+	response, err := handler(r.Context(), w, r, request)
 
-	// Level The new log level.
-	Level *string `json:"level,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCapturesResponseObject); ok {
+		if err := validResponse.VisitListCapturesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response SetLogLevel200JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// StartCapture operation middleware
+func (sh *strictHandler) StartCapture(w http.ResponseWriter, r *http.Request) {
+	var request StartCaptureRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body StartCaptureJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type SetLogLevel400JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartCapture(ctx, request.(StartCaptureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartCapture")
+	}
 
-func (response SetLogLevel400JSONResponse) VisitSetLogLevelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartCaptureResponseObject); ok {
+		if err := validResponse.VisitStartCaptureResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetReadinessRequestObject struct {
-}
+// GetCapture operation middleware
+func (sh *strictHandler) GetCapture(w http.ResponseWriter, r *http.Request, captureId CaptureID) {
+	var request GetCaptureRequestObject
 
-type GetReadinessResponseObject interface {
-	VisitGetReadinessResponse(w http.ResponseWriter) error
-}
+	request.CaptureId = captureId
 
-type GetReadiness200JSONResponse ReadinessResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCapture(ctx, request.(GetCaptureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCapture")
+	}
 
-func (response GetReadiness200JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCaptureResponseObject); ok {
+		if err := validResponse.VisitGetCaptureResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetReadiness500JSONResponse ErrorResponse
+// DownloadCapture operation middleware
+func (sh *strictHandler) DownloadCapture(w http.ResponseWriter, r *http.Request, captureId CaptureID) {
+	var request DownloadCaptureRequestObject
 
-func (response GetReadiness500JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.CaptureId = captureId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadCapture(ctx, request.(DownloadCaptureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadCapture")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Sets the log level and format dynamically
-	// (GET /debug/log)
-	SetLogLevel(ctx context.Context, request SetLogLevelRequestObject) (SetLogLevelResponseObject, error)
-	// Readiness check endpoint
-	// (GET /readyz)
-	GetReadiness(ctx context.Context, request GetReadinessRequestObject) (GetReadinessResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadCaptureResponseObject); ok {
+		if err := validResponse.VisitDownloadCaptureResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetConfig operation middleware
+func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetConfigRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConfig")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
+		if err := validResponse.VisitGetConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetGoroutineDump operation middleware
+func (sh *strictHandler) GetGoroutineDump(w http.ResponseWriter, r *http.Request) {
+	var request GetGoroutineDumpRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGoroutineDump(ctx, request.(GetGoroutineDumpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGoroutineDump")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGoroutineDumpResponseObject); ok {
+		if err := validResponse.VisitGetGoroutineDumpResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
 // SetLogLevel operation middleware
@@ -428,6 +8788,109 @@ func (sh *strictHandler) SetLogLevel(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
+// GetProfile operation middleware
+func (sh *strictHandler) GetProfile(w http.ResponseWriter, r *http.Request, profile GetProfileParamsProfile, params GetProfileParams) {
+	var request GetProfileRequestObject
+
+	request.Profile = profile
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfile(ctx, request.(GetProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProfileResponseObject); ok {
+		if err := validResponse.VisitGetProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRuntimeStats operation middleware
+func (sh *strictHandler) GetRuntimeStats(w http.ResponseWriter, r *http.Request) {
+	var request GetRuntimeStatsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRuntimeStats(ctx, request.(GetRuntimeStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRuntimeStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRuntimeStatsResponseObject); ok {
+		if err := validResponse.VisitGetRuntimeStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDeadLetters operation middleware
+func (sh *strictHandler) ListDeadLetters(w http.ResponseWriter, r *http.Request, params ListDeadLettersParams) {
+	var request ListDeadLettersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDeadLetters(ctx, request.(ListDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDeadLettersResponseObject); ok {
+		if err := validResponse.VisitListDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RetryDeadLetter operation middleware
+func (sh *strictHandler) RetryDeadLetter(w http.ResponseWriter, r *http.Request, deadLetterId DeadLetterID) {
+	var request RetryDeadLetterRequestObject
+
+	request.DeadLetterId = deadLetterId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetryDeadLetter(ctx, request.(RetryDeadLetterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetryDeadLetter")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetryDeadLetterResponseObject); ok {
+		if err := validResponse.VisitRetryDeadLetterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetReadiness operation middleware
 func (sh *strictHandler) GetReadiness(w http.ResponseWriter, r *http.Request) {
 	var request GetReadinessRequestObject
@@ -455,21 +8918,221 @@ func (sh *strictHandler) GetReadiness(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xWWW/jNhD+K8S0j/KxPQBDb+mB1mgWDZIWfVgYxYQcS9zwWnLkXXfh/16Qku0kUo5F",
-	"gb4EjDjHN/N9w/FnkN4G78hxgvozJNmSxXL8OUYfrykF7xLlDyH6QJE1lWtFjNoMxySjDqy9gxoulNL5",
-	"iEZQDiGOlhXwPhDU4G/fk2Q4VFAMxiFKaiG9orNT4qhdk50spYQNjd1+7Sy6WSRUeGtoyH60ngjE2lJi",
-	"tOEpBGeDCrY+WmSoQSHTLN+MQx4qiPSh05EU1O+G6s6ANxMdeEuMChmvsNEO++yPWy27mKba9GP5LrY+",
-	"inB2PyXRjqmhmLO0mN76ONGyv1rilqLIf0hgJGF9JKGZbBLsxZZYtueQt94bQjeqdEB4TjRV6jWh0o5S",
-	"elpUr6X2GVITI3cTsjxlF4NFBeQ6m9HnsHuowHn+uz9vvkwtfxyvhN/mVop4SiZbknevlE8FO4ppkMCj",
-	"oQrBaFkIFkejl+R3qvOew6mGMUHZXbutL4rzjlFyPjq02Wr9p/htlcRNF4KPDBUkg/IOaviKPvGsJRNm",
-	"upvdrVIu4yH2H1DekVPi4mpdtDqE+j1QLAWVl0GzuZfm4mp9D3cNb+bL+TJH9oEcBg01fDt/M19CBQG5",
-	"LXQvFN12zcL4Jv/XUEHvj0nWCmq4Ib70zSXtyBTPiJaYYoL63YjSloSilHspjG+EyU5zsd4K51mE6Hda",
-	"kaoK27KLkRz3NkInYVE7Ru1IzSE3FWr40FEsGuvbaQYM/XubkR7FWKqAgYoKPmLMxPVPyWaC8peA98J7",
-	"AXlv9Drog5KnsL9PvcroE09h3WR99qNfGPtmuTyKjVyhC88yX5Rgp5U0fiuOEzXVAEcf7xc/NWo9A896",
-	"95xPztnE7DyMc+kbkYhZuyaJLuSpVyJ1UlJK286Yfcbw3Rc24OtI2zxyi/PSXgwbe/FwXU8gWrsdGq2E",
-	"dqErjA967TdIbmX2SZ21GPf9sKQiklMrBDp1lIraO7RaYq6kAsYmDxFYdNiQzcVscrRFeU7/eXIgfyE+",
-	"vcvwH9XxXHPGq2eiQaMNcajg+/+ToRuKO4r975ZHZFw/XCiCnApeO36y99m7hJt63H7KbPqQbUVvBRV0",
-	"0UANLXOoFwvjJZrWJ65Xq9UKDpvDvwEAAP//rCGoWyoKAAA=",
+	"H4sIAAAAAAAC/+y9e3MbN7I4+lVQvLdqHzWi7GRza49T5w/HcrK664dWkjf33GVqA840SRwNgQmAkcx1",
+	"+bv/qhuPATkYPmRJTmL9ZYuDR6PRaPQL3R9GpVo2SoK0ZvTsw6jhmi/Bgqa/njeNVte8Pq3wrwpMqUVj",
+	"hZKjZ/EbOz0ZFSOBPzXcLkbFSPIljJ6NeNe5GGn4pRUaqtEzq1soRqZcwJLjqHbVYGtjtZDz0cePxegF",
+	"b2yr4fSkP6n/NDhn6bsePGXdGgu6P+EbvgSmZowzDXOBbaBipW+dByF+PAgAJS0XMgdC/MRwhjE7gRlv",
+	"a2uYVcwugDWq+oNhStYrpjSr3FdWhl7jAOYvLehVAmeccTtkJ8CrV2At6NyG4FdW0+fBTam6EQ7dl9PZ",
+	"a27LRX/el5d8jvuCCLhR+qpWvGIzrZZsDvZH/8OF5bY1Y3a5AFYuuJwDEw5TE7nkFTCxMYAwzFhR14xb",
+	"ZhfcsmvQRij5Lfv5zz+zJYIChnG5Gk9kWOsCeAW6W+3p7MjBvH1lr/gU6guoobQqs+l/b6egJVgwrMaW",
+	"zPimTAOOUloh5wS8BttqCRVT0/+F0pqB7a7X5tsFm7Evr0Ha3I5rMKrVJfzToSZsQs2NZYCdmIYSxDVU",
+	"BVKoBtMugXFmrAa+HMIaTnlEcx6dnuwA7w112YTrLS2fzkieDP2XQ8gPZzINL2HrBsnYanDe8PmgyVWV",
+	"mRd/3bZIVR06z5nKcPczVW2ZpVGHHuRzaJS23yu95LY/2znRsCdnbMi4Yf/vxds3BbI0YQ0TFpYGf31x",
+	"8U92I+yCceZoiGl1M0DzMzddCplnj6Nno/81Cs8wyHY5evav8Gdprkc/FdkFOLLPAe++EL4Kpuh3Xtcr",
+	"9kvLazETULHpis21ahv2RxjPx8iyTcEqaGq1WoK0Zsybxvwpj+xw4A7E+IWthOxD++MC7AI0Hs2Z0jdc",
+	"V4R2g62JSUqow82y8w6hXnn8OgA9WFOlauCS4LoUS6iFhFdiKTKk8Jq/F8t2yWS7nIJG7uK2nlgJUskQ",
+	"f6PhsqA8ffKkGC3duKNn39BfQrq/nkYYhbQwB70G44WQuR1/i3etp0iLFMpnFlG6EIZZsYQBEA2NloLo",
+	"CfTZqOIWjnzX/lYGcN5JK+qt4ExhpjTshKSlgW4DiV1tJSle16rkFvn95eX/jNmFrUBrvFeXoOdQMSGt",
+	"QmJTrWU3C5DMgB2iLmtX+Q2d8drkieud4XPYwrUJVZ7FKOnw1GPfG2Ck37cdOJr7Evhyj2mhm9W4GxQ3",
+	"DPiyQB5nVU0swy5AaCbao6u/mjESQKl0My7V8hibOsFgCHfurt0Gb5CSMhvqv7CE9WQYUxCbtjKmwF8T",
+	"ZodwWW5h1tYGrMnx2484pGmUNECKyHe8OodfWjDEMZAvgaT/8qapRckR8GNi4c8+JJP/3xpmo2ej/+u4",
+	"U3KO3Vdz/FJrpc/9JG7KdSycymteC0SCm9hJ6bNalA8IBIquqgFNg7PSz2/cFUg8utUapGWE0SCMxX37",
+	"WIy+V3oqqgrkwwJd8roGOvlSWeILN1Ahi2hAI7NhNl0ZAvoDt3DDV8jsVPvAKPZ7zOB9CVAhn7KGod6C",
+	"p65grWnpQr/hguTumdIO+V7XQ01FWtCS1zTdwwF/AfoaNAOalYRG+71qZfVZ0Acdx2CVArf18F64w/NG",
+	"2dNlUwPyAHhgAI1Dk6dGPEZi3mpHkKZtiDHbbiEI7ttAm8/LEpq7hPhtR/W7DvwNN3iyNaKWS1JQrebl",
+	"Ff5pGGe1kvMj3UqJVBm7jQqvXxHvfKUclH0+/+78VeAYad/hSwPBfXsNGrn+59tCFSEgnJgFVGubt7b6",
+	"c7B6dfR8lrXuXECpZGW8/HazEOUiHQknq8UV1CukkykwXi2FtVDlkNTJj6RU8RWCeKnUK67n8Hm42VRV",
+	"K8/STBDoA+E7eRkh1YQEgf2/56KGB+cdnmM4C03FSEwmcMnIIyQLJhU6Dhp4hXAj5xMlvJP8mouaT+sH",
+	"RPJz1N1AViDLVWAqCBfRCRHqJjcJIvydwRgHzEkupAv4k219w4JJuEGqmAntYVLqNZcrL1iZzyIfrF24",
+	"mltgQZFLzzC3Tl08GlAawxKY7UZe8hUzICtS0WQJ289skUxypmpRZvScf7TK8oBWPws3bNpqY7+9+W/j",
+	"mQlpqjNR1zvk727Cc1hygSx8y8pqmFk8C6nM9wsCtP+6zsGAHWaCpBTS+DQu0vWsrUlxMVbpXVyv2I/V",
+	"drNIeG9TTutlxN289Z3krV0oLf7zkLzqtTCGblnNhNcMSg0VSCt4bfA8/Yg86sKZOdfBsvDeHpNx9MjE",
+	"71uu2Z6UR52Q9Da4pbO4GkK/X4n329SrE1W2S5D2HAxpzR9GjcZr3gqnU/FGeBtuBoZiVInZDD+QYWEX",
+	"9k7EbPZSWr3Cnn4orjWnvyGIxJtmA9JwWeXhZDN3+xR9WISs4H1/hP8ftDqacgMVa5ShGywczziokBuy",
+	"wSZJFaMr4eTl3rTSG5qzH6KFoffVkNshVX5LDdyJDd0NPCpGrfR33qgY+cVnbY+ddv0vj4o4Sdfemf9x",
+	"ftr9oV2v9Oq8TXc8mk8iDH2bc7TFBbQa5x7Z3LEEqZrmN3tTUI5kM7Rk2tJdGPtBSfwAKkbdjCF+Nsqa",
+	"/FIcexyls0XkdCsbQD35PPt4L9VyGbhB76hBKSqontt9LXGxy3er7IDwvhEazHObO3XgTkRwzzLfmLWy",
+	"BmOYIGbsxx8Ve8Ij8icoqhU5D/KmqtPp1gG2ES6Fo9KI82su5Bvn3+hNFDXQQ5AYOw2gUccztINyCdiO",
+	"aPsMAIVFHLTwXnFPSEg29F+3B7njj0S4XHKdkUdeg10opwO9Oz/tbD8bnK4byaIqYvdWTy9d8x4HQoDT",
+	"jY0Dd7BGHKwjeX2fUjrddphOoBThmho8VPm7ZV2TDrhn5EaNyO8z3EFQXgmT4amRwe3L6WisPnvbRDSN",
+	"tg0zHZPP6lUky/Q0fJKG8ZhrLkfFxlJQZeyPh644p012BkYvKKXUvt7pb5eXZ8x97HXbyYK3XW9tJawT",
+	"NvoyTWkH5RmwXNSuVeXUXV6fJb3XPFbddFXr0PbarDEWIe3/85fszRfFnQEmuccQW9nm87PTZDPpHkbi",
+	"JoUkKz2p1pZqCSk/8vehv9ZaDSNEkBQDLKjRQpaicffaEBs9zV8BTl49bfIfH5i3Ee0EvlaM6GLY3/vV",
+	"44K+ZYeeIlBgwhMD+tdIaTth3wmbiaPl5KglWF5xu1Mteu3bnfG5kMFomWNTyZCDa7uMe7TBxrvoq7uS",
+	"wXXirt+Dv39Xq/JKyLmPhVgH79YgcJPlRRv4S/2LMUaFuuYQ6SPg+lAG4vKKdk573qmK+fC5LZrYTEhh",
+	"FoeJWQOyYVC7tpGfX+3fsekmH9qMDCR7DLHDYDFPlpSDy4j/ZFzEF+I/iR+tVLqCiiFdoyo5XVkwo2If",
+	"Hu6BOARRfbnRm/bzqkiPUxej90fY8+iaa6IqHMKj8DyO5H+4SAb0P3nz709ZXkfblch1KdPbpL109VuI",
+	"+O+eAjaNqy/O3rFGq5mogaIrJIP3ULbuvtPupETVumkRlfTrQQh5cfauW/ml69+Bdhcc2A/1CXJe2LvO",
+	"9b3zzMcgia+fbFqR/qZuyGHkLJRI2aMkLubrXXExtzixGwul/tl1uguA4v9yBJEE27kQw1LVNYnvLuaT",
+	"JyG5+18tpWpz0yGa1Mx6NdnNp0qyufrpyIJ+AdhCUeAj/j/LBGLLQ7nlHgxGyGtVX0PlAh937cvpeuuP",
+	"xSgCvjdoSzCGz2HrPZ0PnyZvjsOkm7+4s9s0SJi5wGmPBXcruPgbqLptzUHhfuitA/FTIzP6kWvPR/eQ",
+	"DzuqzFzy1D8urMNub2cDpabklGzfrgN1J6wsGe/XIE56eE7lTA3LaxlSbA3FoAV2wTCgyezcSxpwCxh3",
+	"iGFa0SdcGGRePmmXTR8eAxYNbAeARINduG47gYrDD8MVhtpzxy6AdClSAmcC6ipwEj/VmnXQBcKMz5TO",
+	"HutrXreZKf6JP28MSzHH/9JQcbxgfnIGSWag1GD3I5UwXQ4T3XOKPhq4tbBs7GGyOzmu1qLw/TA5NEC4",
+	"Ybft+xtlxcx70eKJdzLnHYj9Rsir7RcFtqD/yAQQsnHM6OnCHoyXJgnLLTrEBiwm69m+SXdxtLvRPuFk",
+	"dx62PtnkPa6OtkNoMhn8a2LWLki43+GVuAZGlNvFESfdVJNqJrxyJuyluna3WFNnRfCPhYsazdsUG4UE",
+	"Hsk3hIDQad+506oZ+cGzCNNcyDOt5hqM6SNt6jX+7NMHw24WyqCUIMpIfBpm4frIHLW9KCE1M+QcpTjd",
+	"dtdWg8DNlQQnibpTUkFWQJT+AUlfxlGW1ztnscqtf7exNkBAw3bLKDokD27QsGoDNVh4uWzs6kToEy9b",
+	"bIaAbygKtfEwuxW05LAHPwa7VnW7BFOwWhn/ekpoUu1HRc4bqqJUecikUlm25JLP3bMPTq8oNOor+luc",
+	"csW4JtbGNHi/MIPawM0CNGQBmWtewhlooao1RS+F4gdswxpqxObi2ukmwMsFQtV/NOii6f5g0pCweTIG",
+	"XYLJ68Ib6cL1JZKeSLuNc+aQqEc+yRGmdSG+g4tJFdUrgMafQzkPfGkurvGvttlvYeQ5ZH5W9xzQ/dSZ",
+	"0XUrjQ/rFYYmL9jNglu49tzT98bToei1Q+xrxqOdD0p6xL8ebpKh/uis2KC26LpwMb8stMzMsVVgKAcc",
+	"qYmOt7Ep7ZLLIw28wlg7P3uns2z3CmzEp51sGPQLxvEUmSRg4/878qzh6PSk82W5oLAxw1As8mERUTrN",
+	"DimCo91oWsPSPdDscz6xBGP5sukD5dDSNShu4xUI0kXAS47v/fAC36VmrqSyab/XnQdrHbzwBTGH9jEr",
+	"gjrjHoqwOddTPodgGlE6CaRstCrBxFDitbWpFkMnI5zu3VWwD/zwIoMoWa2JmxsT03NZPqW9TMQIF3W4",
+	"bzgBxobl5v4b8Iah3ZZxm8TrYvNkfrfOPe20sl1+j5y+cvPt12Pvtg1vDVzixfhmX/+hhhKkPcOO5k2G",
+	"BVxY1RzZBRzdKF1XjGYw3Y5YMCkuzEbcZyKp7AHLVmHV4WEdgxsr7q0mbm2xRu65c3Las29lwkfoG1mI",
+	"ybokDONT1dpRcViwGwmcZ1k59YxrG33oNF2B4UQLF4Afn0i6JAHuYeygq+OuvFttVrfKWVuLYePFa1gq",
+	"vYq8aH3V360snuiWgqhkeFwefiHZU7cSj+/4NSzdID2c42PAPQltpgH2JcoF8Ob5AWNj+9OqhkOay9Yc",
+	"0v6tf3u/f49zqIEbqA7ocrHad4IloX7f1sby8uqQFVOH/aExe7ckDWL/rd0geEdva6O4yVOS6VCZEEa6",
+	"6Rvbs76/a8hKENGhPJBy/sT1rJ99IaDVJie1vaDfSURtuu5ZUuHmdVa/j+90F6CBtJAl3s7xffUMXNqK",
+	"zLvaFM0ewm6i3FLjG9xLWDZ4KfVXyqVUlpaxJSjnw5CFPplrbZWZDvRO9hPnGGTUHjPmB3zeb7YinVmP",
+	"CzYVsjJMqxpMwYwK/gkf6r9sjctj4Tvx5R+MSx9gsvphiHUw2+5IvKzipYIC4TXF1AWX1hp4TnyiN6b7",
+	"WjbOugHPk8en2+WHEOwQwN+LkO7CItcb9BMMcxiRelEuoGprIecX0YV/ByEurTRu4PC6adfJXHM3jTYH",
+	"yEO/aevdposeFDg3YP/dEsPx40KxkpNSE310BSbp4XKlZD5kogsge/iQsM5b2Pkh0NKDVoahEJpsGJnz",
+	"BIZJNoNpc/vWvSHt7deOGJ/O5nEfUT4beQ/iXKcnuT63iOdJH6pmxuvMvXuFOEf78F0F7HSB3oeF7tw+",
+	"OnvIZY0RGGStCh0KRrln0qwzpuQ1HEajkTi7OCCPwvWQoO0BQP0N6LMdzYXchY11I/+OEIUGdJkNMMFA",
+	"gRrwDwbGiiW3kEbGPH3yZJdF05/6XeCeu2b+ktjEc4BvK8J+NTGUuYsnB3mUDOKlO2jzv61EuGEi7UYJ",
+	"entcZ0GPx5kl746arYk9ORPqfECqc9JeNwJfFmyKeReCGZpku8wMUTwZEjmjM+gWUuuGO48G+GQU7A7s",
+	"SYbm7OTNhUtMM46pCNF+cNVO4ejPpHRoIBt9Ne5mS7CQzZ9zSa8fb2TIeZefMT9ep37s5blPUYGwbCVp",
+	"qNZyDe1/Iju62p8gBk9xIswfcnkl3b5bbWUAZijbnmHBgyXk5sb4X5SunPazYjeggXVPIe9Or+jI5hP2",
+	"vyc8b1JC3LVN1O1SYXJr6FFLMBF2kmRo+w//yntftn6gGZCGd+n5DqPhw1QumuadFbX4T8z4s1Xj6vZj",
+	"WPfqDdqnU5lkmCAT7SZa1xe84HpjG/5yeKzioKBx5j6gt0TNGM5FLpzCve6POdHogzDsP6DVfr4alwlg",
+	"K5feSU7pdd8tP1glxj7Qeohh9z603rjZDfX0mydPlntFUIVIyZDgQHd5yGiD/Og5kjgHXgkJxgw7V/d1",
+	"cW5xbg69eYuzsygfx1B+4BUlaFP23+7/Px3moLwMnzrnaZisXEB5tbdz7bpzhPRSOofsCSHz7c7tiutM",
+	"OsQ15DfI7eW7/B6gb1NIthR1LUqlwbkfluSriC8wIgZ85iMKvMDTPZHJDWRJatAh2NM5/luclhL7xCMn",
+	"7AL/XYLVojTOc9zzzFIKjX3t2GXTpllV9usREbJHc4ePg2ByXQ4Ey3U6BLIdnFENvUzaIvi5BMgZOVZH",
+	"6duZR8kZvpNiCYb1PSqSHe5hagPbWZpWdT3l5dWgZqPhWuTP3Ln/Qo8yVF0zHIdZRYkga0FJ1aYr+l9I",
+	"yxi6mK2RN093+ksiUENL6lTVvqoWki6dA/GMHkF9/VWezre+xyA1fLvnIni4TQNlkqIG/d0VHWdkFxG6",
+	"rL3cp885T/akbwEbSIWRQuKV/vUcRsEcErMHZkGYg0zsd3sdxP3urABTYpW5K3OAmjq97YdDYaeIgCqf",
+	"coRuwgNpSCfNN7PRGyTuJHlwbFvsM3Lb4MW5lTBck+pAkG/2z7XK/phY6JDHpblS/7STu+WfnNx0qVq9",
+	"dpNQYHZrEzT3F725b0WGH8R9Tw52kkVlW4TUuYssGAiTmpe7dI0QYYUHTW0L+5irJX/faO8q72/bXGnV",
+	"WiFh4Lu7GHY/eukCLVz4EL49zA6oGpDfixpOPI0obbJWdcnocWTVNQv3ow/1iiFYFFbqvtTcIqmmqTkb",
+	"ZejjcvcT1oE0MttCy7Z6ThZIQrnVXTD/LRoXfISbDzjZHQjQbXrE9tper21sB0q64Li3BdJbjkgv0Ha+",
+	"5cK/Gx61zezcu879MDlo0/yDGzc5+r9OZVm31bYLj5oxkFYLMM6Uk6b0TjMNZm+8nVEJqq5A+2AEGj4K",
+	"6H77Q2LA/tiHWSICKjBL4p5+3w78YgNf23BNE/SVrBCr5l42d3hdreX+9mabzF7FVyD7Z3/Y69nP5hu/",
+	"aJHyCmx8TINDDyqvt0yn4RsNvhAmFeQ2VipSDL5bpQtJL0Zv2es7MG5r51pXb/NPJbUozfM0a+nAkStt",
+	"y2uvsYbUpy5cI+iqmBheqD1idqJUEPCRAWSbpW1T98hsAr3decFbk1nS2ju7q/iaeyzUset3RH5/1nmA",
+	"cneGvw8OMXJ7WX+bIC8MpVUmg0rQxTaKAiXqhVV5FrTkczjQoJ8qhYfGusW+ceoUPd2699nLu4io2Rzz",
+	"EwJqwlD3ZCAK+/p7Mgf1nQcnUYfIP/L4NZuPbqObXoNO3Cx5XXO38Wl/y3TqVSk+1aTUh3/nubh/h83a",
+	"dJ/vInPiuVmoej1UesgXMnjxZa67buThqw8HFD7NAAX7u6cIodjbO/b3vxp24UoIjIqRqXl5hSiF9/Zo",
+	"AXVz5Mq39N3z32Eaf1kxTOeGUb1+qBjoQZqJsHUyzfOz08TQ/mz0dPxk/CSojbwRo2ejr8dPx0/8s1ja",
+	"4mPeiOPrp8chpSb9OM/lgcZrwMTUm2bt2cgzHy+QZL7uaj4g/6XPE7mRf9tlES3CSx1+Be6inYQ0mJMR",
+	"OhqmvijORE5i1UgzGXUmkY16GzRjF8y6meBwPJEdFv0jRszozZZcX1ERMQlQ0XMxPxnFJXBpUO+o6AaY",
+	"yK+efOVXBsxHdcX2hX8fjAUUhAmu7lBowb9SKyZSSGOR1NUs3ETuOlnL70eIfx63p1irwfmvbCmhWhib",
+	"7JTwlYzIUjRYqsv7afqleW6fnPRjsbt8VwfmJ5Tw+uawCl4/bZQP+urJkztLFL6WCjRXGyDu5Mdi9Jcn",
+	"T4bGiwAeJ8WNqMvT3V3WUqBjp6/+a3enzXz/H4vRN/vAt17ahnp9vbtXUieEuvxld5eNCkAf0/heOiUd",
+	"MVEo4tzQs4/4GyUu6zG84w9dJdqPg9zPlSA0pJL75nlup1LWNu4d5h8gnuX+Uc4tv2tynJTbfRAS3ka+",
+	"tyfFfYijK0pFPfagjVjY6Mug9h/ApqR4W3L3PzuznzJ2qIwzGHy86G+5LkIc7z96GS+oIifV6PAHQdMd",
+	"yISZJPvP+JwL6arnCuOyezg9azgbMY4Qa9jyoIgbDBz0bcN6xuzcP7bJChETqeTah1sIERMZAfu2W6ph",
+	"JRkmPCTgM0dgUoQkC0DmZvfIfZvErH8iRyCIvvNJmu+UGcQs2xmmEL4xn3B7zC6osop0yTXC61DEsFSS",
+	"uOJ6RcCPn4mbve1IOeT+9jT9oLfzA7DEJ3uwxFi+8Mvgof70IZmuvRq5FSN18vAwHz2H8Mqux0aLLlOB",
+	"ZJIyiiBT3WBnhi9hIhvQS2HorMWE9TiYsDn+4iZ9ZC+fn71EdemRrfze2Yo7dIdyFXKa7TC9rLlYU/PL",
+	"GJOn6hUpI+5JHKogvgSUq/zqBLFKWKjIj8h1SKfW5QZRc1bDNeAQWrXzBTuuYNrOj2s1H7MzbowTgdwD",
+	"b5/QAk124W14l2sFT+GmNFYLYzP2HIQpEcPG7ELIK18/SKExI5Qh9wvvsmsEmLmsJvIGpgulrig5oCmC",
+	"QDbFYmWgBVSDhpXgCBWw07byIi7cRTbAtVCtIRwMmCziW/hMJe39IvJ2G1ACQTyc+aT4kJ1g7XnfcGG7",
+	"fOfOEHtw1z0LcPc0G58LyFNT7t1jbrZYwOGQqXaU5MjNs15B52CkdFUl+ga9g6p7fCyyJsZAdTEF/kNU",
+	"ut8Pkvstcn+vhpf1Ch/ZyqKNfx2ydhv8ysWKB770n+4lxKTFpj+XrPAPJElnxKDttJqLNWOOi6VJJQXP",
+	"KHf5aRJLRq/s6RxswYxLdj5dkZFjnL0cX4S57pHm0xzZGYI/h7nA71DF5XwGK/jnsmfrzOo76og/ZQnk",
+	"+IP/H1n56tVWG18tXO0EvepKY5KAt2xrK47ib//z/PUr9kelGabn/ZOr+eX8YS6x5pERlc8JPGYnXZ1F",
+	"DbHWopD+0ScVLJehUrKtV88w/gKvJNRo45TCdKn5hfRGRrL/hVAMPvXpHb1tEHXhl5ja1LcruaYbgjNj",
+	"dVtal/RTzGZsCvYGfKRqLa5jwjh3hUwkgriRIblgYi4VXgY+l+hRyOYaMtI7iyYlSkbZeoLlNzRYVyQG",
+	"R3R3CeasLYILcSIx6qm09Xg9+qnmxh55zB2FlKXB+BpioUiiQCtpA9wd/WVBKF9y40vBT0Z//vOfJyN6",
+	"FEP/Z390a/zTZEQw+B9psX+ajCYyBgYHRaIasx+FXTBX/fK/3eKZVHaBuBCGNaCNSDy+MJGEZLNQN4ay",
+	"pbIb1daVH2/AIFqvXnMpZj4O4jB7heclo49F7qWjM+SSlSD8VcXsRySUVYrq/xsgDWZAbriluPk9Zah3",
+	"tKI7YUVI/1tFDcx+GWv9MOMBECld4WvX5jAoL/kVoOEatFkIeklIY1GGX3dbuOy2s3Q1hiWO7HB+8XC6",
+	"BIhO+R+AVOkS8kqJz6bcj1zs1wGwiKvJyGFqMsK/qAIzt0C0SGQYuIUnU+fRzsHUlXftS840w+inAbFw",
+	"H/PYii/rneWdH9R8FYsBZ67fM9Ad8yf+x0J92wcVOQ+1Sz3d48Y+4yu8sC+VesX1HH5zEgJtXVr7aBkY",
+	"pzt8vRs5ER1i052yg68gvlvadA0HSy8V1EhJCLYdXzJpIpeKJJ2SZIDEoBUEhytonHH35T9fvrm8+Pf5",
+	"y8uXby5P377prubVRFKu4TBo4fKDu+8JhlzWeLqclmMWQ+3D/YnmGjTG1+CSKM5JXJ4C6xyQ6MDcZtpK",
+	"vIwOIamLsZ8Fg6rFY2n77kdDj/wnMqRSp2ztY4bF1/zGfvPkaajGvFbuyrlLGUiMX8tavC6A63LhcHsv",
+	"t6u3jbmld7uIOXcDIRyZUjXJzYtb3JqW4ztNIUNWmPHd3r4h21WXpTelWRJPEcbOuHimhgwzodDd/pOn",
+	"aRa2TL5lxYfNd04lo+JSMJLw7WyGtOYq+HXpCAemjDWnDjY7UbPczekqZI2KUSiQtb+l6borKL92yD+j",
+	"ySkBifhVB9P9Gp+K34hJ2uPndxHQ16uVttUs55b+e3PzPRrwBmQwd6EmQs9mKcrUXuN/2CVxdZLAsMnm",
+	"hQZuYT1pr5e3YppeTjIQSQy9BD/FRNLjgnPUxAv2Biy+eznDOGjhn8ycqxq+ExSr0MtURyaOidSqdsmK",
+	"qQ3+p5+SeMxOKUFr9NKTHGMVm6IrkZZRFT58PD7odUxU2Bhh3a0Sr0svxk2kF8cu11o43TpYHHj3Tthn",
+	"Up7ICmKGLhTyXJUqw+A9L1EExUY9Gc8N0jkwXTD7pBNIUkkvJ3v1Ex3eXgK7p2CM4VyMe2mlT+8ekDSD",
+	"XobvvsllrH6Msfjdx1hE+kgZYMJpZcdC9+e2xx/i/z92JcZyL/Rr4u82SZ4e7XkEUkKMqbJnfVKEJAvV",
+	"mL2NI1CFPGdjk354b2StREWGSceDkAVOJD1IRBYPY/am0x0zvZoOWRpCmbqJpIvhL0/+q8fp3LrXQzUO",
+	"YXQnHXe9A1ZX7GyacIj7lAMP50nJNQPVt0nFeLxO4+3kbp9HrvX751rJwbgvvnXcqMocf2gUxvZby8vF",
+	"6NmHTx0Iq+vfwTC1mu98XhNiusj32BVP4gbT0wjJLLy3BavFFbCfvccM25ufxxNJ3qkZJri6CZ5A2hVm",
+	"rAa+pJA5VgvkraR+U6M4x0QaqxrD4isAAdKySphSSQmlzQbw/wD2TFWv1Nw8EIPb3djVDt09fVh3xpx3",
+	"Qfhy6FJzjzJuuuqUN1pYC3LQp4Nb8IlOna7YqJs+ViGBpLycohKQlJUnViPJAGS5qF/5ZD77WD2e7GP1",
+	"IEOQM294EDEiE4mH++eWS9R6jC9iucUqdRGb3JlJ5kzDTLz3Dn2EzqmBwhp2/v0L9vXXX//XWinDLNbC",
+	"d/OJW+nOdnq0U1tVwSzopZCkoXUnXkhjuTPZ5WMNXe/DQNstHiB/OSZWs9NHt1kjIECObO7R/HPbq3kj",
+	"lJpXA1dCcmniFYPm5PdHjtHjDjkN9XY3VmIa2Ou+CkYRn1G2K4e3bp5BWS+RB50AfivZnUXRfSI3ZPc5",
+	"2NsL7j+AjdCcpVj4coT3s3W7EArrj4L5F/Ga1icr3Nx9x3buR1b/EHINfXQ/fzxuQnqsvNXXlUllJ0kC",
+	"0PBUwzRQjql7NWYXPiXoBXj/ovuApgXNhYmhcqqmUB2XvpM1XFthfa5h+u4yBrqqnt9uephCY4rPM1Zp",
+	"Z/3QYNolhFe93m3eBb+HXJ+GqVB92L9TEYaFPKP9aFRa+Y9dltJfh6wdAdpz4H3anc5ec1sucuzwqz1O",
+	"RsDa87KE5vHB2egvT/fA2pkmEZzo2bnIvxQ7Kh6rtWTVxO6SdMCB24Wf7pDZaSDNbZjdXWoxp/BCHlmV",
+	"70Ng1jXlbds0B4SV+KY/uxhb3eXv6JiR1by8SrkhVubLvKKlgR65zyP3eeQ+d/tS1h3mB2Q47RK2vdHH",
+	"74HdUKww7/StJhVB7oCntMtHgeaRpTyylLtmKe3yM0o0ofLK7nDp2HbtLYgL1CG1i5Q3Ar7T94J3Aq2N",
+	"uD7QsdiLa5pofgWlTI8pArIP/HIlY36rnOg+zUfZlMi514qe5hbCWKVXvzde9YVkEUy5h9/JB+UivlrU",
+	"VkHFqmD0bVTVxfwRmNFIE3jMkIrUykqxoyOrjkLLO9CWPPRfoGxz9wF5m4XD9grDe5SqHqWqO5aqsPBc",
+	"x/5CDTpUlLo6A/fIEV0+lkF2SJJSz2AdTeP4hUZgpp3Gt1ifyOaovM8jj7sDHrdWKOmRwT0yuM/xjIPY",
+	"w0MJeF114IHYgkZpa1L+05WNzCiEic6XzTcdmIKv1fmo4Q1JWl0901yAj6uYE3ckJsx32fwJoJeXfJ4p",
+	"buQKMoTAp0AOhSvrMDsi9oquU2FNyMm39e3lx0e18jcaYLBOPHehVKqK2I2q4OMxmpGUHJaTXlN9C4QD",
+	"27NWGvcQmCrDGsWkophPKoZEUQPuQQJR5hhfRBvGa6rsGQsn8VCIz71E6LGfFwTSG1Xd7wsAVd0ze8AZ",
+	"umfTw1wC2zG3D48yxxdwsB19Y4SQI/EYHITn8rDTW2kuthxeN1NyfFGBgWtRWpd4n05tqvLQN7x3np+d",
+	"OgPQREYLEE32MyZocI1MjC2crhjHs34ijG5p8u/aag7WByBaLeLjIVQD1WyWRNNPfVMMACdQl8FiTRO6",
+	"mGOGCtVEnnBYKonJe3AlS4H72PEecyWaBirPdqSyIU0SATiRZbSAU3fq11JkE+WEPhGaXavaufM0uEyy",
+	"hBF058V3WF6fCQAL+63Lb3QjDCRgU4iTS+Ac3qZibDda3jCvrh93MOZJJwIdAWqswErutYqK5kTSTNnn",
+	"VPjhATno3Wt4tII1DW/jQR0hWTWuTsJwcm/aEJ8da58k34964iPPzj3DInK7C5bdygNFrlTgcm/Xe8f9",
+	"nR/zC5OZAiofT+AXcAIDjd/2EIZK4oMml2DlDSaXQVvLa9Bz70frcnhAmrWKy3AFxUwaa6mXJ9JyPQfr",
+	"qnAUG2UReUgxxm2sgc7ERiKjAhONJqOuNlu7Z8IuSsBJGR4kCUDvRCYS3lP9M7laKr1P7v2B/GQuq0Xy",
+	"PP15ulYSpYSvbc7EbDOxMK+Nmsg4nZtmM8N/LwtaTPLhMIAZ/FnJ5UR22ftDiZS14upBrsLaBHNwTgkv",
+	"Y7LpaiIbbmKoO/0Yng0i7oWFJeP+JehAdrWJdHu3d3q19AFNddmVu/91GNxCApi7dA6ERV4IWR7U4R3i",
+	"/ZAOlK5m8MLawS3DVjwazr6MtExhw8nCFhm4GHp2v2dipnjt3On9ks+L2GUi5JgKkqrkZu8fll4/qDwB",
+	"m+fY0AC/eggu9ch4HhnPl8h48if7cL7ThgL5WRZz0S4dg3lx9s6Z0qh2PP0UzPRkdUpBCsUrC59MCGkc",
+	"KorpkCRtgdyovY6ij3udV/iC7fWKtQYK1mDyjCTNUQPavYT28lb89Afjfq/5FGrKfRusOjcLVWM6OAfc",
+	"mJbiCmcvRV2LkoLdkrUJyaYrC2bM6HQaZ98z7dKXGA+vxH2OzgW/hgNqUhG+b5m3l/m0vRPp8vbmuW5a",
+	"o/8eGS9Nc5CMSD0ugS8zaUFCuX5K64mg005nN35jZ1lX3SmXzIESBX63yudyWMv4G7LJpr/hjKMiFpDK",
+	"55XdeUPher53STfu1SiS7jwee0o4UZrrA9NNoA5IZOq34vGC+e29VKAjtMG0Havvbgj3914XxPEvrbJ8",
+	"19MDlxImcjbPotdyg27cFCFzzEQuuK4YXRWFS18m0OcC9EY+ydXZgC5BWiRO9xw9ZboTmeO6rM90qUrh",
+	"fI1VDjBTAvrXz0x/NSwoxdcnsiAiuUcW9NtmQWuHn7VW1MJsli09hA910SuHiqxqlpVaAWs5dWFTzl0a",
+	"Q+AKV+F01tYGbMEqcitTCR/N/ldNWfAUe1PgsvAu6Ykk/601UM/G7HmcgBJRYOGMNIsniXbCMsq/QZUX",
+	"7IKTtVSDWai6S+kobJCtCXZcodJ+gd86cdTyK7Qbr8vXRSxQ40ydUqUQDUiuE3lXTDRE4/0mJdPvtavK",
+	"6S4i023AFDAZoLcdb93hoSRoYX/zwumT8TfFCN7jARDX8DrkaLO6hSLJ6K/aaQ2jJKP902y2OZdAfzDZ",
+	"XB3eJvWpM564/Cqww9naWg/L5/arubpyVPqJV1gkisdb7Dd9i8V9dGoRZWFbOycukdlhF9oNt+Vit5dx",
+	"+KLzmUdDpW1u2IWrynQB0vryOGNGxQqdr0kY0qhD2UGSu5GO2R+fn5y8PCnY67cnp9+fvjzBW+Xk5auX",
+	"ly9P/lTEEoddTuo/mIkMAIYIZO5CxUSs0Ofb4gcs6Mhc+cIf/UXEJ/IV1h8kMI9OT5gLdaaOLtWey73p",
+	"HWdOtVgiyvCujUOTd5Ogd2t0EzAloXC3pc+64Ou4cMs24P7WuxrptjPeDWeVwgfOzhqBIxSMs/OXF//z",
+	"5kWHSoP/unykLnKMRpm1de2K/M26TH0mBdGM2Qu1pAB7n/1UgxsMl7MAru0UODWjPLHezuSrjl8BNKxt",
+	"kk6SvTw/f3vuAaOC5V1m2bwv8UfEY2fGD0Ka+U26E1+hxe8C0P+h9H4djCWyOz25pemd8OfO3yNP379I",
+	"HbInUcI7ya+5oEClzacy7uAHjkamgtRQmvewESO9TZJOx4EPYLWbbBI5neeT0fGW58IXABN5M3DsokHT",
+	"Mz4n3A0e3Ac5ro8n8PEEbp5AXmplzIZn4uCDGLsehcwC+6Q0iW2TqUMFxVRdmWm13K8AeTyIlxGM+wxY",
+	"3JxtKM1HbNgt+QsqSi4zq9+dFjVurjn+kGz0x71SLJOFJwRzhM5O+AxPFpat5RQFAbJqlAhiJnJ0YX2K",
+	"df9InJDowu/TUgDdwDMhhVmAiZYiHINBzRsDxseiobTLSoWSK8X6rSfOQDHbAW0XIH25LDcKwsF9Xviu",
+	"XGAHVwW8IheyDwIkBFPBfnSQOmnzhktaK1b4QhM9F04Elez85T/evby4/Pfl6euXb99dsoae7s8pwN4v",
+	"xqeYNpQcFq1DMQjeRx+K3jD/fvvPl+fnpycvLwbMR3GI/n23YcmIc52eBGtFwyl1fzBWJGNvxvgfVAYz",
+	"broiBMUbvNtnq/xWh31wx6AaroyZENOaFSVadr5+8iRr23mYWoXdNmS41tv04NjHmBRrPut7UE6n9ygY",
+	"u1VyggIvjb8FXlrBtJ0fl7yxrYb9soxRIERFduhGq5mo/cux91C2Md8FsDDmegBz/lZ+Eea/z6qbbo6h",
+	"GziCcDuK7F9qZbempEY2n1NA0einj8VQ7hFnAHHR2biPfA3VSlMI+AayAyeiEJGJrFqHYBfa7SFBL4gJ",
+	"kScY24xREtJZbkouUaaq1I10VDmRlE1bWIwaLAEoTpyMxkp2IwZXClYwdsNzJEMryHgiaZR6lVw6ESvh",
+	"8R/RUrawNKLBb8rofl6R+dEPzxRyl7MPWZEDijFS34sl66kBXik3bZ+C3p2/Co6vMiLw1/L4/6C3Kxta",
+	"ikvt2q0pe676XO34g//funDYEzk6ajtQwfajn9yvq2I/gvmErTnoflzfmoW6+dSdOQ7MZy/5Paa4THhj",
+	"wTTwip7CTVfs57liVqmaNfj5Z3TSTuQG3xzoQd9+zj6d9TA+OKmo0oL1CvY6yUSn4FRITpLlXl6qiMCK",
+	"W77OWV44EI5OhGmUEXkmc9HO52BQWaJraWdN+Y8PRJeRx9zJmSNH1LmfZherFuEddpTANBUn7skHgYoO",
+	"OjJKzsTu8kHOZWLA2vBQyStndDujV6UIIgBuv2ZqNpE/+zZuDtZoIe3PaL4sdaj3oaHiQ44FZJ0Ouvvk",
+	"fjTDSbtsctvwcjaD0opr8Gtog9Ly6cIc8TbEFwzMsXPr5kqr1goJZl/TRHnlK7/rFYu9w765A+9tF63E",
+	"g3wNGkW2hktRFl4JrZgL/SCJzNi2vAqkIAypr0ggM6UHdvSHMC2h/P7KnMV53LrvRP5GkDtUmlDbIdmH",
+	"nXu2XlpyQzQF+0rNX8E11LuME5eUYMCgROkqH2InKmAea3FVrlo5sNLng6I2jMr9CekF5AHjQe1h6PAd",
+	"4plpFdRrpkbF6IZr3GUgv3o+oHk74N47sR1yT5l7ge7aZmEnxuBCMHKwfqqM1WjcTitc73B95hAQ6lX6",
+	"xfev1sLvwNbebs9HGaSHX/wzjszpeKXmgZkbX1WpchqZMeh0ThNCP8yFdyqveS0qJmTTOguYo1cXoU/x",
+	"OnfAc0P+zYg/0lI9fVUryZcCXwmvdh9kkv6OP3gRcbdxmHea9oxJsMcLaxs3ylb5cjyRuOlkAyZgl62F",
+	"92Ewd4m6/CPuKTAzfNlQCOENj0+AJzKo5yTLjVPp1uSsLIZC/9xjZKu8UIfhERVN6KWLKqbx+XlDAP95",
+	"qAatm7PP3jLm1Sa2HTathqPN61qVZlSMCE+jYhR5shNB8aYhxOHGLhDdrkJ5nw+gvwlHPbrmGgEhGvBg",
+	"Pw+z+L+/85P5P39I5vQ//c1N7f967SHwf16uAbK9Hmm0iBmqLExVR4G7gPbYwqpS1WzazmYQTs237CtW",
+	"tcvGJLeUC4JxsSXubsyz0sDrc+GESYjgV4cWpD1vJZrzuZ7yeXxtiqQXfAz8ytmncONYRwbZFznlXRdW",
+	"vSvtqLi97HLZbfjD2b6HNAmNfqclJPuwgzP6DntwRCN5YxbKBqvSDyrO5n9xkuWzhHiLiXQhyuQbEMaK",
+	"0tdc9l3Hr2F5Ybk1RY7EnPGwAenUywCZ0kNVs8/dqDTifeoha/PkCjZ4xHSrvjMlRPeH3rLJUlkx82s0",
+	"x+gDPKrBWq/j77Dyr3X2oWiqrV0JWTQUQy1I6fBBdryuJ5JbizfcpsU/s1840Qnw6pUHaIcU/dqxMCZj",
+	"CW9cDvPLcRcf0uqQlEzvrbPs55uUQX7z5MmOstj3ad7r8DHkojhJFv2Z+A2Ctob821Hg8YcqrhZNfxqs",
+	"Xg3nvPpHCy30CdMFqiTQkDhKjnLM+EI+ap/kgE/kTINZhCDNjlSdj32prv0EyXD4hsMT+orOwETOKKsL",
+	"jcym4Jz1nCT9tF+G4s9xhd0WH2w47LrmbYdf3QMdDl15a3uA0usvuD/V6KEtfLfyItBGrJPNNhqm5LT/",
+	"2eY1wJLieOHd760TJtmmmsVGIZV0F3b/MLqhi4FksBG9/3AYeKMs6Wgrlwob3qOOBXhSY1nSils+5Yas",
+	"ta3U6L7MRMR1qCwXUF7FEKBBUsHetPrc/XWCGqxqsK2XlEbFqNX16NkItctnx8e1Knm9UMY+++tf//rX",
+	"0cefPv6fAQCkce28KC8BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ManagementTag marks the operations served by the admin listener rather
+// than the public API.
+const ManagementTag = "management"
+
+// operation is what routes know about an operation.
+type operation struct {
+	id   string
+	tags []string
+	// streaming operations respond with a stream that lasts as long as the
+	// client wants, marked x-streaming in the spec.
+	streaming bool
+}
+
+// websocketOperations are the operations openapi_config.yaml excludes from
+// the generated code, and so from the embedded spec, because handlers
+// mounted on the router directly serve them over a WebSocket.
+var websocketOperations = map[string]operation{
+	"GET /api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/exec":   {id: "execPod", tags: []string{"pods"}, streaming: true},
+	"GET /api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/attach": {id: "attachPod", tags: []string{"pods"}, streaming: true},
+}
+
+// routes maps "METHOD /path" of every operation in the spec, including
+// those served outside the generated handlers.
+var routes = sync.OnceValue(func() map[string]operation {
+	spec, err := GetSwagger()
+	if err != nil {
+		// The spec is embedded at build time; it cannot fail to decode.
+		panic(err)
+	}
+	ops := maps.Clone(websocketOperations)
+	for path, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			// The embedded spec carries the Go names of the operations;
+			// the operationIds of openapi.yaml start in lower case.
+			id := strings.ToLower(op.OperationID[:1]) + op.OperationID[1:]
+			streaming, _ := op.Extensions["x-streaming"].(bool)
+			ops[method+" "+path] = operation{id: id, tags: op.Tags, streaming: streaming}
+		}
+	}
+	return ops
+})
+
+// OperationID returns the operationId of the route pattern, as chi reports
+// it, for method.
+func OperationID(method, pattern string) (string, bool) {
+	op, ok := routes()[method+" "+pattern]
+	return op.id, ok
+}
+
+// IsStreaming reports whether operationID responds with a long-lived
+// stream, such as a watch or a followed log.
+func IsStreaming(operationID string) bool {
+	for _, op := range routes() {
+		if op.id == operationID {
+			return op.streaming
+		}
+	}
+	return false
+}
+
+// HasOperation reports whether the spec defines operationID.
+func HasOperation(operationID string) bool {
+	for _, op := range routes() {
+		if op.id == operationID {
+			return true
+		}
+	}
+	return false
+}
+
+// RoutesWithTag returns a router that registers only the operations tagged
+// tag on r. Pass it to HandlerFromMux to mount a subset of the API.
+func RoutesWithTag(r chi.Router, tag string) chi.Router {
+	return &taggedRouter{Router: r, tag: tag, include: true}
+}
+
+// RoutesWithoutTag returns a router that registers only the operations not
+// tagged tag on r.
+func RoutesWithoutTag(r chi.Router, tag string) chi.Router {
+	return &taggedRouter{Router: r, tag: tag, include: false}
+}
+
+// taggedRouter drops the routes the generated HandlerWithOptions registers
+// for operations on the wrong side of the tag filter. Routes that are not
+// operations, such as those added by other packages, pass through.
+type taggedRouter struct {
+	chi.Router
+	tag     string
+	include bool
+}
+
+func (t *taggedRouter) keep(method, pattern string) bool {
+	op, ok := routes()[method+" "+pattern]
+	if !ok {
+		return true
+	}
+	return slices.Contains(op.tags, t.tag) == t.include
+}
+
+func (t *taggedRouter) wrap(r chi.Router) chi.Router {
+	return &taggedRouter{Router: r, tag: t.tag, include: t.include}
+}
+
+func (t *taggedRouter) Group(fn func(r chi.Router)) chi.Router {
+	return t.wrap(t.Router.Group(func(r chi.Router) { fn(t.wrap(r)) }))
+}
+
+func (t *taggedRouter) With(middlewares ...func(http.Handler) http.Handler) chi.Router {
+	return t.wrap(t.Router.With(middlewares...))
+}
+
+func (t *taggedRouter) Method(method, pattern string, h http.Handler) {
+	if t.keep(method, pattern) {
+		t.Router.Method(method, pattern, h)
+	}
+}
+
+func (t *taggedRouter) MethodFunc(method, pattern string, h http.HandlerFunc) {
+	t.Method(method, pattern, h)
+}
+
+func (t *taggedRouter) Get(pattern string, h http.HandlerFunc) {
+	t.Method(http.MethodGet, pattern, h)
+}
+
+func (t *taggedRouter) Head(pattern string, h http.HandlerFunc) {
+	t.Method(http.MethodHead, pattern, h)
+}
+
+func (t *taggedRouter) Post(pattern string, h http.HandlerFunc) {
+	t.Method(http.MethodPost, pattern, h)
+}
+
+func (t *taggedRouter) Put(pattern string, h http.HandlerFunc) {
+	t.Method(http.MethodPut, pattern, h)
+}
+
+func (t *taggedRouter) Patch(pattern string, h http.HandlerFunc) {
+	t.Method(http.MethodPatch, pattern, h)
+}
+
+func (t *taggedRouter) Delete(pattern string, h http.HandlerFunc) {
+	t.Method(http.MethodDelete, pattern, h)
+}
+
+func (t *taggedRouter) Options(pattern string, h http.HandlerFunc) {
+	t.Method(http.MethodOptions, pattern, h)
+}
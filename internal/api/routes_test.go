@@ -0,0 +1,30 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// TestRoutesCoverSpec checks that every operation of openapi.yaml, those
+// left out of the generated code included, is known with its streaming
+// mark.
+func TestRoutesCoverSpec(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromFile("../../openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			id, ok := OperationID(method, path)
+			if !ok || id != op.OperationID {
+				t.Errorf("OperationID(%s %s) = %q, %v, want %q", method, path, id, ok, op.OperationID)
+				continue
+			}
+			streaming, _ := op.Extensions["x-streaming"].(bool)
+			if got := IsStreaming(id); got != streaming {
+				t.Errorf("IsStreaming(%s) = %v, want %v", id, got, streaming)
+			}
+		}
+	}
+}
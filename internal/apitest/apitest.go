@@ -0,0 +1,161 @@
+// Package apitest serves the API in-process with its real handlers and
+// middleware, for the tests of its clients. The server has one cluster,
+// which has no reachable API server, so only operations that do not call
+// Kubernetes succeed; those that do fail as if the cluster were down.
+package apitest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/events"
+	"iu-k8s.linecorp.com/server/internal/handlers"
+	"iu-k8s.linecorp.com/server/internal/idempotency"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/middleware"
+	"iu-k8s.linecorp.com/server/internal/operation"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// Cluster is the name of the cluster of the server.
+	Cluster = "dev"
+	// AdminToken authenticates Admin, who may do anything.
+	AdminToken = "admin-token"
+	Admin      = "alice"
+	// UserToken authenticates User, whom the policy allows nothing.
+	UserToken = "user-token"
+	User      = "bob"
+)
+
+// Server is a running API server.
+type Server struct {
+	*httptest.Server
+	Audit      *audit.JSONLSink
+	Events     *events.Memory
+	Operations *operation.Manager
+
+	mu         sync.Mutex
+	requests   []*http.Request
+	failures   int
+	status     int
+	retryAfter string
+}
+
+// New starts a server, which is closed when the test ends.
+func New(t testing.TB) *Server {
+	t.Helper()
+	dir := t.TempDir()
+
+	tokenFile := filepath.Join(dir, "tokens.csv")
+	tokens := AdminToken + "," + Admin + ",1,admins\n" + UserToken + "," + User + ",2,\n"
+	if err := os.WriteFile(tokenFile, []byte(tokens), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	authenticator, err := auth.LoadTokenFile(tokenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := &auth.Policy{Rules: []auth.Rule{{
+		Users:      []string{Admin},
+		Verbs:      []string{auth.Wildcard},
+		Resources:  []string{auth.Wildcard},
+		Clusters:   []string{auth.Wildcard},
+		Namespaces: []string{auth.Wildcard},
+	}}}
+
+	auditSink, err := audit.OpenJSONL(filepath.Join(dir, "audit.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { auditSink.Close() })
+
+	clusters := kube.NewRegistry()
+	// Nothing listens on the discard port.
+	if err := clusters.Add(Cluster, &rest.Config{Host: "http://127.0.0.1:9"}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Load()
+	operations := operation.NewManager(operation.Options{Timeout: cfg.Operations.Timeout, Retention: cfg.Operations.Retention})
+	t.Cleanup(operations.Shutdown)
+
+	s := &Server{Audit: auditSink, Events: events.NewMemory(), Operations: operations}
+	handler := handlers.New(handlers.Dependencies{
+		Config:     cfg,
+		Clusters:   clusters,
+		Authorizer: policy,
+		Audit:      auditSink,
+		Watches:    kube.NewWatchHub(kube.WatchOptions{BufferSize: 1, SyncTimeout: cfg.Watch.SyncTimeout}),
+		Operations: operations,
+		Events:     s.Events,
+	})
+
+	r := chi.NewRouter()
+	r.Use(s.record)
+	r.Use(middleware.RequestID(cfg.Requests.IDHeaders, cfg.Requests.IDMaxLength))
+	r.Use(middleware.Authenticate(authenticator))
+	r.Use(middleware.Audit(auditSink))
+	si := api.NewStrictHandlerWithOptions(handler, []api.StrictMiddlewareFunc{
+		middleware.Idempotency(idempotency.NewMemory(), cfg.Idempotency.TTL, cfg.Apply.MaxBodySize),
+		middleware.AuditOperation,
+	}, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  handlers.RequestErrorHandler,
+		ResponseErrorHandlerFunc: handlers.ResponseErrorHandler,
+	})
+	api.HandlerWithOptions(si, api.ChiServerOptions{
+		BaseRouter:       api.RoutesWithoutTag(r, api.ManagementTag),
+		ErrorHandlerFunc: handlers.RequestErrorHandler,
+	})
+
+	s.Server = httptest.NewServer(r)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Fail answers the next n requests with status, and a Retry-After header
+// unless retryAfter is empty, instead of serving them.
+func (s *Server) Fail(n, status int, retryAfter string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures, s.status, s.retryAfter = n, status, retryAfter
+}
+
+// Requests returns the requests received so far, including failed ones.
+func (s *Server) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
+
+func (s *Server) record(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Clone(r.Context()))
+		fail := s.failures > 0
+		if fail {
+			s.failures--
+		}
+		status, retryAfter := s.status, s.retryAfter
+		s.mu.Unlock()
+
+		if fail {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			http.Error(w, strconv.Itoa(status)+" "+http.StatusText(status), status)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,364 @@
+// Package approval holds back operations the policy marks as high-risk until
+// a second principal approves them. Requests wait as pending approvals in
+// memory or, to hold across restarts and replicas, in the database, and
+// expire unless they are decided in time.
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+)
+
+// sweepInterval is how often pending approvals are checked for expiry.
+const sweepInterval = time.Minute
+
+var (
+	// ErrNotFound is returned for unknown approval IDs.
+	ErrNotFound = errors.New("approval not found")
+	// ErrNotPending is returned when deciding an approval that was already
+	// decided or expired.
+	ErrNotPending = errors.New("approval is not pending")
+	// ErrSelfApproval is returned when the requester of an operation tries
+	// to decide on it.
+	ErrSelfApproval = errors.New("approval must be decided by someone other than the requester")
+)
+
+// Status is the lifecycle state of an approval.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+	StatusExpired  Status = "expired"
+)
+
+// Approval is an operation waiting for, or given, a second principal's
+// decision.
+type Approval struct {
+	ID          string
+	OperationID string
+	Target      audit.Target
+	// Summary is the method and URI of the request.
+	Summary string
+	// Request is the request object of the operation as JSON, and Body the
+	// content of its raw request body if it has one.
+	Request json.RawMessage
+	Body    []byte
+	// Requester is the principal the operation runs as once approved.
+	Requester   auth.Principal
+	RequestedAt time.Time
+	ExpiresAt   time.Time
+	Status      Status
+	DecidedBy   string
+	DecidedAt   time.Time
+	Comment     string
+	// Result is the response of the operation once it ran.
+	Result *Result
+}
+
+// Result is the response an approved operation gave.
+type Result struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Filter selects approvals. Zero fields match everything.
+type Filter struct {
+	Status Status
+	// Limit caps the number of approvals returned, newest first.
+	Limit int
+}
+
+// Store keeps approvals. Each method must be atomic.
+type Store interface {
+	// CreateApproval stores a new approval.
+	CreateApproval(ctx context.Context, a Approval) error
+	// GetApproval returns an approval or ErrNotFound.
+	GetApproval(ctx context.Context, id string) (*Approval, error)
+	// ListApprovals returns the approvals matching filter, newest first.
+	ListApprovals(ctx context.Context, filter Filter) ([]Approval, error)
+	// UpdateApproval replaces an approval that is still in status from,
+	// and fails with ErrNotPending otherwise.
+	UpdateApproval(ctx context.Context, a Approval, from Status) error
+	// DeleteApprovals deletes the approvals decided before t.
+	DeleteApprovals(ctx context.Context, before time.Time) error
+}
+
+// Options configure a Manager.
+type Options struct {
+	// TTL is how long an approval stays pending before it expires.
+	TTL time.Duration
+	// Retention is how long decided approvals remain queryable.
+	Retention time.Duration
+	// OnChange, if set, is called with every approval that was requested,
+	// decided or expired.
+	OnChange func(Approval)
+}
+
+// Manager moves approvals through their lifecycle. Expiry happens in the
+// background and is recorded in the audit trail; the other transitions
+// are audited by the requests making them.
+type Manager struct {
+	store    Store
+	recorder audit.Recorder
+	opts     Options
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// NewManager creates a manager and starts its expiry of pending approvals.
+func NewManager(store Store, recorder audit.Recorder, opts Options) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		store:    store,
+		recorder: recorder,
+		opts:     opts,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+
+	m.wg.Add(1)
+	go m.sweep()
+	return m
+}
+
+// Request stores a as pending. The caller sets the operation, target,
+// summary, request and requester; the manager assigns the ID and
+// lifecycle fields.
+func (m *Manager) Request(ctx context.Context, a Approval) (*Approval, error) {
+	a.ID = uuid.NewString()
+	a.RequestedAt = time.Now().UTC()
+	a.ExpiresAt = a.RequestedAt.Add(m.opts.TTL)
+	a.Status = StatusPending
+	if err := m.store.CreateApproval(ctx, a); err != nil {
+		return nil, err
+	}
+	m.changed(a)
+	return &a, nil
+}
+
+// Get returns an approval, expiring it first if its time is up.
+func (m *Manager) Get(ctx context.Context, id string) (*Approval, error) {
+	a, err := m.store.GetApproval(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return m.expireDue(ctx, a, time.Now())
+}
+
+// List returns the approvals matching filter, newest first.
+func (m *Manager) List(ctx context.Context, filter Filter) ([]Approval, error) {
+	approvals, err := m.store.ListApprovals(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for i := range approvals {
+		a, err := m.expireDue(ctx, &approvals[i], now)
+		if err != nil {
+			return nil, err
+		}
+		approvals[i] = *a
+	}
+	return approvals, nil
+}
+
+// Decide approves or rejects a pending approval on behalf of decider, who
+// must not be its requester. Only one decision succeeds; the others fail
+// with ErrNotPending.
+func (m *Manager) Decide(ctx context.Context, id string, decider *auth.Principal, approve bool, comment string) (*Approval, error) {
+	a, err := m.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.Status != StatusPending {
+		return nil, fmt.Errorf("%w: %s is %s", ErrNotPending, a.ID, a.Status)
+	}
+	if decider.Same(&a.Requester) {
+		return nil, ErrSelfApproval
+	}
+
+	a.Status = StatusRejected
+	if approve {
+		a.Status = StatusApproved
+	}
+	a.DecidedBy = decider.Name
+	a.DecidedAt = time.Now().UTC()
+	a.Comment = comment
+	if err := m.store.UpdateApproval(ctx, *a, StatusPending); err != nil {
+		return nil, err
+	}
+	m.changed(*a)
+	return a, nil
+}
+
+// Complete records the response of an approved operation.
+func (m *Manager) Complete(ctx context.Context, a *Approval, result Result) error {
+	a.Result = &result
+	return m.store.UpdateApproval(ctx, *a, StatusApproved)
+}
+
+// Shutdown stops the expiry of pending approvals.
+func (m *Manager) Shutdown() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+// sweep expires pending approvals whose time is up and forgets decided
+// ones older than the retention period.
+func (m *Manager) sweep() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case now := <-ticker.C:
+			pending, err := m.store.ListApprovals(m.ctx, Filter{Status: StatusPending})
+			if err != nil {
+				slog.Warn("Failed to list pending approvals", "error", err)
+				continue
+			}
+			for i := range pending {
+				if _, err := m.expireDue(m.ctx, &pending[i], now); err != nil {
+					slog.Warn("Failed to expire approval", "id", pending[i].ID, "error", err)
+				}
+			}
+			if err := m.store.DeleteApprovals(m.ctx, now.Add(-m.opts.Retention)); err != nil {
+				slog.Warn("Failed to delete old approvals", "error", err)
+			}
+		}
+	}
+}
+
+// expireDue expires a if it is pending past its expiry and records the
+// transition in the audit trail.
+func (m *Manager) expireDue(ctx context.Context, a *Approval, now time.Time) (*Approval, error) {
+	if a.Status != StatusPending || now.Before(a.ExpiresAt) {
+		return a, nil
+	}
+	expired := *a
+	expired.Status = StatusExpired
+	expired.DecidedAt = a.ExpiresAt
+	err := m.store.UpdateApproval(ctx, expired, StatusPending)
+	if errors.Is(err, ErrNotPending) {
+		// Decided or expired concurrently.
+		return m.store.GetApproval(ctx, a.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entry := audit.Entry{
+		Time:        now,
+		Principal:   a.Requester.Name,
+		OperationID: a.OperationID,
+		Action:      "approvals.expire",
+		Target:      a.Target,
+		Summary:     a.Summary,
+		Outcome:     audit.OutcomeFailure,
+		Error:       "approval expired",
+		Details:     map[string]any{"approvalId": a.ID},
+	}
+	if err := m.recorder.Record(context.WithoutCancel(ctx), entry); err != nil {
+		slog.Error("Failed to record audit entry", "error", err, "action", entry.Action)
+	}
+	m.changed(expired)
+	return &expired, nil
+}
+
+func (m *Manager) changed(a Approval) {
+	if m.opts.OnChange != nil {
+		m.opts.OnChange(a)
+	}
+}
+
+// Memory is a Store for a single replica.
+type Memory struct {
+	mu        sync.Mutex
+	approvals map[string]Approval
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{approvals: map[string]Approval{}}
+}
+
+// CreateApproval implements Store.
+func (m *Memory) CreateApproval(ctx context.Context, a Approval) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.approvals[a.ID] = a
+	return nil
+}
+
+// GetApproval implements Store.
+func (m *Memory) GetApproval(ctx context.Context, id string) (*Approval, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	a, ok := m.approvals[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return &a, nil
+}
+
+// ListApprovals implements Store.
+func (m *Memory) ListApprovals(ctx context.Context, filter Filter) ([]Approval, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	approvals := []Approval{}
+	for _, a := range m.approvals {
+		if filter.Status == "" || a.Status == filter.Status {
+			approvals = append(approvals, a)
+		}
+	}
+	slices.SortFunc(approvals, func(a, b Approval) int {
+		return b.RequestedAt.Compare(a.RequestedAt)
+	})
+	if filter.Limit > 0 && len(approvals) > filter.Limit {
+		approvals = approvals[:filter.Limit]
+	}
+	return approvals, nil
+}
+
+// UpdateApproval implements Store.
+func (m *Memory) UpdateApproval(ctx context.Context, a Approval, from Status) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.approvals[a.ID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, a.ID)
+	}
+	if stored.Status != from {
+		return fmt.Errorf("%w: %s is %s", ErrNotPending, a.ID, stored.Status)
+	}
+	m.approvals[a.ID] = a
+	return nil
+}
+
+// DeleteApprovals implements Store.
+func (m *Memory) DeleteApprovals(ctx context.Context, before time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, a := range m.approvals {
+		if a.Status != StatusPending && a.DecidedAt.Before(before) {
+			delete(m.approvals, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+)
+
+// entries is an audit recorder keeping what it records.
+type entries struct {
+	mu      sync.Mutex
+	entries []audit.Entry
+}
+
+func (e *entries) Record(ctx context.Context, entry audit.Entry) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.entries = append(e.entries, entry)
+	return nil
+}
+
+var requester = auth.Principal{Name: "alice", Source: auth.SourceToken}
+
+func newManager(t *testing.T, ttl time.Duration) (*Manager, *entries, *[]Approval) {
+	t.Helper()
+	recorded := &entries{}
+	var changes []Approval
+	m := NewManager(NewMemory(), recorded, Options{
+		TTL:       ttl,
+		Retention: time.Hour,
+		OnChange:  func(a Approval) { changes = append(changes, a) },
+	})
+	t.Cleanup(m.Shutdown)
+	return m, recorded, &changes
+}
+
+func request(t *testing.T, m *Manager) *Approval {
+	t.Helper()
+	a, err := m.Request(context.Background(), Approval{
+		OperationID: "scaleWorkload",
+		Target:      audit.Target{Cluster: "dev", Namespace: "default", Resource: "deployments", Name: "web"},
+		Request:     []byte(`{"Body":{"replicas":3}}`),
+		Requester:   requester,
+	})
+	if err != nil {
+		t.Fatalf("Request: %v", err)
+	}
+	return a
+}
+
+func TestDecideSelfApproval(t *testing.T) {
+	tests := []struct {
+		name    string
+		decider auth.Principal
+		wantErr error
+	}{
+		{"requester", requester, ErrSelfApproval},
+		{"same name from another source", auth.Principal{Name: "alice", Source: auth.SourceCertificate}, nil},
+		{"someone else", auth.Principal{Name: "carol", Source: auth.SourceToken}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _, _ := newManager(t, time.Hour)
+			a := request(t, m)
+			decided, err := m.Decide(context.Background(), a.ID, &tt.decider, true, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decide = %v, want %v", err, tt.wantErr)
+			}
+			want := StatusApproved
+			if err != nil {
+				want = StatusPending
+			} else if decided.DecidedBy != tt.decider.Name {
+				t.Errorf("decided by %q, want %q", decided.DecidedBy, tt.decider.Name)
+			}
+			if got, _ := m.Get(context.Background(), a.ID); got.Status != want {
+				t.Errorf("status %s, want %s", got.Status, want)
+			}
+		})
+	}
+}
+
+func TestDecideOnce(t *testing.T) {
+	ctx := context.Background()
+	carol := &auth.Principal{Name: "carol", Source: auth.SourceToken}
+	dave := &auth.Principal{Name: "dave", Source: auth.SourceToken}
+
+	for _, first := range []bool{true, false} {
+		m, _, changes := newManager(t, time.Hour)
+		a := request(t, m)
+		if _, err := m.Decide(ctx, a.ID, carol, first, "ok"); err != nil {
+			t.Fatalf("first decision: %v", err)
+		}
+		for _, second := range []bool{true, false} {
+			if _, err := m.Decide(ctx, a.ID, dave, second, ""); !errors.Is(err, ErrNotPending) {
+				t.Errorf("deciding %v after %v = %v, want ErrNotPending", second, first, err)
+			}
+		}
+		got, _ := m.Get(ctx, a.ID)
+		if want := map[bool]Status{true: StatusApproved, false: StatusRejected}[first]; got.Status != want || got.DecidedBy != "carol" {
+			t.Errorf("approval %s by %s, want %s by carol", got.Status, got.DecidedBy, want)
+		}
+		if len(*changes) != 2 {
+			t.Errorf("%d changes, want the request and one decision", len(*changes))
+		}
+	}
+
+	// Of concurrent decisions, exactly one wins.
+	m, _, _ := newManager(t, time.Hour)
+	a := request(t, m)
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := m.Decide(ctx, a.ID, carol, true, "")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	decided := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			decided++
+		case !errors.Is(err, ErrNotPending):
+			t.Errorf("Decide = %v, want nil or ErrNotPending", err)
+		}
+	}
+	if decided != 1 {
+		t.Errorf("%d concurrent decisions succeeded, want 1", decided)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	ctx := context.Background()
+	m, recorded, changes := newManager(t, 10*time.Millisecond)
+	a := request(t, m)
+	if got, _ := m.Get(ctx, a.ID); got.Status != StatusPending {
+		t.Fatalf("status %s right after the request, want pending", got.Status)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	approvals, err := m.List(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(approvals) != 1 || approvals[0].Status != StatusExpired || !approvals[0].DecidedAt.Equal(a.ExpiresAt) {
+		t.Fatalf("listed %+v, want the approval expired at its expiry", approvals)
+	}
+	carol := &auth.Principal{Name: "carol", Source: auth.SourceToken}
+	if _, err := m.Decide(ctx, a.ID, carol, true, ""); !errors.Is(err, ErrNotPending) {
+		t.Errorf("Decide after expiry = %v, want ErrNotPending", err)
+	}
+
+	// The expiry is recorded once, as the requester's failed operation.
+	if got, _ := m.Get(ctx, a.ID); got.Status != StatusExpired {
+		t.Errorf("status %s, want expired", got.Status)
+	}
+	if len(recorded.entries) != 1 {
+		t.Fatalf("%d audit entries, want 1", len(recorded.entries))
+	}
+	entry := recorded.entries[0]
+	if entry.Action != "approvals.expire" || entry.Principal != "alice" || entry.Outcome != audit.OutcomeFailure ||
+		entry.Details["approvalId"] != a.ID {
+		t.Errorf("audit entry = %+v, want the expiry of %s", entry, a.ID)
+	}
+	if n := len(*changes); n != 2 || (*changes)[1].Status != StatusExpired {
+		t.Errorf("changes = %+v, want the request and its expiry", *changes)
+	}
+}
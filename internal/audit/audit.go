@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// ErrQueryUnsupported is returned by sinks that only forward entries.
+var ErrQueryUnsupported = errors.New("audit sink does not support queries")
+
+// Outcome is the result of an audited action.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+	OutcomeDenied  Outcome = "denied"
+)
+
+// Target identifies the Kubernetes object an audited action applies to.
+type Target struct {
+	Cluster   string `json:"cluster,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Resource  string `json:"resource,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Entry is a single audit trail record.
+type Entry struct {
+	// ID is assigned by the sink; entries with higher IDs were recorded later.
+	ID        int64     `json:"id,omitempty"`
+	Time      time.Time `json:"time"`
+	Principal string    `json:"principal"`
+	SourceIP  string    `json:"sourceIp,omitempty"`
+	RequestID string    `json:"requestId,omitempty"`
+	// OperationID is the API operation that was called.
+	OperationID string         `json:"operationId,omitempty"`
+	Action      string         `json:"action"`
+	Target      Target         `json:"target"`
+	Summary     string         `json:"summary,omitempty"`
+	Outcome     Outcome        `json:"outcome"`
+	Duration    time.Duration  `json:"duration,omitempty"`
+	Error       string         `json:"error,omitempty"`
+	Details     map[string]any `json:"details,omitempty"`
+}
+
+// Recorder persists audit entries.
+type Recorder interface {
+	Record(ctx context.Context, entry Entry) error
+}
+
+// Filter selects audit entries. Zero fields match everything.
+type Filter struct {
+	Principal string
+	Cluster   string
+	Namespace string
+	// Resources match entries whose target resource is any of them, such
+	// as deployments and deployments.apps.
+	Resources   []string
+	Name        string
+	Action      string
+	OperationID string
+	Outcome     Outcome
+	Since       time.Time
+	Until       time.Time
+}
+
+// Match reports whether entry passes the filter.
+func (f Filter) Match(entry Entry) bool {
+	switch {
+	case f.Principal != "" && entry.Principal != f.Principal,
+		f.Cluster != "" && entry.Target.Cluster != f.Cluster,
+		f.Namespace != "" && entry.Target.Namespace != f.Namespace,
+		len(f.Resources) > 0 && !slices.Contains(f.Resources, entry.Target.Resource),
+		f.Name != "" && entry.Target.Name != f.Name,
+		f.Action != "" && entry.Action != f.Action,
+		f.OperationID != "" && entry.OperationID != f.OperationID,
+		f.Outcome != "" && entry.Outcome != f.Outcome,
+		!f.Since.IsZero() && entry.Time.Before(f.Since),
+		!f.Until.IsZero() && !entry.Time.Before(f.Until):
+		return false
+	}
+	return true
+}
+
+// Query is a page request over the audit trail, newest entries first.
+type Query struct {
+	Filter
+	// Cursor is the ID of the last entry of the previous page; zero starts
+	// from the newest entry.
+	Cursor int64
+	Limit  int
+}
+
+// Page is a page of audit entries.
+type Page struct {
+	Entries []Entry
+	// Cursor continues with the next page when HasMore is set.
+	Cursor  int64
+	HasMore bool
+}
+
+// Sink is where the server sends its audit trail.
+type Sink interface {
+	Recorder
+	// Query returns a page of recorded entries, or ErrQueryUnsupported.
+	Query(ctx context.Context, query Query) (Page, error)
+	Close() error
+}
+
+// LogRecorder writes audit entries to a slog logger.
+type LogRecorder struct {
+	Logger *slog.Logger
+}
+
+// Record implements Recorder.
+func (r LogRecorder) Record(ctx context.Context, entry Entry) error {
+	logger := r.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.LogAttrs(ctx, slog.LevelInfo, "audit",
+		slog.String("component", "audit"),
+		slog.Time("time", entry.Time),
+		slog.String("principal", entry.Principal),
+		slog.String("source_ip", entry.SourceIP),
+		slog.String("req_id", entry.RequestID),
+		slog.String("operation_id", entry.OperationID),
+		slog.String("action", entry.Action),
+		slog.Any("target", entry.Target),
+		slog.String("summary", entry.Summary),
+		slog.String("outcome", string(entry.Outcome)),
+		slog.Duration("duration", entry.Duration),
+		slog.String("error", entry.Error),
+		slog.Any("details", entry.Details),
+	)
+	return nil
+}
+
+// Query implements Sink. Logged entries cannot be queried.
+func (r LogRecorder) Query(ctx context.Context, query Query) (Page, error) {
+	return Page{}, ErrQueryUnsupported
+}
+
+// Close implements Sink.
+func (r LogRecorder) Close() error {
+	return nil
+}
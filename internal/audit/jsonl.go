@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// maxLineSize bounds a single line of a JSON lines file.
+const maxLineSize = 4 << 20
+
+// JSONLSink appends entries to a JSON lines file, one entry per line. Queries
+// scan the whole file, which suits modest trails; prefer the SQLite sink for
+// large ones.
+type JSONLSink struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	lastID int64
+}
+
+// OpenJSONL opens or creates the file at path and continues numbering after
+// the entries it already holds.
+func OpenJSONL(path string) (*JSONLSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	s := &JSONLSink{path: path, file: file}
+	if err := s.scan(func(entry Entry) { s.lastID = max(s.lastID, entry.ID) }); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Record implements Recorder.
+func (s *JSONLSink) Record(ctx context.Context, entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry.ID = s.lastID + 1
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	s.lastID = entry.ID
+	return nil
+}
+
+// Query implements Sink.
+func (s *JSONLSink) Query(ctx context.Context, query Query) (Page, error) {
+	var matched []Entry
+	err := s.scan(func(entry Entry) {
+		if (query.Cursor == 0 || entry.ID < query.Cursor) && query.Match(entry) {
+			matched = append(matched, entry)
+		}
+	})
+	if err != nil {
+		return Page{}, err
+	}
+
+	// The file is in recording order; pages are newest first.
+	page := Page{Entries: make([]Entry, 0, min(query.Limit, len(matched)))}
+	for i := len(matched) - 1; i >= 0 && len(page.Entries) < query.Limit; i-- {
+		page.Entries = append(page.Entries, matched[i])
+	}
+	if len(page.Entries) > 0 && len(page.Entries) < len(matched) {
+		page.HasMore = true
+		page.Cursor = page.Entries[len(page.Entries)-1].ID
+	}
+	return page, nil
+}
+
+// Close implements Sink.
+func (s *JSONLSink) Close() error {
+	return s.file.Close()
+}
+
+func (s *JSONLSink) scan(fn func(Entry)) error {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("%s:%d: %w", s.path, line, err)
+		}
+		fn(entry)
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,33 @@
+package audit
+
+import "context"
+
+// Request collects the audit entry of an HTTP request while it is served.
+// The audit middleware fills in who called what and how it ended; handlers
+// add the action, target and details they know about.
+type Request struct {
+	Entry Entry
+	// Always records the request even though its method does not mutate,
+	// for reads with side effects such as changing the log level.
+	Always bool
+}
+
+type requestKey struct{}
+
+// WithRequest returns a context carrying req.
+func WithRequest(ctx context.Context, req *Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+// RequestFrom returns the audited request of ctx, or nil outside of one.
+func RequestFrom(ctx context.Context) *Request {
+	req, _ := ctx.Value(requestKey{}).(*Request)
+	return req
+}
+
+// Annotate applies fn to the audited request of ctx, if any.
+func Annotate(ctx context.Context, fn func(*Request)) {
+	if req := RequestFrom(ctx); req != nil {
+		fn(req)
+	}
+}
@@ -0,0 +1,26 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+// Open creates the sink selected by cfg. The database sink lives in the
+// storage package, which owns the database connection.
+func Open(cfg config.AuditConfig) (Sink, error) {
+	switch cfg.Sink {
+	case "log":
+		return LogRecorder{}, nil
+	case "jsonl":
+		return OpenJSONL(cfg.JSONLPath)
+	case "webhook":
+		if cfg.WebhookURL == "" {
+			return nil, errors.New("AUDIT_WEBHOOK_URL is required for the webhook audit sink")
+		}
+		return NewWebhook(cfg.WebhookURL, cfg.WebhookTimeout), nil
+	default:
+		return nil, fmt.Errorf("unknown audit sink %q", cfg.Sink)
+	}
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/requestid"
+)
+
+// WebhookSink posts every entry as JSON to a URL, standing in for an
+// external audit collector. The collector owns the trail, so the sink cannot
+// be queried.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook returns a sink posting to url, giving up on a delivery after timeout.
+func NewWebhook(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Record implements Recorder. Deliveries are synchronous so a failing
+// collector surfaces in the server log rather than dropping entries silently.
+func (s *WebhookSink) Record(ctx context.Context, entry Entry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if entry.RequestID != "" {
+		req.Header.Set(requestid.Header, entry.RequestID)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("audit webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// Query implements Sink.
+func (s *WebhookSink) Query(ctx context.Context, query Query) (Page, error) {
+	return Page{}, ErrQueryUnsupported
+}
+
+// Close implements Sink.
+func (s *WebhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+)
+
+// Wildcard matches any value in a policy rule field.
+const Wildcard = "*"
+
+// ErrForbidden is returned when the principal is not allowed to perform an action.
+var ErrForbidden = errors.New("forbidden")
+
+// Attributes describe the action a principal attempts.
+type Attributes struct {
+	Verb      string
+	Cluster   string
+	Namespace string
+	Resource  string
+}
+
+// Authorizer decides whether a principal may perform an action.
+type Authorizer interface {
+	Authorize(ctx context.Context, p *Principal, attrs Attributes) error
+}
+
+// Rule grants verbs on resources in namespaces of clusters to users and groups.
+// Empty subject lists match nobody; empty target lists match nothing.
+type Rule struct {
+	Users      []string `json:"users,omitempty"`
+	Groups     []string `json:"groups,omitempty"`
+	Verbs      []string `json:"verbs"`
+	Resources  []string `json:"resources"`
+	Clusters   []string `json:"clusters"`
+	Namespaces []string `json:"namespaces"`
+}
+
+// ApprovalRule makes the operations it matches wait for a second principal
+// to approve them. Operations are named by their operationId.
+type ApprovalRule struct {
+	Operations []string `json:"operations"`
+	Clusters   []string `json:"clusters"`
+	// Namespaces limit the rule to operations in those namespaces; empty
+	// matches operations in any namespace or none.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Parameters must all equal the path, query or body parameters of the
+	// same name, such as {"replicas": 0}.
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// Policy is an ordered list of allow rules. Anything not allowed is denied.
+// Approvals list the allowed operations that also need approval.
+type Policy struct {
+	Rules     []Rule         `json:"rules"`
+	Approvals []ApprovalRule `json:"approvals,omitempty"`
+}
+
+// LoadPolicyFile reads a JSON policy file.
+func LoadPolicyFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read policy file: %w", err)
+	}
+
+	policy := &Policy{}
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("parse policy file: %w", err)
+	}
+	return policy, nil
+}
+
+// Authorize implements Authorizer.
+func (p *Policy) Authorize(_ context.Context, principal *Principal, attrs Attributes) error {
+	for _, rule := range p.Rules {
+		if rule.matchesSubject(principal) && rule.matchesAttributes(attrs) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s may not %s %s in %s/%s",
+		ErrForbidden, principal.Name, attrs.Verb, attrs.Resource, attrs.Cluster, attrs.Namespace)
+}
+
+// RequiresApproval reports whether an operation called with params, its
+// parameters as decoded from JSON, needs approval.
+func (p *Policy) RequiresApproval(operationID string, params map[string]any) bool {
+	cluster, _ := params["cluster"].(string)
+	namespace, _ := params["namespace"].(string)
+	for _, rule := range p.Approvals {
+		if !matches(rule.Operations, operationID) || !matches(rule.Clusters, cluster) {
+			continue
+		}
+		if len(rule.Namespaces) > 0 && !matches(rule.Namespaces, namespace) {
+			continue
+		}
+		if rule.matchesParameters(params) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r ApprovalRule) matchesParameters(params map[string]any) bool {
+	for name, want := range r.Parameters {
+		if got, ok := params[name]; !ok || !reflect.DeepEqual(got, want) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Rule) matchesSubject(p *Principal) bool {
+	if matches(r.Users, p.Name) {
+		return true
+	}
+	for _, group := range p.Groups {
+		if matches(r.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Rule) matchesAttributes(attrs Attributes) bool {
+	return matches(r.Verbs, attrs.Verb) &&
+		matches(r.Resources, attrs.Resource) &&
+		matches(r.Clusters, attrs.Cluster) &&
+		matches(r.Namespaces, attrs.Namespace)
+}
+
+func matches(values []string, value string) bool {
+	return slices.Contains(values, Wildcard) || slices.Contains(values, value)
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"slices"
+)
+
+const (
+	// AnonymousUser is the principal name of unauthenticated requests.
+	AnonymousUser = "system:anonymous"
+	// UnauthenticatedGroup is the group of unauthenticated requests.
+	UnauthenticatedGroup = "system:unauthenticated"
+	// AuthenticatedGroup is added to every authenticated principal.
+	AuthenticatedGroup = "system:authenticated"
+)
+
+// Authentication sources of principals. A name is unique per source only:
+// a token user and a certificate common name may coincide.
+const (
+	SourceToken       = "token"
+	SourceCertificate = "certificate"
+)
+
+// principalKey must be a distinct type: pointers to zero-size values such
+// as &struct{}{} may compare equal and collide with other context keys.
+type principalKey struct{}
+
+// Principal is the identity a request is made on behalf of.
+type Principal struct {
+	Name   string
+	UID    string
+	Groups []string
+	// Source is how the principal authenticated, empty for anonymous.
+	Source string
+}
+
+// Anonymous returns the principal used for requests without credentials.
+func Anonymous() *Principal {
+	return &Principal{
+		Name:   AnonymousUser,
+		Groups: []string{UnauthenticatedGroup},
+	}
+}
+
+// IsAnonymous reports whether the principal carries no credentials.
+func (p *Principal) IsAnonymous() bool {
+	return p == nil || p.Name == AnonymousUser
+}
+
+// Same reports whether p and o are the same identity: the same name from
+// the same authentication source.
+func (p *Principal) Same(o *Principal) bool {
+	return p != nil && o != nil && p.Name == o.Name && p.Source == o.Source
+}
+
+// InGroup reports whether the principal is a member of group.
+func (p *Principal) InGroup(group string) bool {
+	return p != nil && slices.Contains(p.Groups, group)
+}
+
+// From returns the principal stored in ctx, or the anonymous principal.
+func From(ctx context.Context) *Principal {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	if !ok {
+		return Anonymous()
+	}
+	return p
+}
+
+// With returns a copy of ctx carrying p.
+func With(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromCertificate returns the principal of a verified client certificate.
+// Like the Kubernetes API server, the common name is the user name and the
+// organizations are the groups.
+func FromCertificate(cert *x509.Certificate) *Principal {
+	p := &Principal{Name: cert.Subject.CommonName, Source: SourceCertificate}
+	for _, group := range cert.Subject.Organization {
+		if group != "" {
+			p.Groups = append(p.Groups, group)
+		}
+	}
+	p.Groups = append(p.Groups, AuthenticatedGroup)
+	return p
+}
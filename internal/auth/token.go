@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrInvalidCredentials is returned when presented credentials are not recognised.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Authenticator resolves a bearer token into a principal.
+type Authenticator interface {
+	AuthenticateToken(ctx context.Context, token string) (*Principal, error)
+}
+
+type tokenEntry struct {
+	token     string
+	principal *Principal
+}
+
+// StaticTokens authenticates bearer tokens against a fixed list.
+type StaticTokens struct {
+	entries []tokenEntry
+}
+
+// LoadTokenFile reads a static token file. Each line has the Kubernetes
+// static token file format: token,user,uid,"group1,group2".
+func LoadTokenFile(path string) (*StaticTokens, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open token file: %w", err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	tokens := &StaticTokens{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse token file line %d: %w", line, err)
+		}
+		if len(record) < 3 || record[0] == "" || record[1] == "" {
+			return nil, fmt.Errorf("parse token file line %d: expected token,user,uid[,groups]", line)
+		}
+
+		p := &Principal{Name: record[1], UID: record[2], Source: SourceToken}
+		if len(record) > 3 && record[3] != "" {
+			for _, group := range strings.Split(record[3], ",") {
+				if group = strings.TrimSpace(group); group != "" {
+					p.Groups = append(p.Groups, group)
+				}
+			}
+		}
+		p.Groups = append(p.Groups, AuthenticatedGroup)
+		tokens.entries = append(tokens.entries, tokenEntry{token: record[0], principal: p})
+	}
+	return tokens, nil
+}
+
+// AuthenticateToken implements Authenticator.
+func (s *StaticTokens) AuthenticateToken(_ context.Context, token string) (*Principal, error) {
+	for _, entry := range s.entries {
+		if subtle.ConstantTimeCompare([]byte(entry.token), []byte(token)) == 1 {
+			return entry.principal, nil
+		}
+	}
+	return nil, ErrInvalidCredentials
+}
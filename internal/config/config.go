@@ -3,27 +3,436 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Config holds all configuration for our application
 type Config struct {
-	Server ServerConfig
+	Server       ServerConfig
+	Requests     RequestConfig
+	TLS          TLSConfig
+	Admin        AdminConfig
+	CORS         CORSConfig
+	Compression  CompressionConfig
+	RateLimit    RateLimitConfig
+	Idempotency  IdempotencyConfig
+	Kube         KubeConfig
+	Auth         AuthConfig
+	Exec         ExecConfig
+	Watch        WatchConfig
+	Operations   OperationsConfig
+	Apply        ApplyConfig
+	Drain        DrainConfig
+	Provisioning ProvisioningConfig
+	Approvals    ApprovalConfig
+	Notify       NotifyConfig
+	Events       EventsConfig
+	Audit        AuditConfig
+	Database     DatabaseConfig
+
+	// malformed lists the variables whose values could not be parsed and
+	// were replaced by their defaults.
+	malformed []string
 }
 
 // ServerConfig holds configuration for the HTTP server
 type ServerConfig struct {
 	Port string
+	// ReadTimeout and WriteTimeout bound reading a request and writing its
+	// response. Operations replace them with their own deadline.
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+	// TrustedProxies are the CIDRs of the proxies whose X-Forwarded-For and
+	// X-Real-IP headers name the client; other peers are taken as the client.
+	TrustedProxies []string
+}
+
+// RequestConfig holds configuration for the deadlines and concurrency of
+// requests
+type RequestConfig struct {
+	// Timeout is the deadline of an operation; streams have none.
+	Timeout time.Duration
+	// TimeoutOverrides replace the deadline for single operations, by
+	// operationId.
+	TimeoutOverrides map[string]time.Duration
+	// MaxInFlight is how many requests are served at once; 0 serves any
+	// number. Management requests take precedence over the others.
+	MaxInFlight int
+	// MaxQueueDelay is how long a request of the public API may wait for a
+	// slot before it is shed.
+	MaxQueueDelay time.Duration
+	// IDHeaders are the headers a client or proxy in front of the server
+	// may pass the request ID in, in order of precedence. Requests without
+	// a valid one get a generated ID.
+	IDHeaders []string
+	// IDMaxLength bounds the length of request IDs taken from clients.
+	IDMaxLength int
+}
+
+// TLSConfig holds configuration for serving HTTPS
+type TLSConfig struct {
+	// CertFile and KeyFile enable HTTPS. Both are reloaded when they change
+	// on disk, as when cert-manager rotates a mounted secret.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is a PEM bundle client certificates are verified
+	// against. The subject of a verified certificate becomes the principal.
+	ClientCAFile string
+	// ClientAuth is optional or require; it only applies with ClientCAFile.
+	ClientAuth string
+	// MinVersion is 1.2 or 1.3.
+	MinVersion string
+	// CipherSuites restricts the TLS 1.2 cipher suites by their Go names.
+	// TLS 1.3 suites are not configurable.
+	CipherSuites   []string
+	ReloadInterval time.Duration
+}
+
+// AdminConfig holds configuration for the admin listener, which serves the
+// management operations, metrics and profiles apart from the public API
+type AdminConfig struct {
+	// Addr is the TCP address of the admin listener. Setting it empty
+	// leaves only the socket.
+	Addr string
+	// Socket is the path of a unix socket the admin listener also accepts
+	// connections on.
+	Socket string
+	// TokenFile holds the bearer tokens of the admin listener in the format
+	// of AUTH_TOKEN_FILE. Without it the admin listener is unauthenticated.
+	TokenFile string
+}
+
+// CORSConfig holds the cross-origin policy of the public API
+type CORSConfig struct {
+	// AllowedOrigins may contain one * each to match subdomains, as in
+	// https://*.example.com; a lone * allows every origin.
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposedHeaders []string
+	// AllowCredentials lets browsers send cookies and client certificates,
+	// which single sign-on frontends need. It requires explicit origins.
+	AllowCredentials bool
+	MaxAge           time.Duration
+	// PolicyFile is a JSON file whose settings replace those above. It is
+	// reloaded when it changes, so origins can be added without a restart.
+	PolicyFile     string
+	ReloadInterval time.Duration
+}
+
+// CompressionConfig holds configuration for compressing responses
+type CompressionConfig struct {
+	Enabled bool
+	// MinSize is the smallest response body, in bytes, worth compressing.
+	MinSize int
+	// Types are the media types compressed; a type ending in /* matches
+	// every subtype.
+	Types []string
+}
+
+// RateLimitConfig holds configuration for limiting the request rate of
+// each caller on the public API
+type RateLimitConfig struct {
+	Enabled bool
+	// Rate is the sustained number of requests per second of a caller and
+	// Burst how many it may send at once.
+	Rate  float64
+	Burst int
+	// Overrides replace the quota for single operations, by operationId.
+	Overrides map[string]RateLimit
+	// Store is memory, or database to share the limits across replicas.
+	Store string
+}
+
+// IdempotencyConfig holds configuration for replaying the responses of
+// mutating requests retried with the same Idempotency-Key
+type IdempotencyConfig struct {
+	// TTL is how long a response is replayed.
+	TTL time.Duration
+	// Store is memory, or database to share the keys across replicas.
+	Store string
+}
+
+// RateLimit is a request quota
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// KubeConfig holds configuration for reaching the managed clusters
+type KubeConfig struct {
+	// Kubeconfig is the path of a kubeconfig file; every context in it is
+	// registered as a cluster named after the context.
+	Kubeconfig string
+	// InCluster registers the cluster the server runs in under InClusterName.
+	InCluster     bool
+	InClusterName string
+}
+
+// AuthConfig holds configuration for authentication and authorization
+type AuthConfig struct {
+	// TokenFile is a CSV file of bearer tokens in the Kubernetes static token
+	// file format: token,user,uid,"group1,group2".
+	TokenFile string
+	// PolicyFile is a JSON file of authorization rules.
+	PolicyFile string
+}
+
+// ExecConfig holds configuration for interactive exec and attach sessions
+type ExecConfig struct {
+	IdleTimeout     time.Duration
+	MaxDuration     time.Duration
+	MaxRecordedSize int
+}
+
+// WatchConfig holds configuration for resource watch streams
+type WatchConfig struct {
+	HeartbeatInterval time.Duration
+	BufferSize        int
+	HistorySize       int
+	SyncTimeout       time.Duration
+}
+
+// OperationsConfig holds configuration for long-running operations
+type OperationsConfig struct {
+	Timeout   time.Duration
+	Retention time.Duration
+}
+
+// ApplyConfig holds configuration for applying manifests
+type ApplyConfig struct {
+	// FieldManager is recorded in managedFields when a request names none.
+	FieldManager string
+	MaxBodySize  int
+}
+
+// DrainConfig holds the defaults of node drains
+type DrainConfig struct {
+	// GracePeriodSeconds overrides the termination grace period of evicted
+	// pods. A negative value keeps each pod's own.
+	GracePeriodSeconds int
+	Timeout            time.Duration
+	// RetryInterval is the initial delay between eviction attempts refused
+	// by a PodDisruptionBudget.
+	RetryInterval time.Duration
+}
+
+// ProvisioningConfig holds configuration for namespace provisioning
+type ProvisioningConfig struct {
+	// TemplatesFile is a YAML or JSON file of the templates namespaces are
+	// provisioned from. Provisioning is unavailable without it.
+	TemplatesFile string
+}
+
+// ApprovalConfig holds configuration for operations that need a second
+// principal's approval
+type ApprovalConfig struct {
+	// TTL is how long an approval stays pending before it expires.
+	TTL time.Duration
+	// Retention is how long decided approvals remain queryable.
+	Retention time.Duration
+	// Store is memory, or database to keep pending approvals across
+	// restarts and replicas.
+	Store string
+}
+
+// NotifyConfig holds configuration for outbound notifications
+type NotifyConfig struct {
+	// SinksFile is a YAML or JSON file of the sinks notifications are sent
+	// to. Nothing is sent without it.
+	SinksFile string
+	// Timeout bounds a single delivery attempt.
+	Timeout time.Duration
+	// MaxAttempts is how often a delivery is tried before it is
+	// dead-lettered.
+	MaxAttempts int
+	// RetryBackoff is the delay before the second attempt, doubling for
+	// each further one.
+	RetryBackoff time.Duration
+	// QueueSize is how many deliveries may wait to be sent.
+	QueueSize int
+	// DeadLetterStore is memory, or database to keep failed deliveries
+	// across restarts.
+	DeadLetterStore string
+	// DeadLetterRetention is how long failed deliveries are kept.
+	DeadLetterRetention time.Duration
+}
+
+// EventsConfig holds configuration for the collection of Kubernetes events
+type EventsConfig struct {
+	// Enabled watches the events of every registered cluster and keeps them
+	// beyond their expiry in the cluster.
+	Enabled bool
+	// Store is memory, or database to keep events across restarts and share
+	// them between replicas.
+	Store string
+	// Retention is how long events are kept after they last occurred.
+	Retention time.Duration
+}
+
+// AuditConfig holds configuration for the audit trail
+type AuditConfig struct {
+	// Sink is one of log, database, jsonl or webhook.
+	Sink           string
+	JSONLPath      string
+	WebhookURL     string `secret:"true"`
+	WebhookTimeout time.Duration
+}
+
+// DatabaseConfig holds configuration for the persistence layer
+type DatabaseConfig struct {
+	// Driver is sqlite or postgres. The server runs without a database when
+	// it is empty.
+	Driver string
+	// DSN is the database file for SQLite and the connection URL for Postgres.
+	DSN string `secret:"true"`
+	// AutoMigrate applies pending migrations on startup. Without it the
+	// server refuses to start on an outdated schema.
+	AutoMigrate  bool
+	MaxOpenConns int
 }
 
 // Load loads configuration from environment variables with sensible defaults
 func Load() *Config {
-	return &Config{
+	malformed = nil
+	cfg := &Config{
 		Server: ServerConfig{
-			Port: getEnv("PORT", "8080"),
+			Port:           getEnv("PORT", "8080"),
+			ReadTimeout:    getEnvAsDuration("READ_TIMEOUT", 10*time.Second),
+			WriteTimeout:   getEnvAsDuration("WRITE_TIMEOUT", 10*time.Second),
+			IdleTimeout:    getEnvAsDuration("IDLE_TIMEOUT", 120*time.Second),
+			TrustedProxies: getEnvAsList("TRUSTED_PROXIES", nil),
+		},
+		Requests: RequestConfig{
+			Timeout:          getEnvAsDuration("REQUEST_TIMEOUT", 30*time.Second),
+			TimeoutOverrides: getEnvAsDurations("REQUEST_TIMEOUT_OVERRIDES"),
+			MaxInFlight:      getEnvAsInt("REQUEST_MAX_IN_FLIGHT", 256),
+			MaxQueueDelay:    getEnvAsDuration("REQUEST_MAX_QUEUE_DELAY", 500*time.Millisecond),
+			IDHeaders:        getEnvAsList("REQUEST_ID_HEADERS", []string{"X-Request-ID"}),
+			IDMaxLength:      getEnvAsInt("REQUEST_ID_MAX_LENGTH", 128),
+		},
+		TLS: TLSConfig{
+			CertFile:       getEnv("TLS_CERT_FILE", ""),
+			KeyFile:        getEnv("TLS_KEY_FILE", ""),
+			ClientCAFile:   getEnv("TLS_CLIENT_CA_FILE", ""),
+			ClientAuth:     getEnv("TLS_CLIENT_AUTH", "optional"),
+			MinVersion:     getEnv("TLS_MIN_VERSION", "1.2"),
+			CipherSuites:   getEnvAsList("TLS_CIPHER_SUITES", nil),
+			ReloadInterval: getEnvAsDuration("TLS_RELOAD_INTERVAL", 30*time.Second),
+		},
+		Admin: AdminConfig{
+			Addr:      getEnvAllowEmpty("ADMIN_ADDR", "127.0.0.1:9090"),
+			Socket:    getEnv("ADMIN_SOCKET", ""),
+			TokenFile: getEnv("ADMIN_TOKEN_FILE", ""),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: getEnvAsList("CORS_ALLOWED_ORIGINS", []string{"*"}),
+			AllowedMethods: getEnvAsList("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}),
+			AllowedHeaders: getEnvAsList("CORS_ALLOWED_HEADERS",
+				[]string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Request-ID", "Idempotency-Key",
+					"If-Match", "If-None-Match"}),
+			ExposedHeaders: getEnvAsList("CORS_EXPOSED_HEADERS",
+				[]string{"Link", "Location", "X-Request-ID", "Retry-After", "Idempotent-Replayed", "ETag",
+					"RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset"}),
+			AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", false),
+			MaxAge:           getEnvAsDuration("CORS_MAX_AGE", 5*time.Minute),
+			PolicyFile:       getEnv("CORS_POLICY_FILE", ""),
+			ReloadInterval:   getEnvAsDuration("CORS_RELOAD_INTERVAL", 30*time.Second),
+		},
+		Compression: CompressionConfig{
+			Enabled: getEnvAsBool("COMPRESSION_ENABLED", true),
+			MinSize: getEnvAsInt("COMPRESSION_MIN_SIZE", 1024),
+			Types: getEnvAsList("COMPRESSION_TYPES",
+				[]string{"application/json", "application/yaml", "application/x-yaml", "text/*"}),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:   getEnvAsBool("RATE_LIMIT_ENABLED", true),
+			Rate:      getEnvAsFloat("RATE_LIMIT_RATE", 10),
+			Burst:     getEnvAsInt("RATE_LIMIT_BURST", 40),
+			Overrides: getEnvAsRateLimits("RATE_LIMIT_OVERRIDES"),
+			Store:     getEnv("RATE_LIMIT_STORE", "memory"),
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:   getEnvAsDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+			Store: getEnv("IDEMPOTENCY_STORE", "memory"),
+		},
+		Kube: KubeConfig{
+			Kubeconfig:    getEnv("KUBECONFIG", ""),
+			InCluster:     getEnvAsBool("KUBE_IN_CLUSTER", false),
+			InClusterName: getEnv("KUBE_IN_CLUSTER_NAME", "local"),
+		},
+		Auth: AuthConfig{
+			TokenFile:  getEnv("AUTH_TOKEN_FILE", ""),
+			PolicyFile: getEnv("AUTH_POLICY_FILE", ""),
+		},
+		Exec: ExecConfig{
+			IdleTimeout:     getEnvAsDuration("EXEC_IDLE_TIMEOUT", 15*time.Minute),
+			MaxDuration:     getEnvAsDuration("EXEC_MAX_DURATION", 4*time.Hour),
+			MaxRecordedSize: getEnvAsInt("EXEC_MAX_RECORDED_BYTES", 1<<20),
+		},
+		Watch: WatchConfig{
+			HeartbeatInterval: getEnvAsDuration("WATCH_HEARTBEAT_INTERVAL", 15*time.Second),
+			BufferSize:        getEnvAsInt("WATCH_BUFFER_SIZE", 256),
+			HistorySize:       getEnvAsInt("WATCH_HISTORY_SIZE", 1000),
+			SyncTimeout:       getEnvAsDuration("WATCH_SYNC_TIMEOUT", 30*time.Second),
+		},
+		Operations: OperationsConfig{
+			Timeout:   getEnvAsDuration("OPERATION_TIMEOUT", 15*time.Minute),
+			Retention: getEnvAsDuration("OPERATION_RETENTION", 24*time.Hour),
+		},
+		Apply: ApplyConfig{
+			FieldManager: getEnv("APPLY_FIELD_MANAGER", "iu-k8s"),
+			MaxBodySize:  getEnvAsInt("APPLY_MAX_BODY_BYTES", 4<<20),
+		},
+		Drain: DrainConfig{
+			GracePeriodSeconds: getEnvAsInt("DRAIN_GRACE_PERIOD_SECONDS", -1),
+			Timeout:            getEnvAsDuration("DRAIN_TIMEOUT", 10*time.Minute),
+			RetryInterval:      getEnvAsDuration("DRAIN_RETRY_INTERVAL", 5*time.Second),
+		},
+		Provisioning: ProvisioningConfig{
+			TemplatesFile: getEnv("PROVISIONING_TEMPLATES_FILE", ""),
+		},
+		Approvals: ApprovalConfig{
+			TTL:       getEnvAsDuration("APPROVAL_TTL", 24*time.Hour),
+			Retention: getEnvAsDuration("APPROVAL_RETENTION", 7*24*time.Hour),
+			Store:     getEnv("APPROVAL_STORE", "memory"),
+		},
+		Notify: NotifyConfig{
+			SinksFile:           getEnv("NOTIFY_SINKS_FILE", ""),
+			Timeout:             getEnvAsDuration("NOTIFY_TIMEOUT", 10*time.Second),
+			MaxAttempts:         getEnvAsInt("NOTIFY_MAX_ATTEMPTS", 5),
+			RetryBackoff:        getEnvAsDuration("NOTIFY_RETRY_BACKOFF", 2*time.Second),
+			QueueSize:           getEnvAsInt("NOTIFY_QUEUE_SIZE", 1000),
+			DeadLetterStore:     getEnv("NOTIFY_DEAD_LETTER_STORE", "memory"),
+			DeadLetterRetention: getEnvAsDuration("NOTIFY_DEAD_LETTER_RETENTION", 7*24*time.Hour),
+		},
+		Events: EventsConfig{
+			Enabled:   getEnvAsBool("EVENTS_ENABLED", false),
+			Store:     getEnv("EVENTS_STORE", "memory"),
+			Retention: getEnvAsDuration("EVENTS_RETENTION", 7*24*time.Hour),
+		},
+		Audit: AuditConfig{
+			Sink:           getEnv("AUDIT_SINK", "log"),
+			JSONLPath:      getEnv("AUDIT_JSONL_PATH", "audit.jsonl"),
+			WebhookURL:     getEnv("AUDIT_WEBHOOK_URL", ""),
+			WebhookTimeout: getEnvAsDuration("AUDIT_WEBHOOK_TIMEOUT", 5*time.Second),
+		},
+		Database: DatabaseConfig{
+			Driver:       getEnv("DATABASE_DRIVER", ""),
+			DSN:          getEnv("DATABASE_DSN", ""),
+			AutoMigrate:  getEnvAsBool("DATABASE_AUTO_MIGRATE", false),
+			MaxOpenConns: getEnvAsInt("DATABASE_MAX_OPEN_CONNS", 10),
 		},
 	}
+	cfg.malformed = malformed
+	return cfg
 }
 
+// malformed collects the variables getEnvAs* failed to parse during Load.
+var malformed []string
+
 // getEnv gets an environment variable with a fallback value
 func getEnv(key, fallback string) string {
 	if value := os.Getenv(key); value != "" {
@@ -32,12 +441,105 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// getEnvAllowEmpty gets an environment variable with a fallback value that
+// only applies when it is unset, so that it can be set to empty
+func getEnvAllowEmpty(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+// getEnvAsList gets a comma-separated environment variable as a list,
+// dropping empty items, with a fallback value
+func getEnvAsList(key string, fallback []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 // getEnvAsInt gets an environment variable as integer with a fallback value
 func getEnvAsInt(key string, fallback int) int {
 	if value := os.Getenv(key); value != "" {
 		if intVal, err := strconv.Atoi(value); err == nil {
 			return intVal
 		}
+		malformed = append(malformed, key)
+	}
+	return fallback
+}
+
+// getEnvAsFloat gets an environment variable as float with a fallback value
+func getEnvAsFloat(key string, fallback float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+		malformed = append(malformed, key)
 	}
 	return fallback
 }
+
+// getEnvAsRateLimits gets an environment variable of comma-separated
+// name=rate:burst items as a map of rate limits
+func getEnvAsRateLimits(key string) map[string]RateLimit {
+	limits := map[string]RateLimit{}
+	for _, item := range getEnvAsList(key, nil) {
+		name, quota, ok := strings.Cut(item, "=")
+		rate, burst, ok2 := strings.Cut(quota, ":")
+		rateVal, err := strconv.ParseFloat(rate, 64)
+		burstVal, err2 := strconv.Atoi(burst)
+		if !ok || !ok2 || err != nil || err2 != nil || name == "" {
+			malformed = append(malformed, key)
+			return nil
+		}
+		limits[strings.TrimSpace(name)] = RateLimit{Rate: rateVal, Burst: burstVal}
+	}
+	return limits
+}
+
+// getEnvAsBool gets an environment variable as boolean with a fallback value
+func getEnvAsBool(key string, fallback bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+		malformed = append(malformed, key)
+	}
+	return fallback
+}
+
+// getEnvAsDuration gets an environment variable as duration (e.g. "30s") with a fallback value
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if durVal, err := time.ParseDuration(value); err == nil {
+			return durVal
+		}
+		malformed = append(malformed, key)
+	}
+	return fallback
+}
+
+// getEnvAsDurations gets an environment variable of comma-separated
+// name=duration items as a map of durations
+func getEnvAsDurations(key string) map[string]time.Duration {
+	durations := map[string]time.Duration{}
+	for _, item := range getEnvAsList(key, nil) {
+		name, value, ok := strings.Cut(item, "=")
+		durVal, err := time.ParseDuration(value)
+		if !ok || err != nil || name == "" {
+			malformed = append(malformed, key)
+			return nil
+		}
+		durations[strings.TrimSpace(name)] = durVal
+	}
+	return durations
+}
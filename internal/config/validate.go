@@ -0,0 +1,224 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/netip"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Validate reports every problem of the configuration at once. Load never
+// fails, so this is where malformed values and inconsistent settings surface.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	for _, key := range c.malformed {
+		errs = append(errs, fmt.Errorf("%s: invalid value %q", key, os.Getenv(key)))
+	}
+
+	port, err := strconv.Atoi(c.Server.Port)
+	check(err == nil && port > 0 && port < 65536, "PORT: %q is not a valid port", c.Server.Port)
+	for _, cidr := range c.Server.TrustedProxies {
+		_, err := netip.ParsePrefix(cidr)
+		check(err == nil, "TRUSTED_PROXIES: %q is not a CIDR such as 10.0.0.0/8", cidr)
+	}
+
+	if c.Admin.Addr != "" {
+		_, port, err := net.SplitHostPort(c.Admin.Addr)
+		check(err == nil && port != "", "ADMIN_ADDR: %q is not a host:port address", c.Admin.Addr)
+	}
+	check(c.Admin.Addr != "" || c.Admin.Socket != "", "ADMIN_ADDR and ADMIN_SOCKET: set at least one")
+
+	check((c.TLS.CertFile == "") == (c.TLS.KeyFile == ""), "TLS_CERT_FILE and TLS_KEY_FILE: set both or neither")
+	check(c.TLS.ClientCAFile == "" || c.TLS.CertFile != "", "TLS_CLIENT_CA_FILE: requires TLS_CERT_FILE")
+	check(c.TLS.ClientAuth == "optional" || c.TLS.ClientAuth == "require",
+		"TLS_CLIENT_AUTH: %q is not optional or require", c.TLS.ClientAuth)
+	check(c.TLS.MinVersion == "1.2" || c.TLS.MinVersion == "1.3",
+		"TLS_MIN_VERSION: %q is not 1.2 or 1.3", c.TLS.MinVersion)
+
+	for _, file := range []struct{ key, path string }{
+		{"TLS_CERT_FILE", c.TLS.CertFile},
+		{"TLS_KEY_FILE", c.TLS.KeyFile},
+		{"TLS_CLIENT_CA_FILE", c.TLS.ClientCAFile},
+		{"KUBECONFIG", c.Kube.Kubeconfig},
+		{"AUTH_TOKEN_FILE", c.Auth.TokenFile},
+		{"AUTH_POLICY_FILE", c.Auth.PolicyFile},
+		{"ADMIN_TOKEN_FILE", c.Admin.TokenFile},
+		{"CORS_POLICY_FILE", c.CORS.PolicyFile},
+		{"PROVISIONING_TEMPLATES_FILE", c.Provisioning.TemplatesFile},
+		{"NOTIFY_SINKS_FILE", c.Notify.SinksFile},
+	} {
+		if file.path != "" {
+			_, err := os.Stat(file.path)
+			check(err == nil, "%s: %v", file.key, err)
+		}
+	}
+
+	for _, d := range []struct {
+		key   string
+		value time.Duration
+	}{
+		{"READ_TIMEOUT", c.Server.ReadTimeout},
+		{"WRITE_TIMEOUT", c.Server.WriteTimeout},
+		{"IDLE_TIMEOUT", c.Server.IdleTimeout},
+		{"REQUEST_TIMEOUT", c.Requests.Timeout},
+		{"REQUEST_MAX_QUEUE_DELAY", c.Requests.MaxQueueDelay},
+		{"EXEC_IDLE_TIMEOUT", c.Exec.IdleTimeout},
+		{"EXEC_MAX_DURATION", c.Exec.MaxDuration},
+		{"WATCH_HEARTBEAT_INTERVAL", c.Watch.HeartbeatInterval},
+		{"WATCH_SYNC_TIMEOUT", c.Watch.SyncTimeout},
+		{"OPERATION_TIMEOUT", c.Operations.Timeout},
+		{"OPERATION_RETENTION", c.Operations.Retention},
+		{"DRAIN_TIMEOUT", c.Drain.Timeout},
+		{"DRAIN_RETRY_INTERVAL", c.Drain.RetryInterval},
+		{"AUDIT_WEBHOOK_TIMEOUT", c.Audit.WebhookTimeout},
+		{"TLS_RELOAD_INTERVAL", c.TLS.ReloadInterval},
+		{"IDEMPOTENCY_TTL", c.Idempotency.TTL},
+		{"CORS_RELOAD_INTERVAL", c.CORS.ReloadInterval},
+		{"APPROVAL_TTL", c.Approvals.TTL},
+		{"APPROVAL_RETENTION", c.Approvals.Retention},
+		{"NOTIFY_TIMEOUT", c.Notify.Timeout},
+		{"NOTIFY_RETRY_BACKOFF", c.Notify.RetryBackoff},
+		{"NOTIFY_DEAD_LETTER_RETENTION", c.Notify.DeadLetterRetention},
+		{"EVENTS_RETENTION", c.Events.Retention},
+	} {
+		check(d.value > 0, "%s: must be positive", d.key)
+	}
+	for _, n := range []struct {
+		key   string
+		value int
+	}{
+		{"WATCH_BUFFER_SIZE", c.Watch.BufferSize},
+		{"WATCH_HISTORY_SIZE", c.Watch.HistorySize},
+		{"APPLY_MAX_BODY_BYTES", c.Apply.MaxBodySize},
+		{"DATABASE_MAX_OPEN_CONNS", c.Database.MaxOpenConns},
+		{"REQUEST_ID_MAX_LENGTH", c.Requests.IDMaxLength},
+		{"NOTIFY_MAX_ATTEMPTS", c.Notify.MaxAttempts},
+		{"NOTIFY_QUEUE_SIZE", c.Notify.QueueSize},
+	} {
+		check(n.value > 0, "%s: must be positive", n.key)
+	}
+	for name, timeout := range c.Requests.TimeoutOverrides {
+		check(timeout > 0, "REQUEST_TIMEOUT_OVERRIDES: %s: must be positive", name)
+	}
+	check(c.Requests.MaxInFlight >= 0, "REQUEST_MAX_IN_FLIGHT: must not be negative")
+	check(c.Exec.MaxRecordedSize >= 0, "EXEC_MAX_RECORDED_BYTES: must not be negative")
+	check(c.Compression.MinSize >= 0, "COMPRESSION_MIN_SIZE: must not be negative")
+	for _, mediaType := range c.Compression.Types {
+		_, _, err := mime.ParseMediaType(mediaType)
+		check(err == nil, "COMPRESSION_TYPES: %q is not a media type", mediaType)
+	}
+
+	switch c.Database.Driver {
+	case "":
+	case "sqlite", "postgres":
+		check(c.Database.DSN != "", "DATABASE_DSN: required with DATABASE_DRIVER=%s", c.Database.Driver)
+	default:
+		check(false, "DATABASE_DRIVER: unknown driver %q", c.Database.Driver)
+	}
+
+	if c.RateLimit.Enabled {
+		check(c.RateLimit.Rate > 0, "RATE_LIMIT_RATE: must be positive")
+		check(c.RateLimit.Burst > 0, "RATE_LIMIT_BURST: must be positive")
+		for name, limit := range c.RateLimit.Overrides {
+			check(limit.Rate > 0 && limit.Burst > 0, "RATE_LIMIT_OVERRIDES: %s: rate and burst must be positive", name)
+		}
+		switch c.RateLimit.Store {
+		case "memory":
+		case "database":
+			check(c.Database.Driver != "", "RATE_LIMIT_STORE: database requires DATABASE_DRIVER")
+		default:
+			check(false, "RATE_LIMIT_STORE: unknown store %q", c.RateLimit.Store)
+		}
+	}
+
+	switch c.Idempotency.Store {
+	case "memory":
+	case "database":
+		check(c.Database.Driver != "", "IDEMPOTENCY_STORE: database requires DATABASE_DRIVER")
+	default:
+		check(false, "IDEMPOTENCY_STORE: unknown store %q", c.Idempotency.Store)
+	}
+
+	switch c.Approvals.Store {
+	case "memory":
+	case "database":
+		check(c.Database.Driver != "", "APPROVAL_STORE: database requires DATABASE_DRIVER")
+	default:
+		check(false, "APPROVAL_STORE: unknown store %q", c.Approvals.Store)
+	}
+
+	switch c.Notify.DeadLetterStore {
+	case "memory":
+	case "database":
+		check(c.Database.Driver != "", "NOTIFY_DEAD_LETTER_STORE: database requires DATABASE_DRIVER")
+	default:
+		check(false, "NOTIFY_DEAD_LETTER_STORE: unknown store %q", c.Notify.DeadLetterStore)
+	}
+
+	if c.Events.Enabled {
+		switch c.Events.Store {
+		case "memory":
+		case "database":
+			check(c.Database.Driver != "", "EVENTS_STORE: database requires DATABASE_DRIVER")
+		default:
+			check(false, "EVENTS_STORE: unknown store %q", c.Events.Store)
+		}
+	}
+
+	switch c.Audit.Sink {
+	case "log":
+	case "database":
+		check(c.Database.Driver != "", "AUDIT_SINK: database requires DATABASE_DRIVER")
+	case "jsonl":
+		check(c.Audit.JSONLPath != "", "AUDIT_JSONL_PATH: required with AUDIT_SINK=jsonl")
+	case "webhook":
+		check(c.Audit.WebhookURL != "", "AUDIT_WEBHOOK_URL: required with AUDIT_SINK=webhook")
+	default:
+		check(false, "AUDIT_SINK: unknown sink %q", c.Audit.Sink)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Setting is a single configuration value in printable form.
+type Setting struct {
+	Name  string
+	Value string
+}
+
+// redacted replaces the value of settings tagged secret.
+const redacted = "[redacted]"
+
+// Settings flattens the configuration into Section.Field settings in
+// declaration order. Fields tagged secret are redacted unless empty.
+func (c *Config) Settings() []Setting {
+	var settings []Setting
+	root := reflect.ValueOf(c).Elem()
+	for i := 0; i < root.NumField(); i++ {
+		section := root.Type().Field(i)
+		if !section.IsExported() {
+			continue
+		}
+		value := root.Field(i)
+		for j := 0; j < value.NumField(); j++ {
+			field := value.Type().Field(j)
+			text := fmt.Sprint(value.Field(j).Interface())
+			if field.Tag.Get("secret") == "true" && text != "" {
+				text = redacted
+			}
+			settings = append(settings, Setting{Name: section.Name + "." + field.Name, Value: text})
+		}
+	}
+	return settings
+}
@@ -0,0 +1,202 @@
+// Package corsconfig applies the cross-origin policy of the public API and
+// keeps it current as its policy file changes.
+package corsconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/cors"
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+// policyFile is the format of CORS_POLICY_FILE. Settings it leaves out
+// keep their values from the environment.
+type policyFile struct {
+	AllowedOrigins   *[]string `json:"allowedOrigins"`
+	AllowedMethods   *[]string `json:"allowedMethods"`
+	AllowedHeaders   *[]string `json:"allowedHeaders"`
+	ExposedHeaders   *[]string `json:"exposedHeaders"`
+	AllowCredentials *bool     `json:"allowCredentials"`
+	// MaxAge is a duration such as "10m".
+	MaxAge *string `json:"maxAge"`
+}
+
+// Reloader serves the policy last read from the policy file, or the one of
+// the environment without a file.
+type Reloader struct {
+	base config.CORSConfig
+
+	policy atomic.Pointer[policy]
+	// loaded is the file content the current policy was built from.
+	loaded []byte
+}
+
+// policy is a checked policy and its handler.
+type policy struct {
+	cors *cors.Cors
+	// origins are the allowed origins, lower-cased.
+	origins []string
+}
+
+// New builds the policy described by cfg. The returned Reloader must be
+// run to pick up changes of the policy file.
+func New(cfg config.CORSConfig) (*Reloader, error) {
+	r := &Reloader{base: cfg}
+	if cfg.PolicyFile == "" {
+		p, err := build(cfg)
+		if err != nil {
+			return nil, err
+		}
+		r.policy.Store(p)
+		return r, nil
+	}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Handler applies the current policy to the requests of next.
+func (r *Reloader) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.policy.Load().cors.Handler(next).ServeHTTP(w, req)
+	})
+}
+
+// OriginAllowed reports whether the current policy lists origin, exactly
+// or through a wildcard such as https://*.example.com. A lone "*" does not
+// count: it allows no origin to act with the user's credentials.
+func (r *Reloader) OriginAllowed(origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, allowed := range r.policy.Load().origins {
+		if allowed == "*" {
+			continue
+		}
+		prefix, suffix, wildcard := strings.Cut(allowed, "*")
+		if !wildcard && origin == allowed {
+			return true
+		}
+		if wildcard && len(origin) >= len(prefix)+len(suffix) &&
+			strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Run checks the policy file every interval until ctx is done. Failed
+// reloads are logged and the previous policy stays in use.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := r.reload()
+			if err != nil {
+				slog.Warn("Failed to reload CORS policy", "error", err)
+			} else if changed {
+				slog.Info("Reloaded CORS policy", "file", r.base.PolicyFile)
+			}
+		}
+	}
+}
+
+// reload reads the policy file and swaps in its policy if it changed. Only
+// Run and New call it, so loaded needs no lock.
+func (r *Reloader) reload() (bool, error) {
+	data, err := os.ReadFile(r.base.PolicyFile)
+	if err != nil {
+		return false, err
+	}
+	if r.policy.Load() != nil && bytes.Equal(data, r.loaded) {
+		return false, nil
+	}
+
+	var file policyFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return false, fmt.Errorf("load %s: %w", r.base.PolicyFile, err)
+	}
+	cfg := r.base
+	for _, s := range []struct {
+		from *[]string
+		to   *[]string
+	}{
+		{file.AllowedOrigins, &cfg.AllowedOrigins},
+		{file.AllowedMethods, &cfg.AllowedMethods},
+		{file.AllowedHeaders, &cfg.AllowedHeaders},
+		{file.ExposedHeaders, &cfg.ExposedHeaders},
+	} {
+		if s.from != nil {
+			*s.to = *s.from
+		}
+	}
+	if file.AllowCredentials != nil {
+		cfg.AllowCredentials = *file.AllowCredentials
+	}
+	if file.MaxAge != nil {
+		if cfg.MaxAge, err = time.ParseDuration(*file.MaxAge); err != nil {
+			return false, fmt.Errorf("load %s: maxAge: %w", r.base.PolicyFile, err)
+		}
+	}
+
+	p, err := build(cfg)
+	if err != nil {
+		return false, fmt.Errorf("load %s: %w", r.base.PolicyFile, err)
+	}
+	r.policy.Store(p)
+	r.loaded = data
+	return true, nil
+}
+
+// build checks a policy and creates its handler.
+func build(cfg config.CORSConfig) (*policy, error) {
+	var errs []error
+	// The cors package allows every origin when none is listed.
+	if len(cfg.AllowedOrigins) == 0 {
+		errs = append(errs, errors.New("allowed origins: must not be empty"))
+	}
+	for _, origin := range cfg.AllowedOrigins {
+		if strings.Count(origin, "*") > 1 {
+			errs = append(errs, fmt.Errorf("allowed origins: %q has more than one wildcard", origin))
+		}
+		// Allowed origins are echoed back, so a lone wildcard with
+		// credentials would let every site act as the signed-in user.
+		if origin == "*" && cfg.AllowCredentials {
+			errs = append(errs, errors.New("allow credentials: requires explicit origins instead of *"))
+		}
+	}
+	if cfg.MaxAge < 0 {
+		errs = append(errs, errors.New("max age: must not be negative"))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	p := &policy{cors: cors.New(cors.Options{
+		AllowedOrigins:   cfg.AllowedOrigins,
+		AllowedMethods:   cfg.AllowedMethods,
+		AllowedHeaders:   cfg.AllowedHeaders,
+		ExposedHeaders:   cfg.ExposedHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           int(cfg.MaxAge.Seconds()),
+	})}
+	for _, origin := range cfg.AllowedOrigins {
+		p.origins = append(p.origins, strings.ToLower(origin))
+	}
+	return p, nil
+}
@@ -0,0 +1,245 @@
+package corsconfig
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+// testConfig is the default policy of the environment.
+func testConfig(origins ...string) config.CORSConfig {
+	return config.CORSConfig{
+		AllowedOrigins: origins,
+		AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "Idempotency-Key", "If-Match"},
+		ExposedHeaders: []string{"Location", "X-Request-ID", "ETag"},
+		MaxAge:         5 * time.Minute,
+	}
+}
+
+func newReloader(t *testing.T, cfg config.CORSConfig) *Reloader {
+	t.Helper()
+	r, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return r
+}
+
+// preflight sends a preflight request for method from origin through r.
+func preflight(r *Reloader, origin, method string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/clusters", nil)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", method)
+	if len(headers) > 0 {
+		req.Header.Set("Access-Control-Request-Headers", strings.Join(headers, ","))
+	}
+	rec := httptest.NewRecorder()
+	r.Handler(http.NotFoundHandler()).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPreflight(t *testing.T) {
+	withoutPatch := testConfig("https://app.example.com")
+	withoutPatch.AllowedMethods = []string{"GET", "POST"}
+	tests := []struct {
+		name    string
+		cfg     config.CORSConfig
+		origin  string
+		method  string
+		headers []string
+		// allowOrigin is the expected Access-Control-Allow-Origin, empty
+		// if the preflight must fail.
+		allowOrigin string
+	}{
+		{"any origin", testConfig("*"), "https://app.example.com", "GET", nil, "*"},
+		{"listed origin", testConfig("https://app.example.com"), "https://app.example.com", "POST", nil, "https://app.example.com"},
+		{"unlisted origin", testConfig("https://app.example.com"), "https://evil.example.org", "GET", nil, ""},
+		{"wildcard subdomain", testConfig("https://*.example.com"), "https://app.example.com", "GET", nil, "https://app.example.com"},
+		{"wildcard nested subdomain", testConfig("https://*.example.com"), "https://a.b.example.com", "GET", nil, "https://a.b.example.com"},
+		{"wildcard bare domain", testConfig("https://*.example.com"), "https://example.com", "GET", nil, ""},
+		{"wildcard lookalike", testConfig("https://*.example.com"), "https://evilexample.com", "GET", nil, ""},
+		{"wildcard other scheme", testConfig("https://*.example.com"), "http://app.example.com", "GET", nil, ""},
+		{"patch", testConfig("https://app.example.com"), "https://app.example.com", "PATCH", nil, "https://app.example.com"},
+		{"allowed headers", testConfig("https://app.example.com"), "https://app.example.com", "PATCH",
+			[]string{"Authorization", "Content-Type", "If-Match"}, "https://app.example.com"},
+		{"unlisted header", testConfig("https://app.example.com"), "https://app.example.com", "GET", []string{"X-Secret"}, ""},
+		{"unlisted method", withoutPatch, "https://app.example.com", "PATCH", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := preflight(newReloader(t, tt.cfg), tt.origin, tt.method, tt.headers...)
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.allowOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.allowOrigin)
+			}
+			if tt.allowOrigin == "" {
+				return
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Methods"); got != tt.method {
+				t.Errorf("Access-Control-Allow-Methods = %q, want %q", got, tt.method)
+			}
+			if got := rec.Header().Get("Access-Control-Max-Age"); got != "300" {
+				t.Errorf("Access-Control-Max-Age = %q, want 300", got)
+			}
+		})
+	}
+}
+
+func TestCredentials(t *testing.T) {
+	cfg := testConfig("https://app.example.com", "https://*.example.org")
+	cfg.AllowCredentials = true
+	r := newReloader(t, cfg)
+
+	for _, origin := range []string{"https://app.example.com", "https://team.example.org"} {
+		rec := preflight(r, origin, "DELETE")
+		// Browsers refuse credentials with *, so the origin is echoed.
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != origin {
+			t.Errorf("%s: Access-Control-Allow-Origin = %q, want the origin", origin, got)
+		}
+		if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "true" {
+			t.Errorf("%s: Access-Control-Allow-Credentials = %q, want true", origin, got)
+		}
+	}
+
+	rec := preflight(r, "https://evil.example.net", "DELETE")
+	if rec.Header().Get("Access-Control-Allow-Origin") != "" || rec.Header().Get("Access-Control-Allow-Credentials") != "" {
+		t.Errorf("unlisted origin got %v", rec.Header())
+	}
+
+	cfg.AllowedOrigins = []string{"https://app.example.com", "*"}
+	if _, err := New(cfg); err == nil || !strings.Contains(err.Error(), "requires explicit origins") {
+		t.Errorf("New with credentials and * = %v, want an error", err)
+	}
+}
+
+func TestExposedHeaders(t *testing.T) {
+	r := newReloader(t, testConfig("https://app.example.com"))
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/clusters", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	rec := httptest.NewRecorder()
+	r.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"1"`)
+	})).ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Access-Control-Expose-Headers"); got != "Location, X-Request-Id, Etag" {
+		t.Errorf("Access-Control-Expose-Headers = %q, want the configured headers", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want the origin", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+		t.Errorf("Access-Control-Allow-Credentials = %q without credentials", got)
+	}
+}
+
+func TestOriginAllowed(t *testing.T) {
+	r := newReloader(t, testConfig("*", "https://App.example.com", "https://*.example.org"))
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{"https://app.example.com", true},
+		{"HTTPS://APP.EXAMPLE.COM", true},
+		{"https://team.example.org", true},
+		{"https://example.org", false},
+		// * alone allows no origin to act with credentials.
+		{"https://other.example.net", false},
+	}
+	for _, tt := range tests {
+		if got := r.OriginAllowed(tt.origin); got != tt.want {
+			t.Errorf("OriginAllowed(%q) = %v, want %v", tt.origin, got, tt.want)
+		}
+	}
+}
+
+func TestInvalidPolicies(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(*config.CORSConfig)
+		want string
+	}{
+		{"no origins", func(c *config.CORSConfig) { c.AllowedOrigins = nil }, "must not be empty"},
+		{"two wildcards", func(c *config.CORSConfig) { c.AllowedOrigins = []string{"https://*.*.example.com"} }, "more than one wildcard"},
+		{"credentials with *", func(c *config.CORSConfig) { c.AllowedOrigins = []string{"*"}; c.AllowCredentials = true }, "requires explicit origins"},
+		{"negative max age", func(c *config.CORSConfig) { c.MaxAge = -time.Second }, "must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig("https://app.example.com")
+			tt.edit(&cfg)
+			if _, err := New(cfg); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("New = %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "cors.json")
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`{"allowedOrigins": ["https://app.example.com"]}`)
+	cfg := testConfig("https://env.example.com")
+	cfg.PolicyFile = file
+	r := newReloader(t, cfg)
+
+	// The file overrides the origins of the environment and keeps the rest.
+	if !r.OriginAllowed("https://app.example.com") || r.OriginAllowed("https://env.example.com") {
+		t.Error("file origins were not applied")
+	}
+	if got := preflight(r, "https://app.example.com", "PATCH").Header().Get("Access-Control-Max-Age"); got != "300" {
+		t.Errorf("Access-Control-Max-Age = %q, want the environment's 300", got)
+	}
+
+	if changed, err := r.reload(); changed || err != nil {
+		t.Errorf("reload of an unchanged file = %v, %v, want false, nil", changed, err)
+	}
+
+	write(`{"allowedOrigins": ["https://*.example.net"], "allowCredentials": true, "maxAge": "1m"}`)
+	if changed, err := r.reload(); !changed || err != nil {
+		t.Fatalf("reload = %v, %v, want true, nil", changed, err)
+	}
+	rec := preflight(r, "https://team.example.net", "GET")
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://team.example.net" {
+		t.Errorf("Access-Control-Allow-Origin = %q after reload, want the new origin", got)
+	}
+	if rec.Header().Get("Access-Control-Allow-Credentials") != "true" || rec.Header().Get("Access-Control-Max-Age") != "60" {
+		t.Errorf("reloaded policy sent %v, want credentials and a max age of 60", rec.Header())
+	}
+	if r.OriginAllowed("https://app.example.com") {
+		t.Error("origin of the previous policy is still allowed")
+	}
+
+	// Invalid files keep the previous policy.
+	for _, content := range []string{
+		`{"allowedOrigins": ["*"], "allowCredentials": true}`,
+		`{"allowedOrigin": ["https://app.example.com"]}`,
+		`{"maxAge": "soon"}`,
+		`not json`,
+	} {
+		write(content)
+		if _, err := r.reload(); err == nil {
+			t.Errorf("reload of %s succeeded", content)
+		}
+		if !r.OriginAllowed("https://team.example.net") {
+			t.Errorf("reload of %s dropped the previous policy", content)
+		}
+	}
+
+	// A missing file fails New.
+	cfg.PolicyFile = filepath.Join(t.TempDir(), "missing.json")
+	if _, err := New(cfg); err == nil {
+		t.Error("New with a missing policy file succeeded")
+	}
+}
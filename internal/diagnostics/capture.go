@@ -0,0 +1,237 @@
+package diagnostics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/pprof"
+	"runtime/trace"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"iu-k8s.linecorp.com/server/internal/recovery"
+)
+
+var (
+	// ErrNotFound is returned for unknown or expired capture IDs.
+	ErrNotFound = errors.New("capture not found")
+	// ErrBusy is returned when a capture of the same kind is running.
+	ErrBusy = errors.New("a capture of this kind is already running")
+	// ErrNotReady is returned when downloading a capture that has not
+	// succeeded.
+	ErrNotReady = errors.New("capture has not succeeded")
+)
+
+// retainedCaptures is how many captures are kept in memory. Traces of busy
+// servers run to tens of megabytes a minute.
+const retainedCaptures = 5
+
+// errShutdown fails captures still running when the capturer shuts down.
+var errShutdown = errors.New("capture interrupted by server shutdown")
+
+// Kind is what a capture records.
+type Kind string
+
+const (
+	KindCPU   Kind = "cpu"
+	KindTrace Kind = "trace"
+)
+
+// Status is the lifecycle state of a capture.
+type Status string
+
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// Capture is a CPU profile or execution trace recorded in the background.
+type Capture struct {
+	ID         string
+	Kind       Kind
+	Status     Status
+	Principal  string
+	Duration   time.Duration
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	// Size is the size of the recorded data once the capture succeeded.
+	Size  int
+	Error string
+}
+
+// FileName suggests a file name for the recorded data.
+func (c Capture) FileName() string {
+	if c.Kind == KindTrace {
+		return fmt.Sprintf("trace-%s.out", c.ID)
+	}
+	return fmt.Sprintf("cpu-%s.pprof", c.ID)
+}
+
+// Capturer records captures and keeps the latest of them in memory.
+type Capturer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu sync.RWMutex
+	// captures are ordered oldest first.
+	captures []*capture
+}
+
+type capture struct {
+	Capture
+	data []byte
+}
+
+// NewCapturer creates a capturer.
+func NewCapturer() *Capturer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Capturer{ctx: ctx, cancel: cancel}
+}
+
+// Start records a capture of kind for duration in the background. Only one
+// capture of each kind runs at a time, since the runtime supports a single
+// CPU profile and a single trace.
+func (c *Capturer) Start(kind Kind, duration time.Duration, principal string) (Capture, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.captures {
+		if e.Kind == kind && e.Status == StatusRunning {
+			return Capture{}, ErrBusy
+		}
+	}
+
+	e := &capture{Capture: Capture{
+		ID:        uuid.NewString(),
+		Kind:      kind,
+		Status:    StatusRunning,
+		Principal: principal,
+		Duration:  duration,
+		StartedAt: time.Now(),
+	}}
+	c.captures = append(c.captures, e)
+	c.evict()
+
+	c.wg.Add(1)
+	go c.run(e)
+	return e.Capture, nil
+}
+
+func (c *Capturer) run(e *capture) {
+	defer c.wg.Done()
+
+	var buf bytes.Buffer
+	err := recovery.Catch(c.ctx, "capture", func() error {
+		return record(c.ctx, e.Kind, e.Duration, &buf)
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	e.FinishedAt = &now
+	if err != nil {
+		e.Status = StatusFailed
+		e.Error = err.Error()
+		return
+	}
+	e.Status = StatusSucceeded
+	e.data = buf.Bytes()
+	e.Size = len(e.data)
+}
+
+// record writes a capture of kind to w until duration passed or ctx is done.
+func record(ctx context.Context, kind Kind, duration time.Duration, w io.Writer) error {
+	var stop func()
+	switch kind {
+	case KindCPU:
+		if err := pprof.StartCPUProfile(w); err != nil {
+			return err
+		}
+		stop = pprof.StopCPUProfile
+	case KindTrace:
+		if err := trace.Start(w); err != nil {
+			return err
+		}
+		stop = trace.Stop
+	default:
+		return fmt.Errorf("unknown capture kind %q", kind)
+	}
+	defer stop()
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return errShutdown
+	}
+}
+
+// evict drops the oldest finished captures beyond the retention limit. The
+// caller holds the lock.
+func (c *Capturer) evict() {
+	for len(c.captures) > retainedCaptures {
+		i := slices.IndexFunc(c.captures, func(e *capture) bool { return e.Status != StatusRunning })
+		if i < 0 {
+			return
+		}
+		c.captures = slices.Delete(c.captures, i, i+1)
+	}
+}
+
+// List returns the retained captures, newest first.
+func (c *Capturer) List() []Capture {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	list := make([]Capture, 0, len(c.captures))
+	for i := len(c.captures) - 1; i >= 0; i-- {
+		list = append(list, c.captures[i].Capture)
+	}
+	return list
+}
+
+// Get returns the current state of a capture.
+func (c *Capturer) Get(id string) (Capture, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e := c.find(id)
+	if e == nil {
+		return Capture{}, ErrNotFound
+	}
+	return e.Capture, nil
+}
+
+// Data returns the recorded data of a succeeded capture.
+func (c *Capturer) Data(id string) (Capture, []byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e := c.find(id)
+	if e == nil {
+		return Capture{}, nil, ErrNotFound
+	}
+	if e.Status != StatusSucceeded {
+		return e.Capture, nil, ErrNotReady
+	}
+	return e.Capture, e.data, nil
+}
+
+func (c *Capturer) find(id string) *capture {
+	for _, e := range c.captures {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// Shutdown fails the running captures and waits for them to stop.
+func (c *Capturer) Shutdown() {
+	c.cancel()
+	c.wg.Wait()
+}
@@ -0,0 +1,79 @@
+// Package diagnostics inspects the running server: runtime statistics,
+// profiles and timed CPU profile or execution trace captures.
+package diagnostics
+
+import (
+	"bytes"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+)
+
+// started approximates the start of the process.
+var started = time.Now()
+
+// recentPauses is how many of the latest GC pauses Stats reports.
+const recentPauses = 16
+
+// Stats is a snapshot of the Go runtime.
+type Stats struct {
+	GoVersion  string
+	NumCPU     int
+	GOMAXPROCS int
+	Goroutines int
+	Threads    int
+	// OpenFDs is -1 where the platform does not expose open descriptors.
+	OpenFDs   int
+	StartedAt time.Time
+	Memory    runtime.MemStats
+	// RecentPauses are the latest GC pauses, newest first.
+	RecentPauses []time.Duration
+}
+
+// ReadStats takes a snapshot of the runtime. It stops the world briefly to
+// read the memory statistics.
+func ReadStats() Stats {
+	s := Stats{
+		GoVersion:  runtime.Version(),
+		NumCPU:     runtime.NumCPU(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		Goroutines: runtime.NumGoroutine(),
+		Threads:    pprof.Lookup("threadcreate").Count(),
+		OpenFDs:    openFDs(),
+		StartedAt:  started,
+	}
+	runtime.ReadMemStats(&s.Memory)
+
+	// PauseNs is a circular buffer whose latest entry is at NumGC-1.
+	n := min(int(s.Memory.NumGC), recentPauses, len(s.Memory.PauseNs))
+	for i := range n {
+		idx := (int(s.Memory.NumGC) - 1 - i + len(s.Memory.PauseNs)) % len(s.Memory.PauseNs)
+		s.RecentPauses = append(s.RecentPauses, time.Duration(s.Memory.PauseNs[idx]))
+	}
+	return s
+}
+
+// openFDs counts the entries of /proc/self/fd, which only Linux has.
+func openFDs() int {
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		return -1
+	}
+	// Reading the directory opened a descriptor of its own.
+	return len(entries) - 1
+}
+
+// Profile writes the named pprof profile. debug above 0 selects the text
+// format. It returns false for unknown profiles.
+func Profile(name string, debug int) ([]byte, bool, error) {
+	p := pprof.Lookup(name)
+	if p == nil {
+		return nil, false, nil
+	}
+	var buf bytes.Buffer
+	if err := p.WriteTo(&buf, debug); err != nil {
+		return nil, true, err
+	}
+	return buf.Bytes(), true, nil
+}
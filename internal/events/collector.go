@@ -0,0 +1,174 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/metrics"
+	"iu-k8s.linecorp.com/server/internal/recovery"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// sweepInterval is how often events past the retention period are deleted.
+const sweepInterval = time.Hour
+
+// Collector watches the events of every registered cluster and saves them
+// to a store. Events deleted from a cluster stay in the store; events that
+// recur update their saved copy.
+type Collector struct {
+	store     Store
+	retention time.Duration
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewCollector starts watching the events of the clusters registered in
+// clusters, saving them to store for retention after they last occurred.
+func NewCollector(clusters *kube.Registry, store Store, retention time.Duration) *Collector {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Collector{
+		store:     store,
+		retention: retention,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	for _, name := range clusters.Names() {
+		cluster, err := clusters.Get(name)
+		if err != nil {
+			continue
+		}
+		c.wg.Add(1)
+		go c.watch(cluster)
+	}
+	c.wg.Add(1)
+	go c.sweep()
+	return c
+}
+
+// Shutdown stops watching and waits for pending saves.
+func (c *Collector) Shutdown() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+// watch saves the events of cluster until the collector shuts down. The
+// initial list saves the events still in the cluster; copies saved before
+// a restart are left as they are.
+func (c *Collector) watch(cluster *kube.Cluster) {
+	defer c.wg.Done()
+	logger := slog.With("component", "events", "cluster", cluster.Name)
+
+	informer := coreinformers.NewEventInformer(cluster.Clientset, metav1.NamespaceAll, 0, cache.Indexers{})
+	_ = informer.SetTransform(func(obj any) (any, error) {
+		// The cache holds every event of the cluster; managed fields would
+		// take up most of it.
+		if event, ok := obj.(*corev1.Event); ok {
+			event.ManagedFields = nil
+		}
+		return obj, nil
+	})
+	_ = informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+		logger.Warn("watch failed", "error", err)
+	})
+	save := func(obj any) {
+		event, ok := obj.(*corev1.Event)
+		if !ok {
+			return
+		}
+		// A panic saving one event must not stop the collection.
+		err := recovery.Catch(log.With(c.ctx, logger), "events", func() error {
+			return c.store.SaveEvent(c.ctx, fromKube(cluster.Name, event))
+		})
+		if err != nil {
+			if c.ctx.Err() == nil {
+				logger.Warn("Failed to save event", "namespace", event.Namespace, "name", event.Name, "error", err)
+			}
+			metrics.EventCollected(cluster.Name, "failed")
+			return
+		}
+		metrics.EventCollected(cluster.Name, "saved")
+	}
+	_, _ = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: save,
+		UpdateFunc: func(_, obj any) {
+			save(obj)
+		},
+	})
+
+	logger.Debug("starting event collection")
+	informer.RunWithContext(c.ctx)
+}
+
+// sweep deletes the events that have not occurred for the retention period.
+func (c *Collector) sweep() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := c.store.DeleteEvents(c.ctx, now.Add(-c.retention)); err != nil {
+				slog.Warn("Failed to delete old events", "error", err)
+			}
+		}
+	}
+}
+
+// fromKube converts an event of cluster. Events reported through the
+// events.k8s.io API carry their times and count in the event time and
+// series instead of the legacy fields.
+func fromKube(cluster string, e *corev1.Event) Event {
+	first := e.FirstTimestamp.Time
+	if first.IsZero() {
+		first = e.EventTime.Time
+	}
+	if first.IsZero() {
+		first = e.CreationTimestamp.Time
+	}
+	last, count := e.LastTimestamp.Time, int(e.Count)
+	if e.Series != nil {
+		last, count = e.Series.LastObservedTime.Time, int(e.Series.Count)
+	}
+	if last.Before(first) {
+		last = first
+	}
+	source := e.Source.Component
+	if source == "" {
+		source = e.ReportingController
+	}
+
+	return Event{
+		Cluster:   cluster,
+		UID:       string(e.UID),
+		Namespace: e.Namespace,
+		Name:      e.Name,
+		Type:      e.Type,
+		Reason:    e.Reason,
+		Message:   e.Message,
+		Object: ObjectReference{
+			APIVersion: e.InvolvedObject.APIVersion,
+			Kind:       e.InvolvedObject.Kind,
+			Namespace:  e.InvolvedObject.Namespace,
+			Name:       e.InvolvedObject.Name,
+			UID:        string(e.InvolvedObject.UID),
+			FieldPath:  e.InvolvedObject.FieldPath,
+		},
+		Source:          source,
+		Count:           max(count, 1),
+		FirstSeen:       first.UTC(),
+		LastSeen:        last.UTC(),
+		ResourceVersion: e.ResourceVersion,
+	}
+}
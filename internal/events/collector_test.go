@@ -0,0 +1,119 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var start = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func backOff(resourceVersion string, count int32, last time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default", Name: "web-0.backoff", UID: "event-1", ResourceVersion: resourceVersion,
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "web-0"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "BackOff",
+		Message:        "Back-off restarting failed container",
+		Source:         corev1.EventSource{Component: "kubelet"},
+		Count:          count,
+		FirstTimestamp: metav1.NewTime(start),
+		LastTimestamp:  metav1.NewTime(last),
+	}
+}
+
+// waitForEvents polls store until it holds an event matching done.
+func waitForEvents(t *testing.T, store Store, done func([]Event) bool) []Event {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		page, err := store.QueryEvents(context.Background(), Query{Limit: 10})
+		if err != nil {
+			t.Fatalf("QueryEvents: %v", err)
+		}
+		if done(page.Events) {
+			return page.Events
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("events %+v after 5s", page.Events)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCollectorUpdatesRecurringEvents(t *testing.T) {
+	client := fake.NewClientset(backOff("1", 1, start))
+	clusters := kube.NewRegistry()
+	clusters.AddCluster(&kube.Cluster{Name: "dev", Clientset: client})
+	store := NewMemory()
+	c := NewCollector(clusters, store, time.Hour)
+	defer c.Shutdown()
+
+	saved := waitForEvents(t, store, func(es []Event) bool { return len(es) == 1 })
+	if e := saved[0]; e.Cluster != "dev" || e.UID != "event-1" || e.Count != 1 || e.Object.Name != "web-0" {
+		t.Fatalf("saved %+v, want the event of web-0", e)
+	}
+
+	// Kubernetes counts a recurring event on the same object.
+	_, err := client.CoreV1().Events("default").Update(context.Background(), backOff("2", 5, start.Add(time.Minute)), metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := waitForEvents(t, store, func(es []Event) bool { return len(es) == 1 && es[0].Count == 5 })
+	if e := updated[0]; e.ID != saved[0].ID || !e.LastSeen.Equal(start.Add(time.Minute)) || !e.FirstSeen.Equal(start) {
+		t.Errorf("updated %+v, want the saved event, last seen a minute later", e)
+	}
+
+	// The collection stops with the collector; the saved copy stays.
+	c.Shutdown()
+	if err := client.CoreV1().Events("default").Delete(context.Background(), "web-0.backoff", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if page, _ := store.QueryEvents(context.Background(), Query{Limit: 10}); len(page.Events) != 1 {
+		t.Errorf("%d events after the cluster dropped it, want the saved copy", len(page.Events))
+	}
+}
+
+func TestFromKube(t *testing.T) {
+	series := backOff("1", 0, time.Time{})
+	series.FirstTimestamp = metav1.Time{}
+	series.LastTimestamp = metav1.Time{}
+	series.EventTime = metav1.NewMicroTime(start)
+	series.Series = &corev1.EventSeries{Count: 7, LastObservedTime: metav1.NewMicroTime(start.Add(time.Hour))}
+	series.Source = corev1.EventSource{}
+	series.ReportingController = "kubelet"
+
+	created := backOff("1", 0, time.Time{})
+	created.FirstTimestamp = metav1.Time{}
+	created.LastTimestamp = metav1.Time{}
+	created.CreationTimestamp = metav1.NewTime(start)
+
+	tests := []struct {
+		name        string
+		event       *corev1.Event
+		count       int
+		first, last time.Time
+	}{
+		{"legacy", backOff("1", 3, start.Add(time.Minute)), 3, start, start.Add(time.Minute)},
+		{"series", series, 7, start, start.Add(time.Hour)},
+		{"creation time only", created, 1, start, start},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := fromKube("dev", tt.event)
+			if e.Count != tt.count || !e.FirstSeen.Equal(tt.first) || !e.LastSeen.Equal(tt.last) {
+				t.Errorf("count %d from %v to %v, want %d from %v to %v", e.Count, e.FirstSeen, e.LastSeen, tt.count, tt.first, tt.last)
+			}
+			if e.Source != "kubelet" {
+				t.Errorf("source %q, want kubelet", e.Source)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+// Package events keeps the Events of the registered clusters beyond the hour
+// Kubernetes retains them. A collector watches every cluster and saves each
+// event, in memory or in the database, updating the saved copy as the event
+// recurs, until it has not occurred for the retention period.
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ErrInvalidCursor is returned for a cursor that matches no saved event,
+// such as one deleted after the previous page was read.
+var ErrInvalidCursor = errors.New("cursor does not match a saved event")
+
+// Event is a Kubernetes event collected from a cluster.
+type Event struct {
+	// ID is assigned by the store and kept as the event recurs.
+	ID      int64
+	Cluster string
+	// UID identifies the event in its cluster.
+	UID       string
+	Namespace string
+	Name      string
+	// Type is Normal or Warning.
+	Type    string
+	Reason  string
+	Message string
+	// Object is the object the event is about.
+	Object ObjectReference
+	// Source is the component that reported the event.
+	Source string
+	// Count is how often the event occurred from FirstSeen to LastSeen.
+	Count     int
+	FirstSeen time.Time
+	LastSeen  time.Time
+	// ResourceVersion tells updates of an event from copies saved before.
+	ResourceVersion string
+}
+
+// ObjectReference identifies the object an event is about.
+type ObjectReference struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name,omitempty"`
+	UID        string `json:"uid,omitempty"`
+	// FieldPath points into the object, such as to a container of a pod.
+	FieldPath string `json:"fieldPath,omitempty"`
+}
+
+// Filter selects events. Zero fields match everything.
+type Filter struct {
+	Cluster string
+	// Namespace is the namespace of the event. Events about cluster-scoped
+	// objects are usually in default.
+	Namespace string
+	// Kind and Name select the object the event is about.
+	Kind   string
+	Name   string
+	Reason string
+	Type   string
+	// Since and Until select the events that occurred at or after Since
+	// and before Until.
+	Since time.Time
+	Until time.Time
+}
+
+// Match reports whether e passes the filter.
+func (f Filter) Match(e Event) bool {
+	switch {
+	case f.Cluster != "" && e.Cluster != f.Cluster,
+		f.Namespace != "" && e.Namespace != f.Namespace,
+		f.Kind != "" && e.Object.Kind != f.Kind,
+		f.Name != "" && e.Object.Name != f.Name,
+		f.Reason != "" && e.Reason != f.Reason,
+		f.Type != "" && e.Type != f.Type,
+		!f.Since.IsZero() && e.LastSeen.Before(f.Since),
+		!f.Until.IsZero() && !e.FirstSeen.Before(f.Until):
+		return false
+	}
+	return true
+}
+
+// Query is a page request over the saved events, the ones that occurred
+// most recently first.
+type Query struct {
+	Filter
+	// Cursor is the ID of the last event of the previous page; zero starts
+	// from the most recent event.
+	Cursor int64
+	Limit  int
+}
+
+// Page is a page of events.
+type Page struct {
+	Events []Event
+	// Cursor continues with the next page when HasMore is set.
+	Cursor  int64
+	HasMore bool
+}
+
+// Store keeps collected events. Each method must be atomic.
+type Store interface {
+	// SaveEvent saves e, or updates the event of the same cluster and UID
+	// unless that has the same resource version or occurred later.
+	SaveEvent(ctx context.Context, e Event) error
+	// QueryEvents returns a page of the events matching query, ordered by
+	// LastSeen and then ID, descending. It fails with ErrInvalidCursor if
+	// the cursor matches no event.
+	QueryEvents(ctx context.Context, query Query) (Page, error)
+	// DeleteEvents deletes the events that last occurred before t.
+	DeleteEvents(ctx context.Context, before time.Time) error
+}
+
+// newer reports whether a sorts before b in a page.
+func newer(a, b Event) bool {
+	if !a.LastSeen.Equal(b.LastSeen) {
+		return a.LastSeen.After(b.LastSeen)
+	}
+	return a.ID > b.ID
+}
+
+type eventKey struct {
+	cluster string
+	uid     string
+}
+
+// Memory is a Store for a single replica.
+type Memory struct {
+	mu     sync.Mutex
+	events map[eventKey]Event
+	lastID int64
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{events: map[eventKey]Event{}}
+}
+
+// SaveEvent implements Store.
+func (m *Memory) SaveEvent(ctx context.Context, e Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := eventKey{cluster: e.Cluster, uid: e.UID}
+	stored, ok := m.events[key]
+	switch {
+	case !ok:
+		m.lastID++
+		e.ID = m.lastID
+	case stored.ResourceVersion == e.ResourceVersion, stored.LastSeen.After(e.LastSeen):
+		return nil
+	default:
+		e.ID = stored.ID
+	}
+	m.events[key] = e
+	return nil
+}
+
+// QueryEvents implements Store.
+func (m *Memory) QueryEvents(ctx context.Context, query Query) (Page, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var cursor *Event
+	if query.Cursor > 0 {
+		for _, e := range m.events {
+			if e.ID == query.Cursor {
+				cursor = &e
+				break
+			}
+		}
+		if cursor == nil {
+			return Page{}, fmt.Errorf("%w: %d", ErrInvalidCursor, query.Cursor)
+		}
+	}
+	var matched []Event
+	for _, e := range m.events {
+		if (cursor == nil || newer(*cursor, e)) && query.Match(e) {
+			matched = append(matched, e)
+		}
+	}
+	slices.SortFunc(matched, func(a, b Event) int {
+		if newer(a, b) {
+			return -1
+		}
+		return 1
+	})
+
+	page := Page{Events: matched[:min(query.Limit, len(matched))]}
+	if len(page.Events) > 0 && len(page.Events) < len(matched) {
+		page.HasMore = true
+		page.Cursor = page.Events[len(page.Events)-1].ID
+	}
+	return page, nil
+}
+
+// DeleteEvents implements Store.
+func (m *Memory) DeleteEvents(ctx context.Context, before time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, e := range m.events {
+		if e.LastSeen.Before(before) {
+			delete(m.events, key)
+		}
+	}
+	return nil
+}
@@ -2,16 +2,75 @@ package handlers
 
 import (
 	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/approval"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/diagnostics"
+	"iu-k8s.linecorp.com/server/internal/events"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/notify"
+	"iu-k8s.linecorp.com/server/internal/operation"
+	"iu-k8s.linecorp.com/server/internal/storage"
 )
 
 var _ api.StrictServerInterface = (*aggregated)(nil)
 
 type aggregated struct {
+	*ApprovalHandler
+	*AuditHandler
+	*ClusterHandler
+	*DiagnosticsHandler
+	*EventHandler
 	*ManagementHandler
+	*ManifestHandler
+	*NamespaceHandler
+	*NodeHandler
+	*NotifyHandler
+	*OperationHandler
+	*PodHandler
+	*UsageHandler
+	*WatchHandler
+	*WorkloadHandler
 }
 
-func New() *aggregated {
-	return &aggregated{
-		ManagementHandler: &ManagementHandler{},
+// Dependencies are the shared services the handlers are built from.
+type Dependencies struct {
+	Config     *config.Config
+	Clusters   *kube.Registry
+	Authorizer auth.Authorizer
+	Audit      audit.Sink
+	Watches    *kube.WatchHub
+	Operations *operation.Manager
+	Captures   *diagnostics.Capturer
+	Templates  kube.NamespaceTemplates
+	Approvals  *approval.Manager
+	Notifier   *notify.Notifier
+	// Events is nil when event collection is disabled.
+	Events events.Store
+	// Repository is nil when no database is configured.
+	Repository storage.Repository
+}
+
+func New(deps Dependencies) *aggregated {
+	agg := &aggregated{
+		ApprovalHandler:    NewApprovalHandler(deps.Authorizer, deps.Approvals, deps.Audit),
+		AuditHandler:       NewAuditHandler(deps.Authorizer, deps.Audit),
+		ClusterHandler:     NewClusterHandler(deps.Clusters, deps.Authorizer),
+		DiagnosticsHandler: NewDiagnosticsHandler(deps.Captures),
+		EventHandler:       NewEventHandler(deps.Clusters, deps.Authorizer, deps.Events, deps.Audit),
+		ManagementHandler:  NewManagementHandler(deps.Config, deps.Repository),
+		ManifestHandler:    NewManifestHandler(deps.Clusters, deps.Authorizer, deps.Config.Apply),
+		NamespaceHandler:   NewNamespaceHandler(deps.Clusters, deps.Authorizer, deps.Templates),
+		NodeHandler:        NewNodeHandler(deps.Clusters, deps.Authorizer, deps.Operations, deps.Config.Drain),
+		NotifyHandler:      NewNotifyHandler(deps.Notifier),
+		OperationHandler:   NewOperationHandler(deps.Authorizer, deps.Operations),
+		PodHandler:         NewPodHandler(deps.Clusters, deps.Authorizer),
+		UsageHandler:       NewUsageHandler(deps.Clusters, deps.Authorizer),
+		WatchHandler:       NewWatchHandler(deps.Clusters, deps.Authorizer, deps.Watches, deps.Config.Watch),
+		WorkloadHandler:    NewWorkloadHandler(deps.Clusters, deps.Authorizer, deps.Operations),
 	}
+	// Approved operations run through the same handlers.
+	agg.ApprovalHandler.server = agg
+	return agg
 }
@@ -0,0 +1,402 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/approval"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/requestid"
+)
+
+const (
+	defaultApprovalPageSize = 50
+	maxApprovalPageSize     = 500
+)
+
+var readerType = reflect.TypeFor[io.Reader]()
+
+type ApprovalHandler struct {
+	authorizer auth.Authorizer
+	approvals  *approval.Manager
+	recorder   audit.Recorder
+	// server runs approved operations. New sets it to the aggregated
+	// handler, which embeds this one.
+	server api.StrictServerInterface
+}
+
+func NewApprovalHandler(authorizer auth.Authorizer, approvals *approval.Manager, recorder audit.Recorder) *ApprovalHandler {
+	return &ApprovalHandler{
+		authorizer: authorizer,
+		approvals:  approvals,
+		recorder:   recorder,
+	}
+}
+
+// WritePendingApproval answers a request held back for approval with 202
+// and the pending approval.
+func WritePendingApproval(w http.ResponseWriter, r *http.Request, a *approval.Approval) {
+	w.Header().Set("Location", "/api/v1/approvals/"+a.ID)
+	render.Status(r, http.StatusAccepted)
+	render.JSON(w, r, toAPIApproval(a))
+}
+
+// ListApprovals lists the approvals the caller requested or may decide
+// (GET /api/v1/approvals)
+func (h *ApprovalHandler) ListApprovals(ctx context.Context, request api.ListApprovalsRequestObject) (api.ListApprovalsResponseObject, error) {
+	filter := approval.Filter{Limit: defaultApprovalPageSize}
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 {
+			return api.ListApprovals400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+				errorBody(ctx, "invalid_limit", "limit must be positive"),
+			)}, nil
+		}
+		filter.Limit = min(*request.Params.Limit, maxApprovalPageSize)
+	}
+	if request.Params.Status != nil {
+		filter.Status = approval.Status(*request.Params.Status)
+	}
+
+	approvals, err := h.approvals.List(ctx, filter)
+	if err != nil {
+		return api.ListApprovals500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(
+			errorBody(ctx, "internal_error", err.Error()),
+		)}, nil
+	}
+	items := []api.Approval{}
+	for i := range approvals {
+		if h.authorizeView(ctx, &approvals[i]) == nil {
+			items = append(items, toAPIApproval(&approvals[i]))
+		}
+	}
+	return api.ListApprovals200JSONResponse{Items: items}, nil
+}
+
+// GetApproval returns an approval the caller requested or may decide
+// (GET /api/v1/approvals/{approvalId})
+func (h *ApprovalHandler) GetApproval(ctx context.Context, request api.GetApprovalRequestObject) (api.GetApprovalResponseObject, error) {
+	a, err := h.approvals.Get(ctx, request.ApprovalId)
+	if err == nil {
+		err = h.authorizeView(ctx, a)
+	}
+	if err == nil {
+		return api.GetApproval200JSONResponse(toAPIApproval(a)), nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusForbidden:
+		return api.GetApproval403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetApproval404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.GetApproval500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// ApproveOperation approves a pending operation and runs it as its requester
+// (POST /api/v1/approvals/{approvalId}/approve)
+func (h *ApprovalHandler) ApproveOperation(ctx context.Context, request api.ApproveOperationRequestObject) (api.ApproveOperationResponseObject, error) {
+	a, err := h.decide(ctx, request.ApprovalId, true, request.Body)
+	if err == nil {
+		result := h.run(ctx, a)
+		if err = h.approvals.Complete(ctx, a, result); err != nil {
+			log.From(ctx).Warn("failed to record the result of an approved operation", "approvalId", a.ID, "error", err)
+			err = nil
+		}
+	}
+	if err == nil {
+		return api.ApproveOperation200JSONResponse(toAPIApproval(a)), nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.ApproveOperation400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.ApproveOperation403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.ApproveOperation404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.ApproveOperation409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	default:
+		return api.ApproveOperation500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// RejectOperation rejects a pending operation
+// (POST /api/v1/approvals/{approvalId}/reject)
+func (h *ApprovalHandler) RejectOperation(ctx context.Context, request api.RejectOperationRequestObject) (api.RejectOperationResponseObject, error) {
+	a, err := h.decide(ctx, request.ApprovalId, false, request.Body)
+	if err == nil {
+		return api.RejectOperation200JSONResponse(toAPIApproval(a)), nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.RejectOperation400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.RejectOperation403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.RejectOperation404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.RejectOperation409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	default:
+		return api.RejectOperation500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// decide checks the caller may decide an approval and records the decision.
+func (h *ApprovalHandler) decide(ctx context.Context, id string, approve bool, body *api.ApprovalDecision) (*approval.Approval, error) {
+	var comment string
+	if body != nil {
+		comment = deref(body.Comment)
+	}
+	action := "approvals.reject"
+	if approve {
+		action = "approvals.approve"
+	}
+
+	a, err := h.approvals.Get(ctx, id)
+	if err != nil {
+		describeAudit(ctx, action, audit.Target{}, map[string]any{"approvalId": id}, err)
+		return nil, err
+	}
+	details := map[string]any{"approvalId": id, "operationId": a.OperationID, "requestedBy": a.Requester.Name}
+	if comment != "" {
+		details["comment"] = comment
+	}
+	decided := a
+	err = h.authorizeDecision(ctx, a)
+	if err == nil {
+		decided, err = h.approvals.Decide(ctx, id, auth.From(ctx), approve, comment)
+	}
+	describeAudit(ctx, action, a.Target, details, err)
+	return decided, err
+}
+
+// authorizeDecision checks the caller may approve or reject operations on
+// the target of a.
+func (h *ApprovalHandler) authorizeDecision(ctx context.Context, a *approval.Approval) error {
+	return h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+		Verb:      "approve",
+		Cluster:   a.Target.Cluster,
+		Namespace: a.Target.Namespace,
+		Resource:  "approvals",
+	})
+}
+
+// authorizeView lets callers see the approvals they requested and those
+// they may decide.
+func (h *ApprovalHandler) authorizeView(ctx context.Context, a *approval.Approval) error {
+	if a.Requester.Same(auth.From(ctx)) {
+		return nil
+	}
+	return h.authorizeDecision(ctx, a)
+}
+
+// run runs an approved operation as its requester, bypassing the strict
+// middlewares, and records it in the audit trail like a request of its own.
+func (h *ApprovalHandler) run(ctx context.Context, a *approval.Approval) approval.Result {
+	started := time.Now()
+	requester := a.Requester
+	req := &audit.Request{Entry: audit.Entry{
+		Time:        started,
+		Principal:   requester.Name,
+		RequestID:   requestid.From(ctx),
+		OperationID: a.OperationID,
+		Summary:     a.Summary,
+	}}
+	if outer := audit.RequestFrom(ctx); outer != nil {
+		req.Entry.SourceIP = outer.Entry.SourceIP
+	}
+	runCtx := audit.WithRequest(auth.With(ctx, &requester), req)
+
+	rec := &recordedResponse{header: http.Header{}}
+	if err := h.invoke(runCtx, a, rec); err != nil {
+		status, body := errorStatus(ctx, err)
+		rec = &recordedResponse{header: http.Header{}, status: status}
+		_ = json.NewEncoder(&rec.body).Encode(body)
+	}
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+
+	entry := req.Entry
+	entry.Duration = time.Since(started)
+	if entry.Action == "" {
+		entry.Action = entry.OperationID
+	}
+	if entry.Details == nil {
+		entry.Details = map[string]any{}
+	}
+	entry.Details["approvalId"] = a.ID
+	entry.Details["approvedBy"] = a.DecidedBy
+	switch {
+	case entry.Outcome != "":
+	case rec.status < http.StatusBadRequest:
+		entry.Outcome = audit.OutcomeSuccess
+	case rec.status == http.StatusUnauthorized, rec.status == http.StatusForbidden:
+		entry.Outcome = audit.OutcomeDenied
+	default:
+		entry.Outcome = audit.OutcomeFailure
+	}
+	if entry.Outcome != audit.OutcomeSuccess && entry.Error == "" {
+		entry.Error = http.StatusText(rec.status)
+	}
+	if err := h.recorder.Record(context.WithoutCancel(ctx), entry); err != nil {
+		log.From(ctx).Error("failed to record audit entry", "error", err, "action", entry.Action)
+	}
+
+	result := approval.Result{Status: rec.status}
+	if body := bytes.TrimSpace(rec.body.Bytes()); json.Valid(body) {
+		result.Body = body
+	}
+	return result
+}
+
+// invoke calls the handler method of an approved operation with its stored
+// request and writes the response to w.
+func (h *ApprovalHandler) invoke(ctx context.Context, a *approval.Approval, w http.ResponseWriter) error {
+	name := strings.ToUpper(a.OperationID[:1]) + a.OperationID[1:]
+	method := reflect.ValueOf(h.server).MethodByName(name)
+	if !method.IsValid() {
+		return fmt.Errorf("unknown operation %s", a.OperationID)
+	}
+	request := reflect.New(method.Type().In(1))
+	if err := json.Unmarshal(a.Request, request.Interface()); err != nil {
+		return fmt.Errorf("decode stored request: %w", err)
+	}
+	for i := range request.Elem().NumField() {
+		if field := request.Elem().Field(i); field.Type() == readerType && a.Body != nil {
+			field.Set(reflect.ValueOf(bytes.NewReader(a.Body)))
+		}
+	}
+
+	out := method.Call([]reflect.Value{reflect.ValueOf(ctx), request.Elem()})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return err
+	}
+	visit := out[0].MethodByName("Visit" + name + "Response")
+	if !visit.IsValid() {
+		return fmt.Errorf("unexpected response type %s", out[0].Type())
+	}
+	if err, _ := visit.Call([]reflect.Value{reflect.ValueOf(w)})[0].Interface().(error); err != nil {
+		return err
+	}
+	return nil
+}
+
+// recordedResponse captures the response of an approved operation.
+type recordedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *recordedResponse) Header() http.Header {
+	return rec.header
+}
+
+func (rec *recordedResponse) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+}
+
+func (rec *recordedResponse) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.body.Write(b)
+}
+
+func toAPIApproval(a *approval.Approval) api.Approval {
+	out := api.Approval{
+		Id:          a.ID,
+		OperationId: a.OperationID,
+		Target:      api.OperationTarget{Cluster: a.Target.Cluster},
+		Summary:     a.Summary,
+		Status:      api.ApprovalStatus(a.Status),
+		RequestedBy: a.Requester.Name,
+		RequestedAt: a.RequestedAt,
+		ExpiresAt:   a.ExpiresAt,
+		DecidedBy:   optional(a.DecidedBy),
+		Comment:     optional(a.Comment),
+	}
+	if a.Target.Namespace != "" {
+		out.Target.Namespace = &a.Target.Namespace
+	}
+	if a.Target.Resource != "" {
+		out.Target.Resource = &a.Target.Resource
+	}
+	if a.Target.Name != "" {
+		out.Target.Name = &a.Target.Name
+	}
+	if !a.DecidedAt.IsZero() {
+		out.DecidedAt = &a.DecidedAt
+	}
+	if a.Result != nil {
+		out.Result = &api.ApprovalResult{Status: a.Result.Status}
+		if len(a.Result.Body) > 0 {
+			out.Result.Body = a.Result.Body
+		}
+	}
+	return out
+}
+
+// AuthorizeRequest checks the caller may perform the operation of request,
+// a request object of the generated strict handlers with its raw body read
+// into body. The Approval middleware calls it before holding an operation
+// back, so that callers cannot request approval of what they may not do.
+// Operations acting on no cluster object only need an authenticated caller;
+// their handlers check the rest when they run.
+func (a *aggregated) AuthorizeRequest(ctx context.Context, request interface{}, body []byte) error {
+	var err error
+	switch request := request.(type) {
+	case api.ScaleWorkloadRequestObject:
+		_, err = a.WorkloadHandler.authorize(ctx, newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name), "scale")
+	case api.RestartWorkloadRequestObject:
+		_, err = a.WorkloadHandler.authorize(ctx, newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name), "restart")
+	case api.PauseWorkloadRequestObject:
+		_, err = a.WorkloadHandler.authorize(ctx, newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name), "pause")
+	case api.ResumeWorkloadRequestObject:
+		_, err = a.WorkloadHandler.authorize(ctx, newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name), "resume")
+	case api.RollbackWorkloadRequestObject:
+		_, err = a.WorkloadHandler.authorize(ctx, newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name), "rollback")
+	case api.CordonNodeRequestObject:
+		_, err = a.NodeHandler.authorize(ctx, nodeTarget(request.Cluster, request.Node), "cordon")
+	case api.UncordonNodeRequestObject:
+		_, err = a.NodeHandler.authorize(ctx, nodeTarget(request.Cluster, request.Node), "uncordon")
+	case api.DrainNodeRequestObject:
+		_, err = a.NodeHandler.authorize(ctx, nodeTarget(request.Cluster, request.Node), "drain")
+	case api.ProvisionNamespaceRequestObject:
+		if request.Body == nil {
+			return fmt.Errorf("%w: request body is required", kube.ErrInvalidProvisioning)
+		}
+		_, err = a.NamespaceHandler.authorize(ctx, namespaceTarget(request.Cluster, request.Body.Name), "create")
+	case api.DeprovisionNamespaceRequestObject:
+		_, err = a.NamespaceHandler.authorize(ctx, namespaceTarget(request.Cluster, request.Namespace), "delete")
+	case api.ApplyManifestsRequestObject:
+		var namespace string
+		if request.Params.Namespace != nil {
+			namespace = *request.Params.Namespace
+		}
+		err = a.ManifestHandler.authorizeManifests(ctx, request.Cluster, body, namespace)
+	default:
+		if auth.From(ctx).IsAnonymous() {
+			err = fmt.Errorf("%w: anonymous callers cannot request approval", auth.ErrForbidden)
+		}
+	}
+	return err
+}
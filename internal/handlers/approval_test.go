@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/approval"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/operation"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	requester = auth.Principal{Name: "alice", Source: auth.SourceToken}
+	approver  = auth.Principal{Name: "carol", Source: auth.SourceToken}
+	outsider  = auth.Principal{Name: "bob", Source: auth.SourceToken}
+)
+
+// approvalServer is the API with a cluster holding the deployment web,
+// recording the replica counts it is scaled to.
+type approvalServer struct {
+	*aggregated
+	approvals  *approval.Manager
+	operations *operation.Manager
+
+	mu     sync.Mutex
+	scaled []int32
+}
+
+// newApprovalServer returns the server with approvals pending for ttl.
+func newApprovalServer(t *testing.T, ttl time.Duration) *approvalServer {
+	t.Helper()
+	replicas := int32(1)
+	client := fake.NewClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web", ResourceVersion: "1"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	})
+	s := &approvalServer{}
+	client.PrependReactor("get", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		return true, &autoscalingv1.Scale{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web", ResourceVersion: "1"},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: replicas},
+		}, nil
+	})
+	client.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		scale := action.(k8stesting.UpdateAction).GetObject().(*autoscalingv1.Scale)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.scaled = append(s.scaled, scale.Spec.Replicas)
+		return true, scale, nil
+	})
+	clusters := kube.NewRegistry()
+	clusters.AddCluster(&kube.Cluster{Name: "dev", Clientset: client})
+
+	auditSink, err := audit.OpenJSONL(filepath.Join(t.TempDir(), "audit.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { auditSink.Close() })
+	s.operations = operation.NewManager(operation.Options{Timeout: time.Minute, Retention: time.Minute})
+	t.Cleanup(s.operations.Shutdown)
+	s.approvals = approval.NewManager(approval.NewMemory(), auditSink, approval.Options{TTL: ttl, Retention: time.Hour})
+	t.Cleanup(s.approvals.Shutdown)
+
+	cfg := config.Load()
+	// The requester and the approver may do anything; others nothing.
+	policy := &auth.Policy{Rules: []auth.Rule{{
+		Users:      []string{requester.Name, approver.Name},
+		Verbs:      []string{auth.Wildcard},
+		Resources:  []string{auth.Wildcard},
+		Clusters:   []string{auth.Wildcard},
+		Namespaces: []string{auth.Wildcard},
+	}}}
+	s.aggregated = New(Dependencies{
+		Config:     cfg,
+		Clusters:   clusters,
+		Authorizer: policy,
+		Audit:      auditSink,
+		Watches:    kube.NewWatchHub(kube.WatchOptions{BufferSize: 1, SyncTimeout: cfg.Watch.SyncTimeout}),
+		Operations: s.operations,
+		Approvals:  s.approvals,
+	})
+	return s
+}
+
+// requestScale holds back scaling web to replicas for approval, as the
+// Approval middleware does for a request of by.
+func (s *approvalServer) requestScale(t *testing.T, by auth.Principal, replicas int32) *approval.Approval {
+	t.Helper()
+	data, err := json.Marshal(api.ScaleWorkloadRequestObject{
+		Cluster:   "dev",
+		Namespace: "default",
+		Workload:  api.ScaleWorkloadParamsWorkloadDeployments,
+		Name:      "web",
+		Body:      &api.ScaleWorkloadJSONRequestBody{Replicas: replicas},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := s.approvals.Request(context.Background(), approval.Approval{
+		OperationID: "scaleWorkload",
+		Target:      audit.Target{Cluster: "dev", Namespace: "default", Resource: "deployments", Name: "web"},
+		Summary:     "POST /api/v1/clusters/dev/namespaces/default/deployments/web/scale",
+		Request:     data,
+		Requester:   by,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func (s *approvalServer) approve(principal auth.Principal, id string) api.ApproveOperationResponseObject {
+	ctx := auth.With(context.Background(), &principal)
+	resp, _ := s.ApproveOperation(ctx, api.ApproveOperationRequestObject{ApprovalId: id})
+	return resp
+}
+
+func (s *approvalServer) reject(principal auth.Principal, id string) api.RejectOperationResponseObject {
+	ctx := auth.With(context.Background(), &principal)
+	resp, _ := s.RejectOperation(ctx, api.RejectOperationRequestObject{ApprovalId: id})
+	return resp
+}
+
+func (s *approvalServer) scales() []int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int32(nil), s.scaled...)
+}
+
+func TestApproveRunsApprovedRequest(t *testing.T) {
+	s := newApprovalServer(t, time.Hour)
+	a := s.requestScale(t, requester, 3)
+
+	resp, ok := s.approve(approver, a.ID).(api.ApproveOperation200JSONResponse)
+	if !ok {
+		t.Fatalf("approve: response %T, want 200", resp)
+	}
+	if resp.Status != api.ApprovalStatusApproved || deref(resp.DecidedBy) != approver.Name {
+		t.Errorf("approval %s by %q, want approved by %s", resp.Status, deref(resp.DecidedBy), approver.Name)
+	}
+	if got := s.scales(); len(got) != 1 || got[0] != 3 {
+		t.Fatalf("scaled to %v, want 3 once, as requested", got)
+	}
+
+	// The operation ran as its requester, and its response is kept.
+	stored, err := s.approvals.Get(context.Background(), a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Result == nil || stored.Result.Status != 202 {
+		t.Fatalf("result %+v, want the 202 of the scale", stored.Result)
+	}
+	var accepted api.Operation
+	if err := json.Unmarshal(stored.Result.Body, &accepted); err != nil {
+		t.Fatalf("result body %s: %v", stored.Result.Body, err)
+	}
+	op, err := s.operations.Get(accepted.Id)
+	if err != nil {
+		t.Fatalf("operation %s: %v", accepted.Id, err)
+	}
+	if !op.Principal.Same(&requester) {
+		t.Errorf("operation ran as %+v, want the requester", op.Principal)
+	}
+}
+
+func TestDecideApproval(t *testing.T) {
+	tests := []struct {
+		name      string
+		requester auth.Principal
+		decider   auth.Principal
+		approve   bool
+		wantError string
+		wantState approval.Status
+	}{
+		{"unauthorized approver", requester, outsider, true, "forbidden", approval.StatusPending},
+		{"unauthorized rejecter", requester, outsider, false, "forbidden", approval.StatusPending},
+		// The authorization of the decider is checked first.
+		{"unauthorized requester", outsider, outsider, true, "forbidden", approval.StatusPending},
+		{"requester approving", requester, requester, true, "self_approval", approval.StatusPending},
+		{"requester rejecting", requester, requester, false, "self_approval", approval.StatusPending},
+		{"requester's name from another source", requester, auth.Principal{Name: requester.Name, Source: auth.SourceCertificate},
+			true, "", approval.StatusApproved},
+		{"approved", requester, approver, true, "", approval.StatusApproved},
+		{"rejected", requester, approver, false, "", approval.StatusRejected},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newApprovalServer(t, time.Hour)
+			a := s.requestScale(t, tt.requester, 3)
+
+			var errorCode string
+			if tt.approve {
+				switch resp := s.approve(tt.decider, a.ID).(type) {
+				case api.ApproveOperation200JSONResponse:
+				case api.ApproveOperation403JSONResponse:
+					errorCode = resp.Error
+				default:
+					t.Fatalf("response %T", resp)
+				}
+			} else {
+				switch resp := s.reject(tt.decider, a.ID).(type) {
+				case api.RejectOperation200JSONResponse:
+				case api.RejectOperation403JSONResponse:
+					errorCode = resp.Error
+				default:
+					t.Fatalf("response %T", resp)
+				}
+			}
+			if errorCode != tt.wantError {
+				t.Errorf("error %q, want %q", errorCode, tt.wantError)
+			}
+			if got, _ := s.approvals.Get(context.Background(), a.ID); got.Status != tt.wantState {
+				t.Errorf("status %s, want %s", got.Status, tt.wantState)
+			}
+			wantScaled := tt.wantState == approval.StatusApproved
+			if got := s.scales(); (len(got) > 0) != wantScaled {
+				t.Errorf("scaled %v, want run: %v", got, wantScaled)
+			}
+		})
+	}
+}
+
+func TestDecideApprovalOnce(t *testing.T) {
+	s := newApprovalServer(t, time.Hour)
+	approved := s.requestScale(t, requester, 3)
+	if resp, ok := s.approve(approver, approved.ID).(api.ApproveOperation200JSONResponse); !ok {
+		t.Fatalf("approve: response %T, want 200", resp)
+	}
+	if resp, ok := s.approve(approver, approved.ID).(api.ApproveOperation409JSONResponse); !ok || resp.Error != "approval_not_pending" {
+		t.Errorf("approving again: %#v, want 409", resp)
+	}
+	if resp, ok := s.reject(approver, approved.ID).(api.RejectOperation409JSONResponse); !ok || resp.Error != "approval_not_pending" {
+		t.Errorf("rejecting after approval: %#v, want 409", resp)
+	}
+	if got := s.scales(); len(got) != 1 {
+		t.Errorf("scaled %v, want once", got)
+	}
+
+	rejected := s.requestScale(t, requester, 5)
+	if resp, ok := s.reject(approver, rejected.ID).(api.RejectOperation200JSONResponse); !ok {
+		t.Fatalf("reject: response %T, want 200", resp)
+	}
+	if resp, ok := s.approve(approver, rejected.ID).(api.ApproveOperation409JSONResponse); !ok || resp.Error != "approval_not_pending" {
+		t.Errorf("approving after rejection: %#v, want 409", resp)
+	}
+	if got := s.scales(); len(got) != 1 {
+		t.Errorf("scaled %v, want the rejected operation not run", got)
+	}
+
+	if resp, ok := s.approve(approver, "missing").(api.ApproveOperation404JSONResponse); !ok {
+		t.Errorf("approving an unknown approval: %#v, want 404", resp)
+	}
+}
+
+func TestApproveExpired(t *testing.T) {
+	s := newApprovalServer(t, time.Millisecond)
+	a := s.requestScale(t, requester, 3)
+	time.Sleep(5 * time.Millisecond)
+
+	if resp, ok := s.approve(approver, a.ID).(api.ApproveOperation409JSONResponse); !ok || resp.Error != "approval_not_pending" {
+		t.Errorf("approving an expired approval: %#v, want 409", resp)
+	}
+	if got, _ := s.approvals.Get(context.Background(), a.ID); got.Status != approval.StatusExpired {
+		t.Errorf("status %s, want expired", got.Status)
+	}
+	if got := s.scales(); len(got) != 0 {
+		t.Errorf("scaled %v, want the expired operation not run", got)
+	}
+}
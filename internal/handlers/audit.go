@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/middleware"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 500
+)
+
+type AuditHandler struct {
+	authorizer auth.Authorizer
+	sink       audit.Sink
+}
+
+func NewAuditHandler(authorizer auth.Authorizer, sink audit.Sink) *AuditHandler {
+	return &AuditHandler{
+		authorizer: authorizer,
+		sink:       sink,
+	}
+}
+
+// ListAuditEntries returns a page of the audit trail, newest first
+// (GET /api/v1/audit)
+func (h *AuditHandler) ListAuditEntries(ctx context.Context, request api.ListAuditEntriesRequestObject) (api.ListAuditEntriesResponseObject, error) {
+	if err := h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{Verb: "list", Resource: "audit"}); err != nil {
+		return api.ListAuditEntries403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(
+			errorBody(ctx, "forbidden", err.Error()),
+		)}, nil
+	}
+
+	params := request.Params
+	query := audit.Query{Limit: defaultAuditPageSize}
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			return api.ListAuditEntries400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+				errorBody(ctx, "invalid_limit", "limit must be positive"),
+			)}, nil
+		}
+		query.Limit = min(*params.Limit, maxAuditPageSize)
+	}
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
+	}
+	query.Principal = deref(params.Principal)
+	query.Cluster = deref(params.Cluster)
+	query.Namespace = deref(params.Namespace)
+	query.Action = deref(params.Action)
+	query.OperationID = deref(params.OperationId)
+	if params.Outcome != nil {
+		query.Outcome = audit.Outcome(*params.Outcome)
+	}
+	if params.Since != nil {
+		query.Since = *params.Since
+	}
+	if params.Until != nil {
+		query.Until = *params.Until
+	}
+
+	page, err := h.sink.Query(ctx, query)
+	if errors.Is(err, audit.ErrQueryUnsupported) {
+		return api.ListAuditEntries501JSONResponse{NotImplementedJSONResponse: api.NotImplementedJSONResponse(
+			errorBody(ctx, "audit_query_unsupported", err.Error()),
+		)}, nil
+	}
+	if err != nil {
+		return api.ListAuditEntries500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(
+			errorBody(ctx, "internal_error", err.Error()),
+		)}, nil
+	}
+
+	items := make([]api.AuditEntry, 0, len(page.Entries))
+	for _, entry := range page.Entries {
+		items = append(items, toAPIAuditEntry(entry))
+	}
+	return api.ListAuditEntries200JSONResponse{
+		Items: items,
+		Metadata: api.MetadataPagination{
+			Cursor:  int(page.Cursor),
+			HasMore: page.HasMore,
+		},
+	}, nil
+}
+
+func toAPIAuditEntry(entry audit.Entry) api.AuditEntry {
+	out := api.AuditEntry{
+		Id:          entry.ID,
+		Time:        entry.Time,
+		Principal:   entry.Principal,
+		SourceIp:    optional(entry.SourceIP),
+		RequestId:   optional(entry.RequestID),
+		OperationId: optional(entry.OperationID),
+		Action:      entry.Action,
+		Target: api.AuditTarget{
+			Cluster:   optional(entry.Target.Cluster),
+			Namespace: optional(entry.Target.Namespace),
+			Resource:  optional(entry.Target.Resource),
+			Name:      optional(entry.Target.Name),
+		},
+		Summary:    optional(entry.Summary),
+		Outcome:    api.AuditEntryOutcome(entry.Outcome),
+		DurationMs: entry.Duration.Milliseconds(),
+		Error:      optional(entry.Error),
+	}
+	if len(entry.Details) > 0 {
+		out.Details = &entry.Details
+	}
+	return out
+}
+
+// optional returns nil for an empty string, for omitempty response fields.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// deref returns the value p points to, or the zero value for nil.
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// describeAudit describes the action of the audited request in ctx. The
+// audit middleware records the entry once the response is written; err
+// explains a failure in it.
+func describeAudit(ctx context.Context, action string, target audit.Target, details map[string]any, err error) {
+	audit.Annotate(ctx, func(req *audit.Request) {
+		req.Entry.Action = action
+		req.Entry.Target = target
+		req.Entry.Details = details
+		if err != nil {
+			req.Entry.Error = err.Error()
+		}
+	})
+}
+
+// newAuditEntry starts an audit entry for an action of the caller in ctx,
+// for actions recorded apart from their request such as the phases of an
+// exec session.
+func newAuditEntry(ctx context.Context, action string, target audit.Target) audit.Entry {
+	entry := audit.Entry{
+		Time:      time.Now(),
+		Principal: auth.From(ctx).Name,
+		RequestID: middleware.GetReqID(ctx),
+		Action:    action,
+		Target:    target,
+		Outcome:   audit.OutcomeSuccess,
+	}
+	if req := audit.RequestFrom(ctx); req != nil {
+		entry.SourceIP = req.Entry.SourceIP
+		entry.Summary = req.Entry.Summary
+	}
+	return entry
+}
+
+// finishAuditEntry sets the outcome of an entry from the error of the action.
+func finishAuditEntry(entry *audit.Entry, started time.Time, err error) {
+	entry.Duration = time.Since(started)
+	switch {
+	case err == nil:
+		entry.Outcome = audit.OutcomeSuccess
+	case errors.Is(err, auth.ErrForbidden):
+		entry.Outcome = audit.OutcomeDenied
+		entry.Error = err.Error()
+	default:
+		entry.Outcome = audit.OutcomeFailure
+		entry.Error = err.Error()
+	}
+}
+
+// recordAudit records an entry, logging rather than failing the request when
+// the recorder is unavailable.
+func recordAudit(ctx context.Context, recorder audit.Recorder, entry audit.Entry) {
+	if err := recorder.Record(ctx, entry); err != nil {
+		log.From(ctx).Error("failed to record audit entry", "error", err, "action", entry.Action)
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/kube"
+)
+
+type ClusterHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+}
+
+func NewClusterHandler(clusters *kube.Registry, authorizer auth.Authorizer) *ClusterHandler {
+	return &ClusterHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+	}
+}
+
+// ListClusters lists the registered clusters the caller may get
+// (GET /api/v1/clusters)
+func (h *ClusterHandler) ListClusters(ctx context.Context, request api.ListClustersRequestObject) (api.ListClustersResponseObject, error) {
+	principal := auth.From(ctx)
+	items := []api.ClusterInfo{}
+	for _, name := range h.clusters.Names() {
+		err := h.authorizer.Authorize(ctx, principal, auth.Attributes{
+			Verb:     "get",
+			Cluster:  name,
+			Resource: "clusters",
+		})
+		if err == nil {
+			items = append(items, api.ClusterInfo{Name: name})
+		}
+	}
+	return api.ListClusters200JSONResponse{Items: items}, nil
+}
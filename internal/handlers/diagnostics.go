@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/diagnostics"
+)
+
+const (
+	defaultCaptureDuration = 30 * time.Second
+	maxCaptureDuration     = 300 * time.Second
+)
+
+// profiles are the pprof profiles GetProfile serves.
+var profiles = []api.GetProfileParamsProfile{
+	api.ProfileAllocs, api.ProfileBlock, api.ProfileGoroutine,
+	api.ProfileHeap, api.ProfileMutex, api.ProfileThreadcreate,
+}
+
+type DiagnosticsHandler struct {
+	captures *diagnostics.Capturer
+}
+
+func NewDiagnosticsHandler(captures *diagnostics.Capturer) *DiagnosticsHandler {
+	return &DiagnosticsHandler{captures: captures}
+}
+
+// GetRuntimeStats returns a snapshot of the Go runtime
+// (GET /debug/runtime)
+func (h *DiagnosticsHandler) GetRuntimeStats(ctx context.Context, request api.GetRuntimeStatsRequestObject) (api.GetRuntimeStatsResponseObject, error) {
+	s := diagnostics.ReadStats()
+	m := &s.Memory
+	resp := api.GetRuntimeStats200JSONResponse{
+		GoVersion:  s.GoVersion,
+		NumCPU:     s.NumCPU,
+		Gomaxprocs: s.GOMAXPROCS,
+		Goroutines: s.Goroutines,
+		Threads:    s.Threads,
+		StartedAt:  s.StartedAt,
+		Memory: api.MemoryStats{
+			Alloc:        int64(m.Alloc),
+			TotalAlloc:   int64(m.TotalAlloc),
+			Sys:          int64(m.Sys),
+			HeapAlloc:    int64(m.HeapAlloc),
+			HeapSys:      int64(m.HeapSys),
+			HeapIdle:     int64(m.HeapIdle),
+			HeapInuse:    int64(m.HeapInuse),
+			HeapReleased: int64(m.HeapReleased),
+			HeapObjects:  int64(m.HeapObjects),
+			StackInuse:   int64(m.StackInuse),
+			StackSys:     int64(m.StackSys),
+			Mallocs:      int64(m.Mallocs),
+			Frees:        int64(m.Frees),
+		},
+		Gc: api.GCStats{
+			NumGC:          int64(m.NumGC),
+			NumForcedGC:    int64(m.NumForcedGC),
+			PauseTotalNs:   int64(m.PauseTotalNs),
+			RecentPausesNs: make([]int64, len(s.RecentPauses)),
+			NextGC:         int64(m.NextGC),
+			CpuFraction:    m.GCCPUFraction,
+		},
+	}
+	for i, pause := range s.RecentPauses {
+		resp.Gc.RecentPausesNs[i] = pause.Nanoseconds()
+	}
+	if m.LastGC != 0 {
+		resp.Gc.LastGC = ptr(time.Unix(0, int64(m.LastGC)))
+	}
+	if s.OpenFDs >= 0 {
+		resp.OpenFileDescriptors = &s.OpenFDs
+	}
+	return resp, nil
+}
+
+// GetGoroutineDump returns the stacks of all goroutines
+// (GET /debug/goroutines)
+func (h *DiagnosticsHandler) GetGoroutineDump(ctx context.Context, request api.GetGoroutineDumpRequestObject) (api.GetGoroutineDumpResponseObject, error) {
+	data, _, err := diagnostics.Profile("goroutine", 2)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetGoroutineDump200TextResponse(data), nil
+}
+
+// GetProfile returns a pprof profile
+// (GET /debug/pprof/{profile})
+func (h *DiagnosticsHandler) GetProfile(ctx context.Context, request api.GetProfileRequestObject) (api.GetProfileResponseObject, error) {
+	if !slices.Contains(profiles, request.Profile) {
+		return api.GetProfile400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "unknown_profile", fmt.Sprintf("unknown profile %q", request.Profile)),
+		)}, nil
+	}
+	debug := deref(request.Params.Debug)
+	if debug < 0 || debug > 2 {
+		return api.GetProfile400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_debug", "debug must be 0, 1 or 2"),
+		)}, nil
+	}
+	if request.Profile == api.ProfileHeap && deref(request.Params.Gc) {
+		runtime.GC()
+	}
+
+	data, _, err := diagnostics.Profile(string(request.Profile), debug)
+	if err != nil {
+		return nil, err
+	}
+	if debug > 0 {
+		return api.GetProfile200TextResponse(data), nil
+	}
+	return api.GetProfile200ApplicationoctetStreamResponse{
+		Body:          bytes.NewReader(data),
+		ContentLength: int64(len(data)),
+	}, nil
+}
+
+// ListCaptures lists the retained captures
+// (GET /debug/captures)
+func (h *DiagnosticsHandler) ListCaptures(ctx context.Context, request api.ListCapturesRequestObject) (api.ListCapturesResponseObject, error) {
+	captures := h.captures.List()
+	resp := api.ListCaptures200JSONResponse{Items: make([]api.Capture, len(captures))}
+	for i, c := range captures {
+		resp.Items[i] = toAPICapture(c)
+	}
+	return resp, nil
+}
+
+// StartCapture starts recording a CPU profile or execution trace
+// (POST /debug/captures)
+func (h *DiagnosticsHandler) StartCapture(ctx context.Context, request api.StartCaptureRequestObject) (api.StartCaptureResponseObject, error) {
+	if request.Body == nil {
+		return api.StartCapture400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_request", "request body is required"),
+		)}, nil
+	}
+	kind := request.Body.Kind
+	if kind != api.CaptureCPU && kind != api.CaptureTrace {
+		return api.StartCapture400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_kind", fmt.Sprintf("kind must be cpu or trace, not %q", kind)),
+		)}, nil
+	}
+	duration := defaultCaptureDuration
+	if request.Body.DurationSeconds != nil {
+		duration = time.Duration(*request.Body.DurationSeconds) * time.Second
+		if duration <= 0 || duration > maxCaptureDuration {
+			return api.StartCapture400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+				errorBody(ctx, "invalid_duration", fmt.Sprintf("durationSeconds must be between 1 and %d", int(maxCaptureDuration.Seconds()))),
+			)}, nil
+		}
+	}
+
+	audit.Annotate(ctx, func(req *audit.Request) {
+		req.Entry.Action = "diagnostics.capture"
+		req.Entry.Details = map[string]any{"kind": string(kind), "durationSeconds": int(duration.Seconds())}
+	})
+
+	c, err := h.captures.Start(diagnostics.Kind(kind), duration, auth.From(ctx).Name)
+	if errors.Is(err, diagnostics.ErrBusy) {
+		return api.StartCapture409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(
+			errorBody(ctx, "capture_running", err.Error()),
+		)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return api.StartCapture202JSONResponse{
+		Body:    toAPICapture(c),
+		Headers: api.StartCapture202ResponseHeaders{Location: "/debug/captures/" + c.ID},
+	}, nil
+}
+
+// GetCapture returns the state of a capture
+// (GET /debug/captures/{captureId})
+func (h *DiagnosticsHandler) GetCapture(ctx context.Context, request api.GetCaptureRequestObject) (api.GetCaptureResponseObject, error) {
+	c, err := h.captures.Get(request.CaptureId)
+	if err != nil {
+		return api.GetCapture404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(
+			errorBody(ctx, "capture_not_found", err.Error()),
+		)}, nil
+	}
+	return api.GetCapture200JSONResponse(toAPICapture(c)), nil
+}
+
+// DownloadCapture returns the recorded data of a capture
+// (GET /debug/captures/{captureId}/download)
+func (h *DiagnosticsHandler) DownloadCapture(ctx context.Context, request api.DownloadCaptureRequestObject) (api.DownloadCaptureResponseObject, error) {
+	c, data, err := h.captures.Data(request.CaptureId)
+	switch {
+	case errors.Is(err, diagnostics.ErrNotFound):
+		return api.DownloadCapture404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(
+			errorBody(ctx, "capture_not_found", err.Error()),
+		)}, nil
+	case errors.Is(err, diagnostics.ErrNotReady):
+		return api.DownloadCapture409JSONResponse(
+			errorBody(ctx, "capture_"+string(c.Status), err.Error()),
+		), nil
+	}
+	return api.DownloadCapture200ApplicationoctetStreamResponse{
+		Body:          bytes.NewReader(data),
+		ContentLength: int64(len(data)),
+		Headers: api.DownloadCapture200ResponseHeaders{
+			ContentDisposition: fmt.Sprintf("attachment; filename=%q", c.FileName()),
+		},
+	}, nil
+}
+
+func toAPICapture(c diagnostics.Capture) api.Capture {
+	out := api.Capture{
+		Id:              c.ID,
+		Kind:            api.CaptureKind(c.Kind),
+		Status:          api.CaptureStatus(c.Status),
+		Principal:       c.Principal,
+		DurationSeconds: int(c.Duration.Seconds()),
+		StartedAt:       c.StartedAt,
+		FinishedAt:      c.FinishedAt,
+	}
+	if c.Status == diagnostics.StatusSucceeded {
+		out.Size = ptr(int64(c.Size))
+	}
+	if c.Error != "" {
+		out.Error = &c.Error
+	}
+	return out
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/approval"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/requestid"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// writeError writes an ErrorResponse for handlers mounted outside the
+// generated strict server.
+func writeError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	render.Status(r, status)
+	render.JSON(w, r, errorBody(r.Context(), code, message))
+}
+
+// RequestErrorHandler answers requests the generated server cannot decode,
+// such as those with a malformed parameter or body.
+func RequestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, r, http.StatusBadRequest, "invalid_request", err.Error())
+}
+
+// ResponseErrorHandler answers requests whose handler or strict middleware
+// failed instead of returning a response.
+func ResponseErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	status, body := errorStatus(r.Context(), err)
+	render.Status(r, status)
+	render.JSON(w, r, body)
+}
+
+// errorBody builds an ErrorResponse stamped with the current time and the
+// ID of the request of ctx.
+func errorBody(ctx context.Context, code, message string) api.ErrorResponse {
+	now := time.Now()
+	return api.ErrorResponse{
+		Error:     code,
+		Message:   message,
+		Timestamp: &now,
+		RequestId: optional(requestid.From(ctx)),
+	}
+}
+
+// errorStatus maps an error from the service layer or the Kubernetes API to
+// an HTTP status and ErrorResponse.
+func errorStatus(ctx context.Context, err error) (int, api.ErrorResponse) {
+	switch {
+	case errors.Is(err, kube.ErrClusterNotFound):
+		return http.StatusNotFound, errorBody(ctx, "cluster_not_found", err.Error())
+	case errors.Is(err, auth.ErrForbidden):
+		return http.StatusForbidden, errorBody(ctx, "forbidden", err.Error())
+	case errors.Is(err, kube.ErrUnsupportedWorkload):
+		return http.StatusBadRequest, errorBody(ctx, "unsupported_workload", err.Error())
+	case errors.Is(err, kube.ErrRevisionNotFound):
+		return http.StatusNotFound, errorBody(ctx, "revision_not_found", err.Error())
+	case errors.Is(err, kube.ErrInvalidManifest):
+		return http.StatusBadRequest, errorBody(ctx, "invalid_manifest", err.Error())
+	case errors.Is(err, kube.ErrInvalidOperation):
+		return http.StatusConflict, errorBody(ctx, "invalid_operation", err.Error())
+	case errors.Is(err, kube.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed, errorBody(ctx, "precondition_failed", err.Error())
+	case errors.Is(err, kube.ErrTemplateNotFound):
+		return http.StatusNotFound, errorBody(ctx, "template_not_found", err.Error())
+	case errors.Is(err, kube.ErrInvalidProvisioning):
+		return http.StatusBadRequest, errorBody(ctx, "invalid_provisioning", err.Error())
+	case errors.Is(err, kube.ErrNotProvisioned):
+		return http.StatusConflict, errorBody(ctx, "not_provisioned", err.Error())
+	case errors.Is(err, approval.ErrNotFound):
+		return http.StatusNotFound, errorBody(ctx, "approval_not_found", err.Error())
+	case errors.Is(err, approval.ErrNotPending):
+		return http.StatusConflict, errorBody(ctx, "approval_not_pending", err.Error())
+	case errors.Is(err, approval.ErrSelfApproval):
+		return http.StatusForbidden, errorBody(ctx, "self_approval", err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		// Handlers send it as an internal error, which the Timeout
+		// middleware turns into 504.
+		return http.StatusGatewayTimeout, errorBody(ctx, "timeout", err.Error())
+	case apierrors.IsNotFound(err):
+		return http.StatusNotFound, errorBody(ctx, "not_found", err.Error())
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		return http.StatusConflict, errorBody(ctx, "conflict", err.Error())
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return http.StatusBadRequest, errorBody(ctx, "invalid", err.Error())
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return http.StatusForbidden, errorBody(ctx, "cluster_forbidden", err.Error())
+	default:
+		return http.StatusInternalServerError, errorBody(ctx, "internal_error", err.Error())
+	}
+}
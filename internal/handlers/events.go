@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/events"
+	"iu-k8s.linecorp.com/server/internal/kube"
+)
+
+const (
+	defaultEventPageSize    = 50
+	maxEventPageSize        = 500
+	defaultTimelinePageSize = 100
+	maxTimelinePageSize     = 500
+)
+
+type EventHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+	store      events.Store
+	audit      audit.Sink
+}
+
+// NewEventHandler creates the handler of collected events. store is nil
+// when event collection is disabled.
+func NewEventHandler(clusters *kube.Registry, authorizer auth.Authorizer, store events.Store, auditSink audit.Sink) *EventHandler {
+	return &EventHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+		store:      store,
+		audit:      auditSink,
+	}
+}
+
+// SearchEvents returns a page of the events collected from a cluster
+// (GET /api/v1/clusters/{cluster}/events)
+func (h *EventHandler) SearchEvents(ctx context.Context, request api.SearchEventsRequestObject) (api.SearchEventsResponseObject, error) {
+	if h.store == nil {
+		return api.SearchEvents501JSONResponse{NotImplementedJSONResponse: api.NotImplementedJSONResponse(
+			errorBody(ctx, "event_collection_disabled", "event collection is disabled; set EVENTS_ENABLED=true"),
+		)}, nil
+	}
+	cluster, err := h.clusters.Get(request.Cluster)
+	if err != nil {
+		return api.SearchEvents404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(
+			errorBody(ctx, "cluster_not_found", err.Error()),
+		)}, nil
+	}
+
+	params := request.Params
+	namespace := deref(params.Namespace)
+	if err := h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+		Verb:      "list",
+		Cluster:   cluster.Name,
+		Namespace: namespace,
+		Resource:  "events",
+	}); err != nil {
+		return api.SearchEvents403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(
+			errorBody(ctx, "forbidden", err.Error()),
+		)}, nil
+	}
+
+	query := events.Query{Limit: defaultEventPageSize}
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			return api.SearchEvents400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+				errorBody(ctx, "invalid_limit", "limit must be positive"),
+			)}, nil
+		}
+		query.Limit = min(*params.Limit, maxEventPageSize)
+	}
+	query.Cursor = deref(params.Cursor)
+	query.Cluster = cluster.Name
+	query.Namespace = namespace
+	query.Kind = deref(params.Kind)
+	query.Name = deref(params.Name)
+	query.Reason = deref(params.Reason)
+	query.Type = string(deref(params.Type))
+	query.Since = deref(params.Since)
+	query.Until = deref(params.Until)
+
+	page, err := h.store.QueryEvents(ctx, query)
+	if errors.Is(err, events.ErrInvalidCursor) {
+		return api.SearchEvents400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_cursor", err.Error()),
+		)}, nil
+	}
+	if err != nil {
+		return api.SearchEvents500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(
+			errorBody(ctx, "internal_error", err.Error()),
+		)}, nil
+	}
+
+	items := make([]api.ClusterEvent, 0, len(page.Events))
+	for _, e := range page.Events {
+		items = append(items, toAPIClusterEvent(e))
+	}
+	return api.SearchEvents200JSONResponse{
+		Items: items,
+		Metadata: api.MetadataPagination{
+			Cursor:  int(page.Cursor),
+			HasMore: page.HasMore,
+		},
+	}, nil
+}
+
+// GetTimeline merges the events and audit entries of a cluster-scoped
+// object (GET /api/v1/clusters/{cluster}/timeline/{resource}/{name})
+func (h *EventHandler) GetTimeline(ctx context.Context, request api.GetTimelineRequestObject) (api.GetTimelineResponseObject, error) {
+	params := request.Params
+	timeline, status, body := h.timeline(ctx, request.Cluster, "", request.Resource, request.Name,
+		params.Since, params.Until, params.Limit)
+	switch status {
+	case http.StatusOK:
+		return api.GetTimeline200JSONResponse{TimelineJSONResponse: api.TimelineJSONResponse(timeline)}, nil
+	case http.StatusBadRequest:
+		return api.GetTimeline400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.GetTimeline403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetTimeline404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusNotImplemented:
+		return api.GetTimeline501JSONResponse{NotImplementedJSONResponse: api.NotImplementedJSONResponse(body)}, nil
+	default:
+		return api.GetTimeline500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// GetNamespacedTimeline merges the events and audit entries of an object in
+// a namespace
+// (GET /api/v1/clusters/{cluster}/timeline/namespaces/{namespace}/{resource}/{name})
+func (h *EventHandler) GetNamespacedTimeline(ctx context.Context, request api.GetNamespacedTimelineRequestObject) (api.GetNamespacedTimelineResponseObject, error) {
+	params := request.Params
+	timeline, status, body := h.timeline(ctx, request.Cluster, request.Namespace, request.Resource, request.Name,
+		params.Since, params.Until, params.Limit)
+	switch status {
+	case http.StatusOK:
+		return api.GetNamespacedTimeline200JSONResponse{TimelineJSONResponse: api.TimelineJSONResponse(timeline)}, nil
+	case http.StatusBadRequest:
+		return api.GetNamespacedTimeline400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.GetNamespacedTimeline403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetNamespacedTimeline404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusNotImplemented:
+		return api.GetNamespacedTimeline501JSONResponse{NotImplementedJSONResponse: api.NotImplementedJSONResponse(body)}, nil
+	default:
+		return api.GetNamespacedTimeline500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// timeline resolves, authorizes and assembles a timeline. It returns the
+// timeline on success, or the status and body of the error response.
+func (h *EventHandler) timeline(ctx context.Context, clusterName, namespace, resource, name string, since, until *time.Time, limit *int) (api.Timeline, int, api.ErrorResponse) {
+	if h.store == nil {
+		return api.Timeline{}, http.StatusNotImplemented,
+			errorBody(ctx, "event_collection_disabled", "event collection is disabled; set EVENTS_ENABLED=true")
+	}
+	cluster, err := h.clusters.Get(clusterName)
+	if err != nil {
+		return api.Timeline{}, http.StatusNotFound, errorBody(ctx, "cluster_not_found", err.Error())
+	}
+	gvr, err := cluster.ResourceFor(resource)
+	if err != nil {
+		return api.Timeline{}, http.StatusNotFound, errorBody(ctx, "resource_not_found", err.Error())
+	}
+	gvk, err := cluster.Mapper.KindFor(gvr)
+	if err != nil {
+		return api.Timeline{}, http.StatusNotFound, errorBody(ctx, "resource_not_found", err.Error())
+	}
+
+	principal := auth.From(ctx)
+	attrs := auth.Attributes{Verb: "list", Cluster: cluster.Name, Namespace: namespace, Resource: "events"}
+	if err := h.authorizer.Authorize(ctx, principal, attrs); err != nil {
+		return api.Timeline{}, http.StatusForbidden, errorBody(ctx, "forbidden", err.Error())
+	}
+
+	n := defaultTimelinePageSize
+	if limit != nil {
+		if *limit < 1 {
+			return api.Timeline{}, http.StatusBadRequest, errorBody(ctx, "invalid_limit", "limit must be positive")
+		}
+		n = min(*limit, maxTimelinePageSize)
+	}
+
+	page, err := h.store.QueryEvents(ctx, events.Query{
+		Filter: events.Filter{
+			Cluster:   cluster.Name,
+			Namespace: namespace,
+			Kind:      gvk.Kind,
+			Name:      name,
+			Since:     deref(since),
+			Until:     deref(until),
+		},
+		Limit: n,
+	})
+	if err != nil {
+		return api.Timeline{}, http.StatusInternalServerError, errorBody(ctx, "internal_error", err.Error())
+	}
+	timeline := api.Timeline{Items: make([]api.TimelineItem, 0, n), HasMore: page.HasMore}
+	for _, e := range page.Events {
+		event := toAPIClusterEvent(e)
+		timeline.Items = append(timeline.Items, api.TimelineItem{Time: e.LastSeen, Kind: api.Event, Event: &event})
+	}
+
+	// Audit entries are merged in only for callers who may read them.
+	attrs.Resource = "audit"
+	if h.authorizer.Authorize(ctx, principal, attrs) == nil {
+		entries, err := h.audit.Query(ctx, audit.Query{
+			Filter: audit.Filter{
+				Cluster:   cluster.Name,
+				Namespace: namespace,
+				// Requests name resources with and without their group.
+				Resources: slices.Compact([]string{gvr.Resource, gvr.GroupResource().String()}),
+				Name:      name,
+				Since:     deref(since),
+				Until:     deref(until),
+			},
+			Limit: n,
+		})
+		switch {
+		case errors.Is(err, audit.ErrQueryUnsupported):
+		case err != nil:
+			return api.Timeline{}, http.StatusInternalServerError, errorBody(ctx, "internal_error", err.Error())
+		default:
+			timeline.AuditIncluded = true
+			timeline.HasMore = timeline.HasMore || entries.HasMore
+			for _, entry := range entries.Entries {
+				apiEntry := toAPIAuditEntry(entry)
+				timeline.Items = append(timeline.Items, api.TimelineItem{Time: entry.Time, Kind: api.Audit, AuditEntry: &apiEntry})
+			}
+		}
+	}
+
+	slices.SortStableFunc(timeline.Items, func(a, b api.TimelineItem) int {
+		return b.Time.Compare(a.Time)
+	})
+	if len(timeline.Items) > n {
+		timeline.Items = timeline.Items[:n]
+		timeline.HasMore = true
+	}
+	return timeline, http.StatusOK, api.ErrorResponse{}
+}
+
+func toAPIClusterEvent(e events.Event) api.ClusterEvent {
+	return api.ClusterEvent{
+		Id:        e.ID,
+		Cluster:   e.Cluster,
+		Namespace: e.Namespace,
+		Name:      e.Name,
+		Type:      e.Type,
+		Reason:    e.Reason,
+		Message:   e.Message,
+		InvolvedObject: api.InvolvedObject{
+			ApiVersion: optional(e.Object.APIVersion),
+			Kind:       e.Object.Kind,
+			Namespace:  optional(e.Object.Namespace),
+			Name:       e.Object.Name,
+			Uid:        optional(e.Object.UID),
+			FieldPath:  optional(e.Object.FieldPath),
+		},
+		Source:    optional(e.Source),
+		Count:     e.Count,
+		FirstSeen: e.FirstSeen,
+		LastSeen:  e.LastSeen,
+	}
+}
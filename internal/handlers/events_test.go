@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/events"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/storage"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/restmapper"
+)
+
+var timelineStart = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// newEventHandler returns the handler of events saved in a SQLite database,
+// which also holds the audit trail, for a cluster serving deployments.
+func newEventHandler(t *testing.T, authorizer auth.Authorizer) (*EventHandler, *storage.DB) {
+	t.Helper()
+	db, err := storage.Open(config.DatabaseConfig{Driver: storage.DriverSQLite, DSN: filepath.Join(t.TempDir(), "iu.db")})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	m, err := storage.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+
+	client := fake.NewClientset()
+	client.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{{Name: "deployments", Namespaced: true, Kind: "Deployment"}},
+	}}
+	clusters := kube.NewRegistry()
+	clusters.AddCluster(&kube.Cluster{
+		Name:      "dev",
+		Clientset: client,
+		Mapper:    restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client.Discovery())),
+	})
+	return NewEventHandler(clusters, authorizer, db, storage.AuditSink(db)), db
+}
+
+// saveEvent saves an event about the deployment name seen at offset.
+func saveEvent(t *testing.T, store events.Store, uid, name string, offset time.Duration) {
+	t.Helper()
+	err := store.SaveEvent(context.Background(), events.Event{
+		Cluster:         "dev",
+		UID:             uid,
+		Namespace:       "default",
+		Name:            name + "." + uid,
+		Type:            "Normal",
+		Reason:          "ScalingReplicaSet",
+		Object:          events.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: name},
+		Count:           1,
+		FirstSeen:       timelineStart.Add(offset),
+		LastSeen:        timelineStart.Add(offset),
+		ResourceVersion: "1",
+	})
+	if err != nil {
+		t.Fatalf("SaveEvent: %v", err)
+	}
+}
+
+func TestSearchEventsPages(t *testing.T) {
+	h, db := newEventHandler(t, allowAll)
+	for i := range 5 {
+		saveEvent(t, db, fmt.Sprintf("e%d", i), "web", time.Duration(i)*time.Minute)
+	}
+	saveEvent(t, db, "other", "api", 0)
+	ctx := auth.With(context.Background(), &auth.Principal{Name: "alice"})
+
+	var names []string
+	limit, kind, name := 2, "Deployment", "web"
+	params := api.SearchEventsParams{Limit: &limit, Kind: &kind, Name: &name}
+	for pages := 1; ; pages++ {
+		resp, err := h.SearchEvents(ctx, api.SearchEventsRequestObject{Cluster: "dev", Params: params})
+		if err != nil {
+			t.Fatal(err)
+		}
+		page, ok := resp.(api.SearchEvents200JSONResponse)
+		if !ok {
+			t.Fatalf("page %d: response %#v, want 200", pages, resp)
+		}
+		for _, e := range page.Items {
+			names = append(names, e.Name)
+		}
+		if !page.Metadata.HasMore {
+			if pages != 3 {
+				t.Errorf("%d pages, want 3 of at most 2 events", pages)
+			}
+			break
+		}
+		cursor := int64(page.Metadata.Cursor)
+		if cursor != page.Items[len(page.Items)-1].Id {
+			t.Errorf("cursor %d, want the ID of the last event of the page", cursor)
+		}
+		params.Cursor = &cursor
+	}
+	want := []string{"web.e4", "web.e3", "web.e2", "web.e1", "web.e0"}
+	if !slices.Equal(names, want) {
+		t.Errorf("paged through %v, want %v", names, want)
+	}
+
+	cursor := int64(999)
+	params.Cursor = &cursor
+	resp, _ := h.SearchEvents(ctx, api.SearchEventsRequestObject{Cluster: "dev", Params: params})
+	if resp, ok := resp.(api.SearchEvents400JSONResponse); !ok || resp.Error != "invalid_cursor" {
+		t.Errorf("unknown cursor: %#v, want 400 invalid_cursor", resp)
+	}
+}
+
+func TestTimelineMergesAuditEntries(t *testing.T) {
+	// alice may read events and the audit trail, bob only events.
+	policy := &auth.Policy{Rules: []auth.Rule{{
+		Users:      []string{"alice"},
+		Verbs:      []string{"list"},
+		Resources:  []string{"events", "audit"},
+		Clusters:   []string{auth.Wildcard},
+		Namespaces: []string{auth.Wildcard},
+	}, {
+		Users:      []string{"bob"},
+		Verbs:      []string{"list"},
+		Resources:  []string{"events"},
+		Clusters:   []string{auth.Wildcard},
+		Namespaces: []string{auth.Wildcard},
+	}}}
+	h, db := newEventHandler(t, policy)
+	saveEvent(t, db, "e1", "web", time.Minute)
+	saveEvent(t, db, "e2", "web", 3*time.Minute)
+	saveEvent(t, db, "e3", "api", 2*time.Minute)
+	ctx := context.Background()
+	for _, entry := range []audit.Entry{
+		{Time: timelineStart, Action: "deployments.scale", Target: audit.Target{Resource: "deployments", Name: "web"}},
+		{Time: timelineStart.Add(2 * time.Minute), Action: "deployments.restart", Target: audit.Target{Resource: "deployments.apps", Name: "web"}},
+		{Time: timelineStart.Add(4 * time.Minute), Action: "deployments.scale", Target: audit.Target{Resource: "deployments", Name: "api"}},
+	} {
+		entry.Principal = "alice"
+		entry.Outcome = audit.OutcomeSuccess
+		entry.Target.Cluster, entry.Target.Namespace = "dev", "default"
+		if err := db.RecordAudit(ctx, entry); err != nil {
+			t.Fatalf("RecordAudit: %v", err)
+		}
+	}
+
+	timeline := func(principal string, limit int) api.Timeline {
+		t.Helper()
+		resp, err := h.GetNamespacedTimeline(auth.With(ctx, &auth.Principal{Name: principal}), api.GetNamespacedTimelineRequestObject{
+			Cluster: "dev", Namespace: "default", Resource: "deployments", Name: "web",
+			Params: api.GetNamespacedTimelineParams{Limit: &limit},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := resp.(api.GetNamespacedTimeline200JSONResponse)
+		if !ok {
+			t.Fatalf("response %#v, want 200", resp)
+		}
+		return api.Timeline(got.TimelineJSONResponse)
+	}
+	describe := func(items []api.TimelineItem) []string {
+		var out []string
+		for _, item := range items {
+			if item.Kind == api.Audit {
+				out = append(out, item.AuditEntry.Action)
+			} else {
+				out = append(out, item.Event.Name)
+			}
+		}
+		return out
+	}
+
+	got := timeline("alice", 10)
+	want := []string{"web.e2", "deployments.restart", "web.e1", "deployments.scale"}
+	if !got.AuditIncluded || got.HasMore || !slices.Equal(describe(got.Items), want) {
+		t.Errorf("timeline %v (audit %v, more %v), want %v with the audit trail", describe(got.Items), got.AuditIncluded, got.HasMore, want)
+	}
+	for i := 1; i < len(got.Items); i++ {
+		if got.Items[i].Time.After(got.Items[i-1].Time) {
+			t.Errorf("item %d at %v after %v, want the most recent first", i, got.Items[i].Time, got.Items[i-1].Time)
+		}
+	}
+
+	// The limit applies to the merged items.
+	if got := timeline("alice", 3); !got.HasMore || !slices.Equal(describe(got.Items), want[:3]) {
+		t.Errorf("timeline %v (more %v), want %v and more", describe(got.Items), got.HasMore, want[:3])
+	}
+
+	if got := timeline("bob", 10); got.AuditIncluded || !slices.Equal(describe(got.Items), []string{"web.e2", "web.e1"}) {
+		t.Errorf("timeline for bob %v (audit %v), want the events only", describe(got.Items), got.AuditIncluded)
+	}
+}
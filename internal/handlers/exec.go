@@ -0,0 +1,547 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/recovery"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// Channel numbers of the WebSocket stream protocol. They follow the
+// Kubernetes v4.channel.k8s.io protocol: every binary message starts with the
+// channel byte followed by the payload.
+const (
+	channelStdin  byte = 0
+	channelStdout byte = 1
+	channelStderr byte = 2
+	channelError  byte = 3
+	channelResize byte = 4
+)
+
+const (
+	execProtocol      = "v4.channel.k8s.io"
+	execWriteDeadline = 10 * time.Second
+)
+
+var (
+	errSessionIdle        = errors.New("session idle timeout exceeded")
+	errSessionMaxDuration = errors.New("session maximum duration exceeded")
+	errClientGone         = errors.New("client closed the connection")
+)
+
+// ExecHandler bridges WebSocket clients to the exec and attach subresources
+// of pods. It is mounted on the router directly because the WebSocket upgrade
+// needs the raw request, which the strict handlers do not expose.
+type ExecHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+	recorder   audit.Recorder
+	cfg        config.ExecConfig
+	upgrader   websocket.Upgrader
+}
+
+// NewExecHandler creates the exec and attach handler. originAllowed is nil
+// when requests are only authenticated by bearer token, which browsers do
+// not send on their own, so that any page may open a session with one.
+// Otherwise browsers send client certificates with every request, and only
+// pages of the server's own origin or the origins originAllowed accepts may
+// open sessions.
+func NewExecHandler(clusters *kube.Registry, authorizer auth.Authorizer, recorder audit.Recorder, cfg config.ExecConfig, originAllowed func(origin string) bool) *ExecHandler {
+	checkOrigin := func(r *http.Request) bool { return true }
+	if originAllowed != nil {
+		checkOrigin = func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			// Clients other than browsers send no origin.
+			if origin == "" {
+				return true
+			}
+			if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+				return true
+			}
+			return originAllowed(origin)
+		}
+	}
+	return &ExecHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+		recorder:   recorder,
+		cfg:        cfg,
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{execProtocol},
+			CheckOrigin:  checkOrigin,
+		},
+	}
+}
+
+// Routes registers the exec and attach endpoints on r.
+func (h *ExecHandler) Routes(r chi.Router) {
+	r.Get("/api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/exec", h.Exec)
+	r.Get("/api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/attach", h.Attach)
+}
+
+// Exec runs a command in a container and streams its I/O over a WebSocket
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/exec)
+func (h *ExecHandler) Exec(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, "exec")
+}
+
+// Attach attaches to the main process of a container over a WebSocket
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/attach)
+func (h *ExecHandler) Attach(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, "attach")
+}
+
+type execRequest struct {
+	subresource string
+	target      audit.Target
+	container   string
+	command     []string
+	stdin       bool
+	tty         bool
+}
+
+func parseExecRequest(r *http.Request, subresource string) (*execRequest, error) {
+	query := r.URL.Query()
+	req := &execRequest{
+		subresource: subresource,
+		target: audit.Target{
+			Cluster:   chi.URLParam(r, "cluster"),
+			Namespace: chi.URLParam(r, "namespace"),
+			Resource:  "pods/" + subresource,
+			Name:      chi.URLParam(r, "pod"),
+		},
+		container: query.Get("container"),
+		command:   query["command"],
+		stdin:     true,
+	}
+
+	var err error
+	if v := query.Get("stdin"); v != "" {
+		if req.stdin, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid stdin parameter: %w", err)
+		}
+	}
+	if v := query.Get("tty"); v != "" {
+		if req.tty, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid tty parameter: %w", err)
+		}
+	}
+	if subresource == "exec" && len(req.command) == 0 {
+		return nil, errors.New("at least one command parameter is required")
+	}
+	return req, nil
+}
+
+func (h *ExecHandler) serve(w http.ResponseWriter, r *http.Request, subresource string) {
+	ctx := r.Context()
+	logger := log.From(ctx)
+	principal := auth.From(ctx)
+
+	req, err := parseExecRequest(r, subresource)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	cluster, err := h.clusters.Get(req.target.Cluster)
+	if err != nil {
+		writeError(w, r, http.StatusNotFound, "cluster_not_found", err.Error())
+		return
+	}
+
+	entry := newAuditEntry(ctx, "pods."+subresource, req.target)
+
+	if err := h.authorizer.Authorize(ctx, principal, auth.Attributes{
+		Verb:      subresource,
+		Cluster:   req.target.Cluster,
+		Namespace: req.target.Namespace,
+		Resource:  "pods",
+	}); err != nil {
+		finishAuditEntry(&entry, entry.Time, err)
+		recordAudit(ctx, h.recorder, entry)
+		writeError(w, r, http.StatusForbidden, "forbidden", err.Error())
+		return
+	}
+
+	executor, err := h.executor(cluster, req)
+	if err != nil {
+		// No session entry is recorded, so the request itself is audited
+		// as the failed attempt.
+		audit.Annotate(ctx, func(ar *audit.Request) { ar.Always = true })
+		describeAudit(ctx, entry.Action, entry.Target, map[string]any{
+			"container": req.container,
+			"command":   req.command,
+			"tty":       req.tty,
+		}, err)
+		writeError(w, r, http.StatusInternalServerError, "executor_error", err.Error())
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied to the client.
+		logger.Warn("websocket upgrade failed", "error", err)
+		return
+	}
+	defer conn.Close()
+
+	session := newExecSession(ctx, conn, req, h.cfg)
+	defer session.stop()
+
+	sessionID := uuid.NewString()
+	logger = logger.With("session_id", sessionID, "cluster", req.target.Cluster,
+		"namespace", req.target.Namespace, "pod", req.target.Name)
+	logger.Info("session started", "subresource", subresource, "command", req.command, "tty", req.tty)
+
+	entry.Time = time.Now()
+	entry.Details = map[string]any{
+		"session":   sessionID,
+		"phase":     "started",
+		"container": req.container,
+		"command":   req.command,
+		"tty":       req.tty,
+	}
+	recordAudit(ctx, h.recorder, entry)
+
+	started := time.Now()
+	streamErr := session.run(executor)
+	cause := context.Cause(session.ctx)
+
+	entry.Time = time.Now()
+	finishAuditEntry(&entry, started, streamErr)
+	commands, output, truncated := session.transcript.snapshot()
+	entry.Details = map[string]any{
+		"session":   sessionID,
+		"phase":     "ended",
+		"container": req.container,
+		"command":   req.command,
+		"tty":       req.tty,
+		"commands":  commands,
+		"output":    output,
+		"truncated": truncated,
+	}
+	if cause != nil {
+		entry.Details["termination"] = cause.Error()
+	}
+	recordAudit(ctx, h.recorder, entry)
+
+	session.finish(streamErr, cause)
+	logger.Info("session ended", "duration", entry.Duration, "error", streamErr, "termination", cause)
+}
+
+func (h *ExecHandler) executor(cluster *kube.Cluster, req *execRequest) (remotecommand.Executor, error) {
+	request := cluster.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(req.target.Namespace).
+		Name(req.target.Name).
+		SubResource(req.subresource)
+
+	if req.subresource == "exec" {
+		request = request.VersionedParams(&corev1.PodExecOptions{
+			Container: req.container,
+			Command:   req.command,
+			Stdin:     req.stdin,
+			Stdout:    true,
+			Stderr:    !req.tty,
+			TTY:       req.tty,
+		}, scheme.ParameterCodec)
+	} else {
+		request = request.VersionedParams(&corev1.PodAttachOptions{
+			Container: req.container,
+			Stdin:     req.stdin,
+			Stdout:    true,
+			Stderr:    !req.tty,
+			TTY:       req.tty,
+		}, scheme.ParameterCodec)
+	}
+
+	spdyExecutor, err := remotecommand.NewSPDYExecutor(cluster.Config, http.MethodPost, request.URL())
+	if err != nil {
+		return nil, fmt.Errorf("create SPDY executor: %w", err)
+	}
+	wsExecutor, err := remotecommand.NewWebSocketExecutor(cluster.Config, http.MethodGet, request.URL().String())
+	if err != nil {
+		return nil, fmt.Errorf("create WebSocket executor: %w", err)
+	}
+	// Prefer WebSockets and fall back to SPDY for older API servers, as kubectl does.
+	return remotecommand.NewFallbackExecutor(wsExecutor, spdyExecutor, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+}
+
+// execSession pumps one WebSocket connection into a remote command stream.
+type execSession struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	conn   *websocket.Conn
+	req    *execRequest
+	cfg    config.ExecConfig
+
+	writeMu    sync.Mutex
+	idle       *time.Timer
+	stdin      *io.PipeReader
+	stdinW     *io.PipeWriter
+	sizes      chan remotecommand.TerminalSize
+	transcript *transcript
+	stopMax    context.CancelFunc
+}
+
+func newExecSession(parent context.Context, conn *websocket.Conn, req *execRequest, cfg config.ExecConfig) *execSession {
+	ctx, stopMax := context.WithTimeoutCause(parent, cfg.MaxDuration, errSessionMaxDuration)
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	s := &execSession{
+		ctx:        ctx,
+		cancel:     cancel,
+		conn:       conn,
+		req:        req,
+		cfg:        cfg,
+		sizes:      make(chan remotecommand.TerminalSize, 1),
+		transcript: newTranscript(cfg.MaxRecordedSize),
+		stopMax:    stopMax,
+	}
+	s.stdin, s.stdinW = io.Pipe()
+	s.idle = time.AfterFunc(cfg.IdleTimeout, func() { cancel(errSessionIdle) })
+	return s
+}
+
+func (s *execSession) run(executor remotecommand.Executor) error {
+	go func() {
+		// A panic ends the session instead of leaving it without input.
+		if err := recovery.Catch(s.ctx, "exec", func() error { s.readLoop(); return nil }); err != nil {
+			s.cancel(err)
+		}
+	}()
+
+	opts := remotecommand.StreamOptions{
+		Stdout: &channelWriter{session: s, channel: channelStdout},
+		Tty:    s.req.tty,
+	}
+	if s.req.stdin {
+		opts.Stdin = s.stdin
+	}
+	if !s.req.tty {
+		opts.Stderr = &channelWriter{session: s, channel: channelStderr}
+	} else {
+		opts.TerminalSizeQueue = s
+	}
+	return executor.StreamWithContext(s.ctx, opts)
+}
+
+// readLoop forwards stdin and resize messages from the client until the
+// connection closes or the session ends.
+func (s *execSession) readLoop() {
+	defer s.stdinW.Close()
+	for {
+		_, message, err := s.conn.ReadMessage()
+		if err != nil {
+			s.cancel(errClientGone)
+			return
+		}
+		if len(message) == 0 {
+			continue
+		}
+		s.touch()
+
+		switch message[0] {
+		case channelStdin:
+			if !s.req.stdin {
+				continue
+			}
+			s.transcript.input(message[1:])
+			if _, err := s.stdinW.Write(message[1:]); err != nil {
+				return
+			}
+		case channelResize:
+			var size remotecommand.TerminalSize
+			if err := json.Unmarshal(message[1:], &size); err != nil {
+				continue
+			}
+			// Only the latest size matters; drop a pending one.
+			select {
+			case <-s.sizes:
+			default:
+			}
+			s.sizes <- size
+		}
+	}
+}
+
+// Next implements remotecommand.TerminalSizeQueue.
+func (s *execSession) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-s.sizes:
+		return &size
+	case <-s.ctx.Done():
+		return nil
+	}
+}
+
+func (s *execSession) touch() {
+	s.idle.Reset(s.cfg.IdleTimeout)
+}
+
+func (s *execSession) write(channel byte, payload []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	message := make([]byte, len(payload)+1)
+	message[0] = channel
+	copy(message[1:], payload)
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(execWriteDeadline))
+	return s.conn.WriteMessage(websocket.BinaryMessage, message)
+}
+
+// finish reports the final status on the error channel and closes the
+// connection, in the shape of a Kubernetes metav1.Status.
+func (s *execSession) finish(streamErr, cause error) {
+	status := metav1.Status{Status: metav1.StatusSuccess}
+	if streamErr != nil || (cause != nil && !errors.Is(cause, errClientGone)) {
+		status.Status = metav1.StatusFailure
+		status.Message = errors.Join(streamErr, cause).Error()
+
+		var exitErr utilexec.ExitError
+		if errors.As(streamErr, &exitErr) && exitErr.Exited() {
+			status.Reason = "NonZeroExitCode"
+			status.Details = &metav1.StatusDetails{
+				Causes: []metav1.StatusCause{{
+					Type:    "ExitCode",
+					Message: strconv.Itoa(exitErr.ExitStatus()),
+				}},
+			}
+		} else if errors.Is(cause, errSessionIdle) || errors.Is(cause, errSessionMaxDuration) {
+			status.Reason = metav1.StatusReasonTimeout
+		}
+	}
+
+	if payload, err := json.Marshal(status); err == nil {
+		_ = s.write(channelError, payload)
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(execWriteDeadline))
+}
+
+func (s *execSession) stop() {
+	s.idle.Stop()
+	s.cancel(nil)
+	s.stopMax()
+	s.stdin.Close()
+}
+
+// channelWriter writes remote output to one channel of the client connection.
+type channelWriter struct {
+	session *execSession
+	channel byte
+}
+
+func (w *channelWriter) Write(p []byte) (int, error) {
+	w.session.touch()
+	w.session.transcript.output(p)
+	if err := w.session.write(w.channel, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// transcript records the commands typed into and the output of a session,
+// each capped at a maximum size.
+type transcript struct {
+	mu        sync.Mutex
+	limit     int
+	commands  []string
+	line      []byte
+	inputSize int
+	out       []byte
+	truncated bool
+}
+
+func newTranscript(limit int) *transcript {
+	return &transcript{limit: limit}
+}
+
+func (t *transcript) input(p []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, b := range p {
+		switch b {
+		case '\r', '\n':
+			if len(t.line) > 0 {
+				t.appendCommand(string(t.line))
+				t.line = t.line[:0]
+			}
+		case '\b', 0x7f:
+			if len(t.line) > 0 {
+				t.line = t.line[:len(t.line)-1]
+			}
+		default:
+			// A line that would not fit in the transcript anyway is not
+			// buffered whole: what exceeds the limit is dropped.
+			if len(t.line) >= t.limit {
+				t.truncated = true
+				continue
+			}
+			t.line = append(t.line, b)
+		}
+	}
+}
+
+func (t *transcript) appendCommand(command string) {
+	if t.inputSize+len(command) > t.limit {
+		t.truncated = true
+		return
+	}
+	t.inputSize += len(command)
+	t.commands = append(t.commands, command)
+}
+
+func (t *transcript) output(p []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	room := t.limit - len(t.out)
+	if room < len(p) {
+		t.truncated = true
+		p = p[:max(room, 0)]
+	}
+	t.out = append(t.out, p...)
+}
+
+func (t *transcript) snapshot() ([]string, string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	commands := append([]string(nil), t.commands...)
+	if len(t.line) > 0 {
+		commands = append(commands, string(t.line))
+	}
+	return commands, string(t.out), t.truncated
+}
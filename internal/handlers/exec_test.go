@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestTranscriptCapsInput(t *testing.T) {
+	tr := newTranscript(8)
+	tr.input([]byte("ls\r"))
+	tr.input(bytes.Repeat([]byte("a"), 64))
+	if len(tr.line) > tr.limit {
+		t.Errorf("buffered a line of %d bytes, want at most %d", len(tr.line), tr.limit)
+	}
+	commands, _, truncated := tr.snapshot()
+	if !truncated {
+		t.Error("transcript not marked truncated")
+	}
+	if want := []string{"ls", "aaaaaaaa"}; !slices.Equal(commands, want) {
+		t.Errorf("commands = %q, want %q", commands, want)
+	}
+
+}
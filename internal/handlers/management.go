@@ -5,24 +5,71 @@ import (
 	"time"
 
 	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/config"
 	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/storage"
+	"iu-k8s.linecorp.com/server/internal/version"
 )
 
-type ManagementHandler struct{}
+// readinessPingTimeout bounds the database check of a readiness probe.
+const readinessPingTimeout = 2 * time.Second
+
+type ManagementHandler struct {
+	cfg  *config.Config
+	repo storage.Repository
+}
+
+// NewManagementHandler creates the management handler. repo may be nil when
+// the server runs without a database.
+func NewManagementHandler(cfg *config.Config, repo storage.Repository) *ManagementHandler {
+	return &ManagementHandler{cfg: cfg, repo: repo}
+}
 
 // GetReadiness checks if the service is ready
 // (GET /readyz)
 func (h *ManagementHandler) GetReadiness(ctx context.Context, request api.GetReadinessRequestObject) (api.GetReadinessResponseObject, error) {
+	if h.repo != nil {
+		pingCtx, cancel := context.WithTimeout(ctx, readinessPingTimeout)
+		defer cancel()
+		if err := h.repo.Ping(pingCtx); err != nil {
+			log.From(ctx).Warn("readiness check failed", "error", err)
+			message := "database unreachable"
+			return api.GetReadiness503JSONResponse{
+				Status:    api.NotReady,
+				Message:   &message,
+				Timestamp: time.Now(),
+				Version:   version.Version,
+			}, nil
+		}
+	}
+
 	return api.GetReadiness200JSONResponse{
 		Status:    api.Ready,
 		Timestamp: time.Now(),
-		Version:   "v0.0.1", // TODO: build time injection
+		Version:   version.Version,
 	}, nil
 }
 
 // SetLogLevel sets the log level dynamically
 // (GET /debug/log)
 func (h *ManagementHandler) SetLogLevel(ctx context.Context, request api.SetLogLevelRequestObject) (api.SetLogLevelResponseObject, error) {
+	if request.Params.Level != nil || request.Params.Format != nil {
+		// Changing the log settings is audited even though it is a GET.
+		details := map[string]any{}
+		if request.Params.Level != nil {
+			details["level"] = string(*request.Params.Level)
+		}
+		if request.Params.Format != nil {
+			details["format"] = string(*request.Params.Format)
+		}
+		audit.Annotate(ctx, func(req *audit.Request) {
+			req.Always = true
+			req.Entry.Action = "log.set"
+			req.Entry.Details = details
+		})
+	}
+
 	if request.Params.Level != nil {
 		if err := log.SetLevel(string(*request.Params.Level)); err != nil {
 			return api.SetLogLevel400JSONResponse{
@@ -48,3 +95,14 @@ func (h *ManagementHandler) SetLogLevel(ctx context.Context, request api.SetLogL
 		Format: &format,
 	}, nil
 }
+
+// GetConfig returns the effective configuration with secrets redacted
+// (GET /debug/config)
+func (h *ManagementHandler) GetConfig(ctx context.Context, request api.GetConfigRequestObject) (api.GetConfigResponseObject, error) {
+	settings := h.cfg.Settings()
+	resp := api.GetConfig200JSONResponse{Settings: make([]api.ConfigSetting, len(settings))}
+	for i, s := range settings {
+		resp.Settings[i] = api.ConfigSetting{Name: s.Name, Value: s.Value}
+	}
+	return resp, nil
+}
@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/kube"
+)
+
+type ManifestHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+	cfg        config.ApplyConfig
+}
+
+func NewManifestHandler(clusters *kube.Registry, authorizer auth.Authorizer, cfg config.ApplyConfig) *ManifestHandler {
+	return &ManifestHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+		cfg:        cfg,
+	}
+}
+
+// ApplyManifests applies a multi-document manifest with server-side apply
+// (POST /api/v1/clusters/{cluster}/apply)
+func (h *ManifestHandler) ApplyManifests(ctx context.Context, request api.ApplyManifestsRequestObject) (api.ApplyManifestsResponseObject, error) {
+	cluster, err := h.clusters.Get(request.Cluster)
+	if err != nil {
+		return api.ApplyManifests404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(
+			errorBody(ctx, "cluster_not_found", err.Error()),
+		)}, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(request.Body, int64(h.cfg.MaxBodySize)+1))
+	if err != nil {
+		return api.ApplyManifests400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_body", err.Error()),
+		)}, nil
+	}
+	if len(body) > h.cfg.MaxBodySize {
+		return api.ApplyManifests413JSONResponse{PayloadTooLargeJSONResponse: api.PayloadTooLargeJSONResponse(
+			errorBody(ctx, "body_too_large", fmt.Sprintf("manifest exceeds %d bytes", h.cfg.MaxBodySize)),
+		)}, nil
+	}
+
+	manifests, err := kube.DecodeManifests(bytes.NewReader(body))
+	if err != nil {
+		return api.ApplyManifests400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_manifest", err.Error()),
+		)}, nil
+	}
+	if len(manifests) == 0 {
+		return api.ApplyManifests400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_manifest", "manifest contains no documents"),
+		)}, nil
+	}
+
+	opts := kube.ApplyOptions{
+		FieldManager: h.cfg.FieldManager,
+		DryRun:       request.Params.DryRun != nil && *request.Params.DryRun == api.Server,
+	}
+	if request.Params.FieldManager != nil && *request.Params.FieldManager != "" {
+		opts.FieldManager = *request.Params.FieldManager
+	}
+	if request.Params.Force != nil {
+		opts.Force = *request.Params.Force
+	}
+	var namespace string
+	if request.Params.Namespace != nil {
+		namespace = *request.Params.Namespace
+	}
+
+	out := api.ApplyResult{
+		DryRun:  opts.DryRun,
+		Results: make([]api.ApplyDocumentResult, 0, len(manifests)),
+	}
+	for _, manifest := range manifests {
+		result := h.apply(ctx, cluster, manifest, namespace, opts)
+		if result.Status == api.ApplyDocumentResultStatusFailed {
+			out.Failed++
+		} else {
+			out.Succeeded++
+		}
+		out.Results = append(out.Results, result)
+	}
+
+	if out.Failed > 0 {
+		err = fmt.Errorf("%d of %d documents failed", out.Failed, len(out.Results))
+	}
+	describeAudit(ctx, "manifests.apply", audit.Target{Cluster: cluster.Name}, map[string]any{
+		"fieldManager": opts.FieldManager,
+		"force":        opts.Force,
+		"dryRun":       opts.DryRun,
+		"documents":    auditDocuments(out.Results),
+	}, err)
+	if err != nil {
+		// The batch is answered with 200 even when documents failed.
+		audit.Annotate(ctx, func(req *audit.Request) {
+			req.Entry.Outcome = audit.OutcomeFailure
+		})
+	}
+	return api.ApplyManifests200JSONResponse(out), nil
+}
+
+// apply applies a single document, reporting failures in the result rather
+// than as an error so the rest of the batch proceeds.
+func (h *ManifestHandler) apply(ctx context.Context, cluster *kube.Cluster, manifest kube.Manifest, namespace string, opts kube.ApplyOptions) api.ApplyDocumentResult {
+	result := api.ApplyDocumentResult{Index: manifest.Index}
+	if manifest.Err != nil {
+		result.Status = api.ApplyDocumentResultStatusFailed
+		result.Error = ptr(manifest.Err.Error())
+		return result
+	}
+
+	obj := manifest.Object
+	result.ApiVersion = ptr(obj.GetAPIVersion())
+	result.Kind = ptr(obj.GetKind())
+	result.Name = ptr(obj.GetName())
+
+	mapping, err := cluster.MappingFor(obj, namespace)
+	if err == nil {
+		err = h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+			Verb:      "apply",
+			Cluster:   cluster.Name,
+			Namespace: obj.GetNamespace(),
+			Resource:  mapping.Resource.GroupResource().String(),
+		})
+	}
+	var applied *kube.ApplyResult
+	if err == nil {
+		applied, err = cluster.Apply(ctx, mapping, obj, opts)
+	}
+
+	if obj.GetNamespace() != "" {
+		result.Namespace = ptr(obj.GetNamespace())
+	}
+	if err != nil {
+		result.Status = api.ApplyDocumentResultStatusFailed
+		result.Error = ptr(err.Error())
+		return result
+	}
+
+	switch {
+	case applied.Created:
+		result.Status = api.ApplyDocumentResultStatusCreated
+	case len(applied.Diff) == 0:
+		result.Status = api.ApplyDocumentResultStatusUnchanged
+	default:
+		result.Status = api.ApplyDocumentResultStatusConfigured
+	}
+	diff := make([]api.DiffEntry, 0, len(applied.Diff))
+	for _, change := range applied.Diff {
+		diff = append(diff, api.DiffEntry{
+			Op:     api.DiffEntryOp(change.Op),
+			Path:   change.Path,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+	result.Diff = &diff
+	return result
+}
+
+// authorizeManifests checks the caller may apply every document of body, a
+// manifest to apply to clusterName. Every document must be valid.
+func (h *ManifestHandler) authorizeManifests(ctx context.Context, clusterName string, body []byte, namespace string) error {
+	cluster, err := h.clusters.Get(clusterName)
+	if err != nil {
+		return err
+	}
+	manifests, err := kube.DecodeManifests(bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %v", kube.ErrInvalidManifest, err)
+	}
+	if len(manifests) == 0 {
+		return fmt.Errorf("%w: manifest contains no documents", kube.ErrInvalidManifest)
+	}
+	for _, manifest := range manifests {
+		if manifest.Err != nil {
+			return fmt.Errorf("document %d: %w", manifest.Index, manifest.Err)
+		}
+		mapping, err := cluster.MappingFor(manifest.Object, namespace)
+		if err != nil {
+			return fmt.Errorf("document %d: %w", manifest.Index, err)
+		}
+		err = h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+			Verb:      "apply",
+			Cluster:   cluster.Name,
+			Namespace: manifest.Object.GetNamespace(),
+			Resource:  mapping.Resource.GroupResource().String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// auditDocuments summarises the outcome of each document for the audit trail.
+func auditDocuments(results []api.ApplyDocumentResult) []map[string]any {
+	documents := make([]map[string]any, 0, len(results))
+	for _, result := range results {
+		document := map[string]any{"index": result.Index, "status": result.Status}
+		if result.Kind != nil {
+			document["kind"] = *result.Kind
+		}
+		if result.Namespace != nil {
+			document["namespace"] = *result.Namespace
+		}
+		if result.Name != nil {
+			document["name"] = *result.Name
+		}
+		if result.Error != nil {
+			document["error"] = *result.Error
+		}
+		documents = append(documents, document)
+	}
+	return documents
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/kube"
+)
+
+type NamespaceHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+	templates  kube.NamespaceTemplates
+}
+
+func NewNamespaceHandler(clusters *kube.Registry, authorizer auth.Authorizer, templates kube.NamespaceTemplates) *NamespaceHandler {
+	return &NamespaceHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+		templates:  templates,
+	}
+}
+
+// ListNamespaceTemplates lists the templates namespaces may be provisioned from
+// (GET /api/v1/namespace-templates)
+func (h *NamespaceHandler) ListNamespaceTemplates(ctx context.Context, request api.ListNamespaceTemplatesRequestObject) (api.ListNamespaceTemplatesResponseObject, error) {
+	items := make([]api.NamespaceTemplate, 0, len(h.templates))
+	for i := range h.templates {
+		t := &h.templates[i]
+		item := api.NamespaceTemplate{
+			Name:           t.Name,
+			Description:    optional(t.Description),
+			RequiresGroups: ptr(len(t.RoleBindings) > 0),
+			Resources:      provisionedResources(t.Resources()),
+		}
+		if len(t.Labels) > 0 {
+			item.Labels = &t.Labels
+		}
+		if len(t.Annotations) > 0 {
+			item.Annotations = &t.Annotations
+		}
+		items = append(items, item)
+	}
+	return api.ListNamespaceTemplates200JSONResponse{Items: items}, nil
+}
+
+// ProvisionNamespace creates a namespace from a template
+// (POST /api/v1/clusters/{cluster}/namespaces)
+func (h *NamespaceHandler) ProvisionNamespace(ctx context.Context, request api.ProvisionNamespaceRequestObject) (api.ProvisionNamespaceResponseObject, error) {
+	body := request.Body
+	if body == nil {
+		return api.ProvisionNamespace400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_request", "request body is required"),
+		)}, nil
+	}
+
+	req := kube.ProvisionRequest{
+		Name:          body.Name,
+		Team:          body.Team,
+		Groups:        deref(body.Groups),
+		Labels:        deref(body.Labels),
+		Annotations:   deref(body.Annotations),
+		ProvisionedBy: auth.From(ctx).Name,
+	}
+	target := namespaceTarget(request.Cluster, body.Name)
+	details := map[string]any{
+		"template": body.Template,
+		"team":     body.Team,
+		"groups":   req.Groups,
+	}
+
+	var p *kube.Provisioning
+	var cluster *kube.Cluster
+	template, err := h.templates.Get(body.Template)
+	if err == nil {
+		cluster, err = h.authorize(ctx, target, "create")
+	}
+	if err == nil {
+		p, err = cluster.ProvisionNamespace(ctx, template, req)
+	}
+	if p != nil {
+		details["resources"] = p.Resources
+	}
+	describeAudit(ctx, "namespaces.provision", target, details, err)
+
+	if err == nil {
+		return api.ProvisionNamespace201JSONResponse(provisionedNamespace(request.Cluster, p)), nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.ProvisionNamespace400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.ProvisionNamespace403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.ProvisionNamespace404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.ProvisionNamespace409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	default:
+		return api.ProvisionNamespace500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// GetNamespaceProvisioning returns the provisioning record of a namespace
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/provisioning)
+func (h *NamespaceHandler) GetNamespaceProvisioning(ctx context.Context, request api.GetNamespaceProvisioningRequestObject) (api.GetNamespaceProvisioningResponseObject, error) {
+	var p *kube.Provisioning
+	cluster, err := h.authorize(ctx, namespaceTarget(request.Cluster, request.Namespace), "get")
+	if err == nil {
+		p, err = cluster.GetNamespaceProvisioning(ctx, request.Namespace)
+	}
+	if err == nil {
+		return api.GetNamespaceProvisioning200JSONResponse(provisionedNamespace(request.Cluster, p)), nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.GetNamespaceProvisioning400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.GetNamespaceProvisioning403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetNamespaceProvisioning404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.GetNamespaceProvisioning409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	default:
+		return api.GetNamespaceProvisioning500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// DeprovisionNamespace deletes a provisioned namespace and what was created in it
+// (DELETE /api/v1/clusters/{cluster}/namespaces/{namespace})
+func (h *NamespaceHandler) DeprovisionNamespace(ctx context.Context, request api.DeprovisionNamespaceRequestObject) (api.DeprovisionNamespaceResponseObject, error) {
+	target := namespaceTarget(request.Cluster, request.Namespace)
+	var p *kube.Provisioning
+	cluster, err := h.authorize(ctx, target, "delete")
+	if err == nil {
+		p, err = cluster.DeprovisionNamespace(ctx, request.Namespace)
+	}
+	var details map[string]any
+	if p != nil {
+		details = map[string]any{"template": p.Template, "team": p.Team, "resources": p.Resources}
+	}
+	describeAudit(ctx, "namespaces.deprovision", target, details, err)
+
+	if err == nil {
+		return api.DeprovisionNamespace200JSONResponse(provisionedNamespace(request.Cluster, p)), nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.DeprovisionNamespace400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.DeprovisionNamespace403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.DeprovisionNamespace404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.DeprovisionNamespace409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	default:
+		return api.DeprovisionNamespace500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// authorize resolves the cluster of target and checks the caller may perform verb on the namespace.
+func (h *NamespaceHandler) authorize(ctx context.Context, target audit.Target, verb string) (*kube.Cluster, error) {
+	cluster, err := h.clusters.Get(target.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	err = h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+		Verb:      verb,
+		Cluster:   target.Cluster,
+		Namespace: target.Name,
+		Resource:  target.Resource,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
+
+func namespaceTarget(cluster, namespace string) audit.Target {
+	return audit.Target{Cluster: cluster, Namespace: namespace, Resource: "namespaces", Name: namespace}
+}
+
+func provisionedNamespace(cluster string, p *kube.Provisioning) api.ProvisionedNamespace {
+	out := api.ProvisionedNamespace{
+		Cluster:       cluster,
+		Name:          p.Namespace,
+		Template:      p.Template,
+		Team:          p.Team,
+		Groups:        p.Groups,
+		ProvisionedBy: p.ProvisionedBy,
+		Resources:     provisionedResources(p.Resources),
+	}
+	if out.Groups == nil {
+		out.Groups = []string{}
+	}
+	if !p.ProvisionedAt.IsZero() {
+		out.ProvisionedAt = &p.ProvisionedAt
+	}
+	return out
+}
+
+func provisionedResources(resources []kube.ProvisionedResource) []api.ProvisionedResource {
+	out := make([]api.ProvisionedResource, 0, len(resources))
+	for _, res := range resources {
+		out = append(out, api.ProvisionedResource{Kind: res.Kind, Name: res.Name})
+	}
+	return out
+}
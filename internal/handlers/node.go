@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/operation"
+)
+
+type NodeHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+	operations *operation.Manager
+	cfg        config.DrainConfig
+}
+
+func NewNodeHandler(clusters *kube.Registry, authorizer auth.Authorizer, operations *operation.Manager, cfg config.DrainConfig) *NodeHandler {
+	return &NodeHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+		operations: operations,
+		cfg:        cfg,
+	}
+}
+
+// CordonNode marks a node unschedulable
+// (POST /api/v1/clusters/{cluster}/nodes/{node}/cordon)
+func (h *NodeHandler) CordonNode(ctx context.Context, request api.CordonNodeRequestObject) (api.CordonNodeResponseObject, error) {
+	err := h.setUnschedulable(ctx, request.Cluster, request.Node, "cordon", true)
+	if err == nil {
+		return api.CordonNode200JSONResponse{Cluster: request.Cluster, Name: request.Node, Unschedulable: true}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.CordonNode400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.CordonNode403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.CordonNode404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.CordonNode409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	default:
+		return api.CordonNode500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// UncordonNode marks a node schedulable again
+// (POST /api/v1/clusters/{cluster}/nodes/{node}/uncordon)
+func (h *NodeHandler) UncordonNode(ctx context.Context, request api.UncordonNodeRequestObject) (api.UncordonNodeResponseObject, error) {
+	err := h.setUnschedulable(ctx, request.Cluster, request.Node, "uncordon", false)
+	if err == nil {
+		return api.UncordonNode200JSONResponse{Cluster: request.Cluster, Name: request.Node, Unschedulable: false}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.UncordonNode400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.UncordonNode403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.UncordonNode404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.UncordonNode409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	default:
+		return api.UncordonNode500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// DrainNode cordons a node and evicts its pods in the background
+// (POST /api/v1/clusters/{cluster}/nodes/{node}/drain)
+func (h *NodeHandler) DrainNode(ctx context.Context, request api.DrainNodeRequestObject) (api.DrainNodeResponseObject, error) {
+	opts := kube.DrainOptions{
+		Timeout:       h.cfg.Timeout,
+		RetryInterval: h.cfg.RetryInterval,
+	}
+	if h.cfg.GracePeriodSeconds >= 0 {
+		opts.GracePeriodSeconds = ptr(int64(h.cfg.GracePeriodSeconds))
+	}
+	if body := request.Body; body != nil {
+		if body.GracePeriodSeconds != nil {
+			if *body.GracePeriodSeconds < 0 {
+				return api.DrainNode400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+					errorBody(ctx, "invalid_grace_period", "gracePeriodSeconds must not be negative"),
+				)}, nil
+			}
+			opts.GracePeriodSeconds = body.GracePeriodSeconds
+		}
+		if body.TimeoutSeconds != nil {
+			if *body.TimeoutSeconds < 1 {
+				return api.DrainNode400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+					errorBody(ctx, "invalid_timeout", "timeoutSeconds must be positive"),
+				)}, nil
+			}
+			opts.Timeout = time.Duration(*body.TimeoutSeconds) * time.Second
+		}
+		if body.Force != nil {
+			opts.Force = *body.Force
+		}
+		if body.DeleteEmptyDirData != nil {
+			opts.DeleteEmptyDirData = *body.DeleteEmptyDirData
+		}
+	}
+
+	target := nodeTarget(request.Cluster, request.Node)
+	details := map[string]any{
+		"force":              opts.Force,
+		"deleteEmptyDirData": opts.DeleteEmptyDirData,
+		"timeout":            opts.Timeout.String(),
+	}
+	if opts.GracePeriodSeconds != nil {
+		details["gracePeriodSeconds"] = *opts.GracePeriodSeconds
+	}
+
+	// Reject drains that cannot complete before cordoning the node.
+	cluster, err := h.authorize(ctx, target, "drain")
+	if err == nil {
+		_, err = cluster.PlanDrain(ctx, request.Node, opts)
+	}
+	if err == nil {
+		err = cluster.SetUnschedulable(ctx, request.Node, true)
+	}
+	describeAudit(ctx, "nodes.drain", target, details, err)
+
+	if err == nil {
+		op := h.operations.Start(operation.Operation{
+			Type:      "nodes.drain",
+			Target:    target,
+			Principal: *auth.From(ctx),
+			Progress:  operation.Progress{Message: "cordoned, evicting pods"},
+			// The drain reports its own timeout with the pods blocking it.
+			Timeout: opts.Timeout,
+		}, func(ctx context.Context, report func(operation.Progress)) error {
+			return cluster.Drain(ctx, request.Node, opts, func(s *kube.DrainStatus) {
+				report(drainProgress(s))
+			})
+		})
+		return api.DrainNode202JSONResponse{OperationAcceptedJSONResponse: operationAccepted(op)}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.DrainNode400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.DrainNode403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.DrainNode404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.DrainNode409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	default:
+		return api.DrainNode500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+func (h *NodeHandler) setUnschedulable(ctx context.Context, clusterName, node, verb string, unschedulable bool) error {
+	target := nodeTarget(clusterName, node)
+	cluster, err := h.authorize(ctx, target, verb)
+	if err == nil {
+		err = cluster.SetUnschedulable(ctx, node, unschedulable)
+	}
+	describeAudit(ctx, "nodes."+verb, target, nil, err)
+	return err
+}
+
+// authorize resolves the cluster of target and checks the caller may perform verb on its node.
+func (h *NodeHandler) authorize(ctx context.Context, target audit.Target, verb string) (*kube.Cluster, error) {
+	cluster, err := h.clusters.Get(target.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	err = h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+		Verb:     verb,
+		Cluster:  target.Cluster,
+		Resource: target.Resource,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
+
+func nodeTarget(cluster, node string) audit.Target {
+	return audit.Target{Cluster: cluster, Resource: "nodes", Name: node}
+}
+
+func drainProgress(status *kube.DrainStatus) operation.Progress {
+	detail := &api.DrainProgress{
+		Node:     status.Node,
+		Total:    status.Total,
+		Evicted:  status.Evicted,
+		Blocking: make([]api.BlockingPod, 0, len(status.Blocking)),
+	}
+	for _, pod := range status.Blocking {
+		detail.Blocking = append(detail.Blocking, api.BlockingPod{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			Reason:    pod.Reason,
+		})
+	}
+
+	progress := operation.Progress{Percent: 100, Detail: detail, Message: "all pods evicted"}
+	if status.Total > 0 {
+		progress.Percent = status.Evicted * 100 / status.Total
+	}
+	switch remaining := status.Total - status.Evicted; {
+	case remaining > 0 && len(status.Blocking) > 0:
+		progress.Message = "evictions blocked, retrying"
+	case remaining > 0:
+		progress.Message = "waiting for evicted pods to terminate"
+	}
+	return progress
+}
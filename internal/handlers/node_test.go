@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/operation"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// allowAll lets every principal do anything.
+var allowAll = &auth.Policy{Rules: []auth.Rule{{
+	Users:      []string{auth.Wildcard},
+	Verbs:      []string{auth.Wildcard},
+	Resources:  []string{auth.Wildcard},
+	Clusters:   []string{auth.Wildcard},
+	Namespaces: []string{auth.Wildcard},
+}}}
+
+func TestDrainNodeRunsForItsOwnTimeout(t *testing.T) {
+	controller := true
+	client := fake.NewClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "default",
+				Name:            "db-0",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "db", Controller: &controller}},
+			},
+			Spec: corev1.PodSpec{NodeName: "node-1"},
+		},
+	)
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+	})
+	clusters := kube.NewRegistry()
+	clusters.AddCluster(&kube.Cluster{Name: "dev", Clientset: client})
+
+	// Operations time out long before the drain does.
+	operations := operation.NewManager(operation.Options{Timeout: 10 * time.Millisecond, Retention: time.Minute})
+	defer operations.Shutdown()
+	h := NewNodeHandler(clusters, allowAll, operations, config.DrainConfig{
+		Timeout:            time.Minute,
+		RetryInterval:      10 * time.Millisecond,
+		GracePeriodSeconds: -1,
+	})
+
+	ctx := auth.With(context.Background(), &auth.Principal{Name: "alice"})
+	timeout := 1
+	resp, err := h.DrainNode(ctx, api.DrainNodeRequestObject{
+		Cluster: "dev",
+		Node:    "node-1",
+		Body:    &api.DrainNodeJSONRequestBody{TimeoutSeconds: &timeout},
+	})
+	if err != nil {
+		t.Fatalf("DrainNode: %v", err)
+	}
+	accepted, ok := resp.(api.DrainNode202JSONResponse)
+	if !ok {
+		t.Fatalf("response %T, want 202", resp)
+	}
+
+	start := time.Now()
+	op, err := operations.Wait(context.Background(), accepted.Body.Id)
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("drain ended after %v, want after its timeout of 1s", elapsed)
+	}
+	if op.Status != operation.StatusFailed || !strings.Contains(op.Error, "drain did not complete within 1s") ||
+		!strings.Contains(op.Error, "default/db-0 (disruption budget: ") {
+		t.Errorf("operation %s with %q, want the drain error naming the blocking pod", op.Status, op.Error)
+	}
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/notify"
+)
+
+const (
+	defaultDeadLetterPageSize = 50
+	maxDeadLetterPageSize     = 500
+)
+
+type NotifyHandler struct {
+	notifier *notify.Notifier
+}
+
+func NewNotifyHandler(notifier *notify.Notifier) *NotifyHandler {
+	return &NotifyHandler{notifier: notifier}
+}
+
+// ListDeadLetters lists the notifications that could not be delivered
+// (GET /notifications/dead-letters)
+func (h *NotifyHandler) ListDeadLetters(ctx context.Context, request api.ListDeadLettersRequestObject) (api.ListDeadLettersResponseObject, error) {
+	limit := defaultDeadLetterPageSize
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 {
+			return api.ListDeadLetters400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+				errorBody(ctx, "invalid_limit", "limit must be positive"),
+			)}, nil
+		}
+		limit = min(*request.Params.Limit, maxDeadLetterPageSize)
+	}
+
+	deadLetters, err := h.notifier.DeadLetters(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := api.ListDeadLetters200JSONResponse{Items: make([]api.DeadLetter, len(deadLetters))}
+	for i := range deadLetters {
+		resp.Items[i] = toAPIDeadLetter(&deadLetters[i])
+	}
+	return resp, nil
+}
+
+// RetryDeadLetter queues an undelivered notification again
+// (POST /notifications/dead-letters/{deadLetterId}/retry)
+func (h *NotifyHandler) RetryDeadLetter(ctx context.Context, request api.RetryDeadLetterRequestObject) (api.RetryDeadLetterResponseObject, error) {
+	dl, err := h.notifier.Retry(ctx, request.DeadLetterId)
+	details := map[string]any{"deadLetterId": request.DeadLetterId}
+	if dl != nil {
+		details["sink"] = dl.Sink
+		details["eventType"] = string(dl.Event.Type)
+	}
+	describeAudit(ctx, "notifications.retry", audit.Target{}, details, err)
+
+	switch {
+	case err == nil:
+		return api.RetryDeadLetter202JSONResponse(toAPIDeadLetter(dl)), nil
+	case errors.Is(err, notify.ErrNotFound):
+		return api.RetryDeadLetter404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(
+			errorBody(ctx, "dead_letter_not_found", err.Error()),
+		)}, nil
+	case errors.Is(err, notify.ErrUnknownSink):
+		return api.RetryDeadLetter409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(
+			errorBody(ctx, "unknown_sink", err.Error()),
+		)}, nil
+	default:
+		return nil, err
+	}
+}
+
+func toAPIDeadLetter(dl *notify.DeadLetter) api.DeadLetter {
+	event := dl.Event
+	out := api.DeadLetter{
+		Id:       dl.ID,
+		Sink:     dl.Sink,
+		Attempts: dl.Attempts,
+		Error:    dl.Error,
+		FailedAt: dl.FailedAt,
+		Event: api.NotificationEvent{
+			Id:        event.ID,
+			Type:      string(event.Type),
+			Time:      event.Time,
+			Principal: optional(event.Principal),
+			Target: api.AuditTarget{
+				Cluster:   optional(event.Target.Cluster),
+				Namespace: optional(event.Target.Namespace),
+				Resource:  optional(event.Target.Resource),
+				Name:      optional(event.Target.Name),
+			},
+			Summary: event.Summary,
+		},
+	}
+	if len(event.Details) > 0 {
+		out.Event.Details = &event.Details
+	}
+	return out
+}
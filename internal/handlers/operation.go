@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/operation"
+)
+
+// maxOperationWait caps the waitSeconds parameter of GetOperation. The
+// deadline of the request, REQUEST_TIMEOUT unless getOperation has an
+// override, cuts the wait shorter still.
+const maxOperationWait = 300 * time.Second
+
+// operationWaitMargin is left of the request deadline to respond in after
+// waiting for an operation.
+const operationWaitMargin = time.Second
+
+type OperationHandler struct {
+	authorizer auth.Authorizer
+	operations *operation.Manager
+}
+
+func NewOperationHandler(authorizer auth.Authorizer, operations *operation.Manager) *OperationHandler {
+	return &OperationHandler{
+		authorizer: authorizer,
+		operations: operations,
+	}
+}
+
+// GetOperation returns the state of a long-running operation, optionally
+// waiting for it to finish
+// (GET /api/v1/operations/{operationId})
+func (h *OperationHandler) GetOperation(ctx context.Context, request api.GetOperationRequestObject) (api.GetOperationResponseObject, error) {
+	var wait time.Duration
+	if request.Params.WaitSeconds != nil {
+		if *request.Params.WaitSeconds < 0 {
+			return api.GetOperation400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+				errorBody(ctx, "invalid_wait", "waitSeconds must not be negative"),
+			)}, nil
+		}
+		wait = min(time.Duration(*request.Params.WaitSeconds)*time.Second, maxOperationWait)
+		// Answer with the operation as it stands rather than run out of time.
+		if deadline, ok := ctx.Deadline(); ok {
+			wait = min(wait, time.Until(deadline)-operationWaitMargin)
+		}
+	}
+
+	op, err := h.operations.Get(request.OperationId)
+	if errors.Is(err, operation.ErrNotFound) {
+		return api.GetOperation404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(
+			errorBody(ctx, "operation_not_found", err.Error()),
+		)}, nil
+	}
+
+	// Callers may always see their own operations; others need permission to
+	// read operations on the target namespace.
+	principal := auth.From(ctx)
+	if !op.Principal.Same(principal) {
+		if err := h.authorizer.Authorize(ctx, principal, auth.Attributes{
+			Verb:      "get",
+			Cluster:   op.Target.Cluster,
+			Namespace: op.Target.Namespace,
+			Resource:  "operations",
+		}); err != nil {
+			return api.GetOperation403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(
+				errorBody(ctx, "forbidden", err.Error()),
+			)}, nil
+		}
+	}
+
+	if wait > 0 {
+		waitCtx, cancel := context.WithTimeout(ctx, wait)
+		defer cancel()
+		if op, err = h.operations.Wait(waitCtx, request.OperationId); err != nil {
+			return api.GetOperation404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(
+				errorBody(ctx, "operation_not_found", err.Error()),
+			)}, nil
+		}
+	}
+
+	return api.GetOperation200JSONResponse(toAPIOperation(op)), nil
+}
+
+// operationAccepted builds the 202 response of an endpoint that started op.
+func operationAccepted(op operation.Operation) api.OperationAcceptedJSONResponse {
+	return api.OperationAcceptedJSONResponse{
+		Body: toAPIOperation(op),
+		Headers: api.OperationAcceptedResponseHeaders{
+			Location: "/api/v1/operations/" + op.ID,
+		},
+	}
+}
+
+func toAPIOperation(op operation.Operation) api.Operation {
+	out := api.Operation{
+		Id:   op.ID,
+		Type: op.Type,
+		Target: api.OperationTarget{
+			Cluster: op.Target.Cluster,
+		},
+		Status:     api.OperationStatus(op.Status),
+		Principal:  op.Principal.Name,
+		StartedAt:  op.StartedAt,
+		FinishedAt: op.FinishedAt,
+		Progress: api.OperationProgress{
+			Percent: op.Progress.Percent,
+		},
+	}
+	if op.Target.Namespace != "" {
+		out.Target.Namespace = &op.Target.Namespace
+	}
+	if op.Target.Resource != "" {
+		out.Target.Resource = &op.Target.Resource
+	}
+	if op.Target.Name != "" {
+		out.Target.Name = &op.Target.Name
+	}
+	if op.Progress.Message != "" {
+		out.Progress.Message = &op.Progress.Message
+	}
+	if rollout, ok := op.Progress.Detail.(*api.RolloutStatus); ok {
+		out.Progress.Rollout = rollout
+	}
+	if drain, ok := op.Progress.Detail.(*api.DrainProgress); ok {
+		out.Progress.Drain = drain
+	}
+	if op.Error != "" {
+		out.Error = &op.Error
+	}
+	return out
+}
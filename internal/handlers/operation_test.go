@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/operation"
+)
+
+func TestGetOperationWaitEndsBeforeDeadline(t *testing.T) {
+	operations := operation.NewManager(operation.Options{Timeout: time.Minute, Retention: time.Minute})
+	defer operations.Shutdown()
+	op := operations.Start(operation.Operation{Type: "test.block", Principal: auth.Principal{Name: "alice"}},
+		func(ctx context.Context, report func(operation.Progress)) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	h := NewOperationHandler(&auth.Policy{}, operations)
+
+	ctx := auth.With(context.Background(), &auth.Principal{Name: "alice"})
+	ctx, cancel := context.WithTimeout(ctx, 1500*time.Millisecond)
+	defer cancel()
+	wait := 300
+	start := time.Now()
+	resp, err := h.GetOperation(ctx, api.GetOperationRequestObject{
+		OperationId: op.ID,
+		Params:      api.GetOperationParams{WaitSeconds: &wait},
+	})
+	if err != nil {
+		t.Fatalf("GetOperation: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %v, want to stop a second before the 1.5s deadline", elapsed)
+	}
+	if ctx.Err() != nil {
+		t.Error("responded after the deadline")
+	}
+	got, ok := resp.(api.GetOperation200JSONResponse)
+	if !ok {
+		t.Fatalf("response %T, want 200", resp)
+	}
+	if got.Status != api.OperationStatusRunning {
+		t.Errorf("status %s, want the running operation as it stands", got.Status)
+	}
+}
+
+func TestGetOperationOwnedByNameAndSource(t *testing.T) {
+	operations := operation.NewManager(operation.Options{Timeout: time.Minute, Retention: time.Minute})
+	defer operations.Shutdown()
+	owner := auth.Principal{Name: "alice", Source: auth.SourceCertificate}
+	op := operations.Start(operation.Operation{Type: "test.run", Principal: owner},
+		func(ctx context.Context, report func(operation.Progress)) error { return nil })
+	h := NewOperationHandler(&auth.Policy{}, operations)
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		want      bool
+	}{
+		{"owner", &owner, true},
+		{"same name from another source", &auth.Principal{Name: "alice", Source: auth.SourceToken}, false},
+		{"another name", &auth.Principal{Name: "bob", Source: auth.SourceCertificate}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.With(context.Background(), tt.principal)
+			resp, err := h.GetOperation(ctx, api.GetOperationRequestObject{OperationId: op.ID})
+			if err != nil {
+				t.Fatalf("GetOperation: %v", err)
+			}
+			if _, ok := resp.(api.GetOperation200JSONResponse); ok != tt.want {
+				t.Errorf("response %T, want allowed: %v", resp, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type PodHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+}
+
+func NewPodHandler(clusters *kube.Registry, authorizer auth.Authorizer) *PodHandler {
+	return &PodHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+	}
+}
+
+// GetPodLogs returns or streams the log of a container
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/log)
+func (h *PodHandler) GetPodLogs(ctx context.Context, request api.GetPodLogsRequestObject) (api.GetPodLogsResponseObject, error) {
+	stream, err := h.openLogs(ctx, request)
+	if err == nil {
+		return &logStream{stream: stream}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.GetPodLogs400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.GetPodLogs403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetPodLogs404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.GetPodLogs500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+func (h *PodHandler) openLogs(ctx context.Context, request api.GetPodLogsRequestObject) (io.ReadCloser, error) {
+	cluster, err := h.clusters.Get(request.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+		Verb:      "log",
+		Cluster:   cluster.Name,
+		Namespace: request.Namespace,
+		Resource:  "pods",
+	}); err != nil {
+		return nil, err
+	}
+
+	params := request.Params
+	return cluster.PodLogs(ctx, request.Namespace, request.Pod, &corev1.PodLogOptions{
+		Container:    deref(params.Container),
+		Follow:       deref(params.Follow),
+		TailLines:    params.TailLines,
+		SinceSeconds: params.SinceSeconds,
+		Timestamps:   deref(params.Timestamps),
+		Previous:     deref(params.Previous),
+	})
+}
+
+// logStream copies a container log to the client, flushing after every
+// read so followed logs arrive as they are written.
+type logStream struct {
+	stream io.ReadCloser
+}
+
+func (s *logStream) VisitGetPodLogsResponse(w http.ResponseWriter) error {
+	defer s.stream.Close()
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Disable response buffering in nginx-style reverse proxies.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	buf := make([]byte, 32<<10)
+	for {
+		n, err := s.stream.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return err
+			}
+			if err := rc.Flush(); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/kube"
+)
+
+const defaultOverProvisionedThreshold = 0.5
+
+var groupings = []api.UsageReportGroupBy{
+	api.UsageReportGroupByNamespace,
+	api.UsageReportGroupByTeam,
+	api.UsageReportGroupByCluster,
+}
+
+type UsageHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+}
+
+func NewUsageHandler(clusters *kube.Registry, authorizer auth.Authorizer) *UsageHandler {
+	return &UsageHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+	}
+}
+
+// GetUsageReport reports the CPU and memory requested, limited and used per
+// namespace, team or cluster
+// (GET /api/v1/clusters/{cluster}/usage)
+func (h *UsageHandler) GetUsageReport(ctx context.Context, request api.GetUsageReportRequestObject) (api.GetUsageReportResponseObject, error) {
+	params := request.Params
+	groupBy := api.UsageReportGroupBy(deref(params.GroupBy))
+	if groupBy == "" {
+		groupBy = api.UsageReportGroupByNamespace
+	}
+	format := string(deref(params.Format))
+
+	var report *kube.UsageReport
+	status, body := validateFormat(ctx, format)
+	if status == http.StatusOK && !slices.Contains(groupings, groupBy) {
+		status, body = http.StatusBadRequest, errorBody(ctx, "invalid_group_by", "groupBy must be namespace, team or cluster")
+	}
+	if status == http.StatusOK {
+		report, status, body = h.report(ctx, request.Cluster, params.Namespace, params.Team)
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusBadRequest:
+		return api.GetUsageReport400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.GetUsageReport403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetUsageReport404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.GetUsageReport500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+
+	resp := api.GetUsageReport200JSONResponse{
+		Cluster:          request.Cluster,
+		GroupBy:          groupBy,
+		MetricsAvailable: report.MetricsAvailable,
+		Items:            []api.ResourceUsage{},
+	}
+	switch groupBy {
+	case api.UsageReportGroupByNamespace:
+		for _, ns := range report.Namespaces {
+			resp.Items = append(resp.Items, toAPIResourceUsage(ns.Namespace, ns.Team, ns.Usage, report.MetricsAvailable))
+		}
+	case api.UsageReportGroupByTeam:
+		for _, team := range report.ByTeam() {
+			resp.Items = append(resp.Items, toAPIResourceUsage("", team.Team, team.Usage, report.MetricsAvailable))
+		}
+	case api.UsageReportGroupByCluster:
+		resp.Items = append(resp.Items, toAPIResourceUsage("", "", report.Total(), report.MetricsAvailable))
+	}
+
+	if format != string(api.ReportFormatCsv) {
+		return resp, nil
+	}
+	header := []string{"cluster", string(groupBy), "pods", "cpuRequests", "cpuLimits", "cpuUsage",
+		"memoryRequests", "memoryLimits", "memoryUsage"}
+	if groupBy == api.UsageReportGroupByCluster {
+		header = slices.Delete(header, 1, 2)
+	}
+	rows := [][]string{header}
+	for _, item := range resp.Items {
+		row := []string{request.Cluster}
+		switch groupBy {
+		case api.UsageReportGroupByNamespace:
+			row = append(row, deref(item.Namespace))
+		case api.UsageReportGroupByTeam:
+			row = append(row, deref(item.Team))
+		}
+		rows = append(rows, append(row, strconv.Itoa(item.Pods),
+			formatInt(&item.CpuRequests), formatInt(&item.CpuLimits), formatInt(item.CpuUsage),
+			formatInt(&item.MemoryRequests), formatInt(&item.MemoryLimits), formatInt(item.MemoryUsage)))
+	}
+	data, err := csvBody(rows)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetUsageReport200TextcsvResponse{Body: bytes.NewReader(data), ContentLength: int64(len(data))}, nil
+}
+
+// GetQuotaReport reports the utilisation of ResourceQuotas
+// (GET /api/v1/clusters/{cluster}/usage/quotas)
+func (h *UsageHandler) GetQuotaReport(ctx context.Context, request api.GetQuotaReportRequestObject) (api.GetQuotaReportResponseObject, error) {
+	params := request.Params
+	format := string(deref(params.Format))
+
+	var report *kube.UsageReport
+	status, body := validateFormat(ctx, format)
+	if status == http.StatusOK {
+		report, status, body = h.report(ctx, request.Cluster, params.Namespace, params.Team)
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusBadRequest:
+		return api.GetQuotaReport400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.GetQuotaReport403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetQuotaReport404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.GetQuotaReport500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+
+	resp := api.GetQuotaReport200JSONResponse{Cluster: request.Cluster, Items: []api.QuotaUtilization{}}
+	for _, q := range report.Quotas {
+		item := api.QuotaUtilization{
+			Namespace: q.Namespace,
+			Team:      optional(q.Team),
+			Quota:     q.Quota,
+			Resource:  string(q.Resource),
+			Hard:      q.Hard.String(),
+			Used:      q.Used.String(),
+		}
+		if percent, ok := q.Percent(); ok {
+			item.Percent = &percent
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	if format != string(api.ReportFormatCsv) {
+		return resp, nil
+	}
+	rows := [][]string{{"cluster", "namespace", "team", "quota", "resource", "hard", "used", "percent"}}
+	for _, item := range resp.Items {
+		var percent string
+		if item.Percent != nil {
+			percent = strconv.FormatFloat(*item.Percent, 'f', 1, 64)
+		}
+		rows = append(rows, []string{request.Cluster, item.Namespace, deref(item.Team), item.Quota, item.Resource,
+			item.Hard, item.Used, percent})
+	}
+	data, err := csvBody(rows)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetQuotaReport200TextcsvResponse{Body: bytes.NewReader(data), ContentLength: int64(len(data))}, nil
+}
+
+// GetWorkloadUsageReport reports the CPU and memory of workloads and
+// whether they are over-provisioned
+// (GET /api/v1/clusters/{cluster}/usage/workloads)
+func (h *UsageHandler) GetWorkloadUsageReport(ctx context.Context, request api.GetWorkloadUsageReportRequestObject) (api.GetWorkloadUsageReportResponseObject, error) {
+	params := request.Params
+	format := string(deref(params.Format))
+	threshold := defaultOverProvisionedThreshold
+	if params.Threshold != nil {
+		threshold = *params.Threshold
+	}
+
+	var report *kube.UsageReport
+	status, body := validateFormat(ctx, format)
+	if status == http.StatusOK && (threshold <= 0 || threshold > 1) {
+		status, body = http.StatusBadRequest, errorBody(ctx, "invalid_threshold", "threshold must be above 0 and at most 1")
+	}
+	if status == http.StatusOK {
+		report, status, body = h.report(ctx, request.Cluster, params.Namespace, params.Team)
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusBadRequest:
+		return api.GetWorkloadUsageReport400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.GetWorkloadUsageReport403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetWorkloadUsageReport404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.GetWorkloadUsageReport500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+
+	resp := api.GetWorkloadUsageReport200JSONResponse{
+		Cluster:          request.Cluster,
+		MetricsAvailable: report.MetricsAvailable,
+		Threshold:        threshold,
+		Items:            []api.WorkloadUsage{},
+	}
+	for _, w := range report.Workloads {
+		// Without metrics there is no usage to compare the requests with.
+		overProvisioned := report.MetricsAvailable && w.OverProvisioned(threshold)
+		if deref(params.OverProvisioned) && !overProvisioned {
+			continue
+		}
+		usage := toAPIResourceUsage(w.Namespace, w.Team, w.Usage, report.MetricsAvailable)
+		resp.Items = append(resp.Items, api.WorkloadUsage{
+			Namespace:       w.Namespace,
+			Team:            usage.Team,
+			Kind:            w.Kind,
+			Name:            w.Name,
+			Pods:            usage.Pods,
+			CpuRequests:     usage.CpuRequests,
+			CpuLimits:       usage.CpuLimits,
+			CpuUsage:        usage.CpuUsage,
+			MemoryRequests:  usage.MemoryRequests,
+			MemoryLimits:    usage.MemoryLimits,
+			MemoryUsage:     usage.MemoryUsage,
+			OverProvisioned: overProvisioned,
+		})
+	}
+
+	if format != string(api.ReportFormatCsv) {
+		return resp, nil
+	}
+	rows := [][]string{{"cluster", "namespace", "team", "kind", "name", "pods", "cpuRequests", "cpuLimits", "cpuUsage",
+		"memoryRequests", "memoryLimits", "memoryUsage", "overProvisioned"}}
+	for _, item := range resp.Items {
+		rows = append(rows, []string{request.Cluster, item.Namespace, deref(item.Team), item.Kind, item.Name,
+			strconv.Itoa(item.Pods), formatInt(&item.CpuRequests), formatInt(&item.CpuLimits), formatInt(item.CpuUsage),
+			formatInt(&item.MemoryRequests), formatInt(&item.MemoryLimits), formatInt(item.MemoryUsage),
+			strconv.FormatBool(item.OverProvisioned)})
+	}
+	data, err := csvBody(rows)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetWorkloadUsageReport200TextcsvResponse{Body: bytes.NewReader(data), ContentLength: int64(len(data))}, nil
+}
+
+// report authorizes and builds the usage report of a cluster. It returns
+// the report on success, or the status and body of the error response.
+func (h *UsageHandler) report(ctx context.Context, clusterName string, namespace, team *string) (*kube.UsageReport, int, api.ErrorResponse) {
+	cluster, err := h.clusters.Get(clusterName)
+	if err == nil {
+		err = h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+			Verb:      "list",
+			Cluster:   cluster.Name,
+			Namespace: deref(namespace),
+			Resource:  "usage",
+		})
+	}
+	var report *kube.UsageReport
+	if err == nil {
+		report, err = cluster.UsageReport(ctx, kube.UsageOptions{Namespace: deref(namespace), Team: deref(team)})
+	}
+	if err != nil {
+		status, body := errorStatus(ctx, err)
+		return nil, status, body
+	}
+	return report, http.StatusOK, api.ErrorResponse{}
+}
+
+// validateFormat checks the format parameter of a report request.
+func validateFormat(ctx context.Context, format string) (int, api.ErrorResponse) {
+	if format != "" && format != string(api.ReportFormatJson) && format != string(api.ReportFormatCsv) {
+		return http.StatusBadRequest, errorBody(ctx, "invalid_format", "format must be json or csv")
+	}
+	return http.StatusOK, api.ErrorResponse{}
+}
+
+func toAPIResourceUsage(namespace, team string, u kube.Usage, metricsAvailable bool) api.ResourceUsage {
+	usage := api.ResourceUsage{
+		Namespace:      optional(namespace),
+		Team:           optional(team),
+		Pods:           u.Pods,
+		CpuRequests:    u.Requests.CPU,
+		CpuLimits:      u.Limits.CPU,
+		MemoryRequests: u.Requests.Memory,
+		MemoryLimits:   u.Limits.Memory,
+	}
+	if metricsAvailable {
+		usage.CpuUsage = &u.Actual.CPU
+		usage.MemoryUsage = &u.Actual.Memory
+	}
+	return usage
+}
+
+// formatInt formats a CSV field, empty for nil.
+func formatInt(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+func csvBody(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
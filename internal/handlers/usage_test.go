@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"slices"
+	"testing"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var podMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+
+// usagePod is a pod requesting 100m of CPU and 128Mi of memory, limited
+// to twice that.
+func usagePod(namespace, name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+		}}}},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+// newUsageHandler returns the handler of a cluster with the namespaces of
+// teams a and b, each running a pod. With metrics, the pod of team a uses
+// a fifth of its CPU and the pod of team b most of it.
+func newUsageHandler(t *testing.T, metrics bool) *UsageHandler {
+	t.Helper()
+	client := fake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"iu-k8s.linecorp.com/team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"iu-k8s.linecorp.com/team": "b"}}},
+		usagePod("team-a", "web"),
+		usagePod("team-b", "api"),
+		&corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "compute"},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("500m"), corev1.ResourcePods: resource.MustParse("0")},
+				Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("100m"), corev1.ResourcePods: resource.MustParse("1")},
+			},
+		},
+	)
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podMetricsResource: "PodMetricsList"})
+	if !metrics {
+		dynamic.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewNotFound(podMetricsResource.GroupResource(), "")
+		})
+	}
+	for _, m := range []struct{ namespace, name, cpu string }{{"team-a", "web", "20m"}, {"team-b", "api", "80m"}} {
+		_, err := dynamic.Resource(podMetricsResource).Namespace(m.namespace).Create(context.Background(), &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "metrics.k8s.io/v1beta1",
+				"kind":       "PodMetrics",
+				"metadata":   map[string]any{"namespace": m.namespace, "name": m.name},
+				"containers": []any{map[string]any{"usage": map[string]any{"cpu": m.cpu, "memory": "100Mi"}}},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	clusters := kube.NewRegistry()
+	clusters.AddCluster(&kube.Cluster{Name: "dev", Clientset: client, Dynamic: dynamic})
+	return NewUsageHandler(clusters, allowAll)
+}
+
+var usageContext = auth.With(context.Background(), &auth.Principal{Name: "alice"})
+
+// readCSV parses a CSV report body.
+func readCSV(t *testing.T, body io.Reader) [][]string {
+	t.Helper()
+	rows, err := csv.NewReader(body).ReadAll()
+	if err != nil {
+		t.Fatalf("reading the CSV: %v", err)
+	}
+	return rows
+}
+
+func TestGetUsageReportCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupBy api.GetUsageReportParamsGroupBy
+		metrics bool
+		want    [][]string
+	}{
+		{"namespace", api.GetUsageReportParamsGroupByNamespace, true, [][]string{
+			{"cluster", "namespace", "pods", "cpuRequests", "cpuLimits", "cpuUsage", "memoryRequests", "memoryLimits", "memoryUsage"},
+			{"dev", "team-a", "1", "100", "200", "20", "134217728", "268435456", "104857600"},
+			{"dev", "team-b", "1", "100", "200", "80", "134217728", "268435456", "104857600"},
+		}},
+		{"team", api.GetUsageReportParamsGroupByTeam, true, [][]string{
+			{"cluster", "team", "pods", "cpuRequests", "cpuLimits", "cpuUsage", "memoryRequests", "memoryLimits", "memoryUsage"},
+			{"dev", "a", "1", "100", "200", "20", "134217728", "268435456", "104857600"},
+			{"dev", "b", "1", "100", "200", "80", "134217728", "268435456", "104857600"},
+		}},
+		{"cluster", api.GetUsageReportParamsGroupByCluster, true, [][]string{
+			{"cluster", "pods", "cpuRequests", "cpuLimits", "cpuUsage", "memoryRequests", "memoryLimits", "memoryUsage"},
+			{"dev", "2", "200", "400", "100", "268435456", "536870912", "209715200"},
+		}},
+		// Without metrics-server the usage is unknown, not zero.
+		{"cluster without metrics", api.GetUsageReportParamsGroupByCluster, false, [][]string{
+			{"cluster", "pods", "cpuRequests", "cpuLimits", "cpuUsage", "memoryRequests", "memoryLimits", "memoryUsage"},
+			{"dev", "2", "200", "400", "", "268435456", "536870912", ""},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format := api.GetUsageReportParamsFormatCsv
+			resp, err := newUsageHandler(t, tt.metrics).GetUsageReport(usageContext, api.GetUsageReportRequestObject{
+				Cluster: "dev",
+				Params:  api.GetUsageReportParams{GroupBy: &tt.groupBy, Format: &format},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := resp.(api.GetUsageReport200TextcsvResponse)
+			if !ok {
+				t.Fatalf("response %#v, want CSV", resp)
+			}
+			if rows := readCSV(t, got.Body); !slices.EqualFunc(rows, tt.want, slices.Equal) {
+				t.Errorf("rows = %q, want %q", rows, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetQuotaReportCSV(t *testing.T) {
+	format := api.GetQuotaReportParamsFormatCsv
+	resp, err := newUsageHandler(t, true).GetQuotaReport(usageContext, api.GetQuotaReportRequestObject{
+		Cluster: "dev",
+		Params:  api.GetQuotaReportParams{Format: &format},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, ok := resp.(api.GetQuotaReport200TextcsvResponse)
+	if !ok {
+		t.Fatalf("response %#v, want CSV", resp)
+	}
+	want := [][]string{
+		{"cluster", "namespace", "team", "quota", "resource", "hard", "used", "percent"},
+		// A zero hard limit has no percentage.
+		{"dev", "team-a", "a", "compute", "pods", "0", "1", ""},
+		{"dev", "team-a", "a", "compute", "requests.cpu", "500m", "100m", "20.0"},
+	}
+	if rows := readCSV(t, got.Body); !slices.EqualFunc(rows, want, slices.Equal) {
+		t.Errorf("rows = %q, want %q", rows, want)
+	}
+}
+
+func TestGetWorkloadUsageReport(t *testing.T) {
+	threshold := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name            string
+		metrics         bool
+		threshold       *float64
+		overProvisioned bool
+		want            map[string]bool
+	}{
+		{"default threshold", true, nil, false, map[string]bool{"web": true, "api": false}},
+		{"lower threshold", true, threshold(0.1), false, map[string]bool{"web": false, "api": false}},
+		{"full threshold", true, threshold(1), false, map[string]bool{"web": true, "api": true}},
+		{"over-provisioned only", true, nil, true, map[string]bool{"web": true}},
+		// Without metrics nothing is known to be over-provisioned.
+		{"without metrics", false, nil, false, map[string]bool{"web": false, "api": false}},
+		{"over-provisioned only without metrics", false, nil, true, map[string]bool{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newUsageHandler(t, tt.metrics).GetWorkloadUsageReport(usageContext, api.GetWorkloadUsageReportRequestObject{
+				Cluster: "dev",
+				Params:  api.GetWorkloadUsageReportParams{Threshold: tt.threshold, OverProvisioned: &tt.overProvisioned},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			report, ok := resp.(api.GetWorkloadUsageReport200JSONResponse)
+			if !ok {
+				t.Fatalf("response %#v, want 200", resp)
+			}
+			got := map[string]bool{}
+			for _, w := range report.Items {
+				got[w.Name] = w.OverProvisioned
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("over-provisioned = %v, want %v", got, tt.want)
+			}
+			for name, want := range tt.want {
+				if over, ok := got[name]; !ok || over != want {
+					t.Errorf("over-provisioned = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+
+	for _, v := range []float64{0, -0.5, 1.5} {
+		resp, _ := newUsageHandler(t, true).GetWorkloadUsageReport(usageContext, api.GetWorkloadUsageReportRequestObject{
+			Cluster: "dev",
+			Params:  api.GetWorkloadUsageReportParams{Threshold: &v},
+		})
+		if resp, ok := resp.(api.GetWorkloadUsageReport400JSONResponse); !ok || resp.Error != "invalid_threshold" {
+			t.Errorf("threshold %v: %#v, want 400 invalid_threshold", v, resp)
+		}
+	}
+}
+
+func TestGetWorkloadUsageReportCSV(t *testing.T) {
+	format := api.GetWorkloadUsageReportParamsFormatCsv
+	resp, err := newUsageHandler(t, true).GetWorkloadUsageReport(usageContext, api.GetWorkloadUsageReportRequestObject{
+		Cluster: "dev",
+		Params:  api.GetWorkloadUsageReportParams{Format: &format},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, ok := resp.(api.GetWorkloadUsageReport200TextcsvResponse)
+	if !ok {
+		t.Fatalf("response %#v, want CSV", resp)
+	}
+	want := [][]string{
+		{"cluster", "namespace", "team", "kind", "name", "pods", "cpuRequests", "cpuLimits", "cpuUsage",
+			"memoryRequests", "memoryLimits", "memoryUsage", "overProvisioned"},
+		{"dev", "team-a", "a", "Pod", "web", "1", "100", "200", "20", "134217728", "268435456", "104857600", "true"},
+		{"dev", "team-b", "b", "Pod", "api", "1", "100", "200", "80", "134217728", "268435456", "104857600", "false"},
+	}
+	if rows := readCSV(t, got.Body); !slices.EqualFunc(rows, want, slices.Equal) {
+		t.Errorf("rows = %q, want %q", rows, want)
+	}
+}
+
+func TestCSVBodyEscapes(t *testing.T) {
+	rows := [][]string{
+		{"cluster", "quota"},
+		{"dev", `compute, "burst"`},
+		{"dev", "two\nlines"},
+	}
+	data, err := csvBody(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "cluster,quota\ndev,\"compute, \"\"burst\"\"\"\ndev,\"two\nlines\"\n"
+	if string(data) != want {
+		t.Errorf("csvBody = %q, want %q", data, want)
+	}
+	if got := readCSV(t, bytes.NewReader(data)); !slices.EqualFunc(got, rows, slices.Equal) {
+		t.Errorf("read back %q, want %q", got, rows)
+	}
+}
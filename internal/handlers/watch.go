@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// eventResync tells the client to drop its state; the full set of objects follows.
+	eventResync = "RESYNC"
+	// eventError is sent before the server ends a stream.
+	eventError = "ERROR"
+)
+
+type WatchHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+	hub        *kube.WatchHub
+	cfg        config.WatchConfig
+}
+
+func NewWatchHandler(clusters *kube.Registry, authorizer auth.Authorizer, hub *kube.WatchHub, cfg config.WatchConfig) *WatchHandler {
+	return &WatchHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+		hub:        hub,
+		cfg:        cfg,
+	}
+}
+
+// WatchResources streams changes of a resource across all namespaces
+// (GET /api/v1/clusters/{cluster}/watch/{resource})
+func (h *WatchHandler) WatchResources(ctx context.Context, request api.WatchResourcesRequestObject) (api.WatchResourcesResponseObject, error) {
+	stream, status, body := h.subscribe(ctx, request.Cluster, "", request.Resource,
+		request.Params.LabelSelector, request.Params.LastEventID)
+	switch status {
+	case http.StatusOK:
+		return stream, nil
+	case http.StatusBadRequest:
+		return api.WatchResources400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.WatchResources403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.WatchResources404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.WatchResources503JSONResponse{ServiceUnavailableJSONResponse: api.ServiceUnavailableJSONResponse(body)}, nil
+	}
+}
+
+// WatchNamespacedResources streams changes of a resource in a namespace
+// (GET /api/v1/clusters/{cluster}/watch/namespaces/{namespace}/{resource})
+func (h *WatchHandler) WatchNamespacedResources(ctx context.Context, request api.WatchNamespacedResourcesRequestObject) (api.WatchNamespacedResourcesResponseObject, error) {
+	stream, status, body := h.subscribe(ctx, request.Cluster, request.Namespace, request.Resource,
+		request.Params.LabelSelector, request.Params.LastEventID)
+	switch status {
+	case http.StatusOK:
+		return stream, nil
+	case http.StatusBadRequest:
+		return api.WatchNamespacedResources400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.WatchNamespacedResources403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.WatchNamespacedResources404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.WatchNamespacedResources503JSONResponse{ServiceUnavailableJSONResponse: api.ServiceUnavailableJSONResponse(body)}, nil
+	}
+}
+
+// subscribe resolves and authorizes a watch request. It returns the stream on
+// success, or the status and body of the error response.
+func (h *WatchHandler) subscribe(ctx context.Context, clusterName, namespace, resource string, labelSelector, lastEventID *string) (*watchStream, int, api.ErrorResponse) {
+	cluster, err := h.clusters.Get(clusterName)
+	if err != nil {
+		return nil, http.StatusNotFound, errorBody(ctx, "cluster_not_found", err.Error())
+	}
+
+	selector := labels.Everything()
+	if labelSelector != nil && *labelSelector != "" {
+		if selector, err = labels.Parse(*labelSelector); err != nil {
+			return nil, http.StatusBadRequest, errorBody(ctx, "invalid_label_selector", err.Error())
+		}
+	}
+
+	gvr, err := cluster.ResourceFor(resource)
+	if err != nil {
+		return nil, http.StatusNotFound, errorBody(ctx, "resource_not_found", err.Error())
+	}
+
+	if err := h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+		Verb:      "watch",
+		Cluster:   cluster.Name,
+		Namespace: namespace,
+		Resource:  gvr.GroupResource().String(),
+	}); err != nil {
+		return nil, http.StatusForbidden, errorBody(ctx, "forbidden", err.Error())
+	}
+
+	var resumeFrom string
+	if lastEventID != nil {
+		resumeFrom = *lastEventID
+	}
+	sub, err := h.hub.Subscribe(ctx, cluster, gvr, namespace, selector, resumeFrom)
+	if err != nil {
+		return nil, http.StatusServiceUnavailable, errorBody(ctx, "watch_unavailable", err.Error())
+	}
+
+	return &watchStream{
+		ctx:       ctx,
+		sub:       sub,
+		heartbeat: h.cfg.HeartbeatInterval,
+	}, http.StatusOK, api.ErrorResponse{}
+}
+
+// watchStream writes a subscription to the client as Server-Sent Events. It
+// implements the response interfaces of both watch operations.
+type watchStream struct {
+	ctx       context.Context
+	sub       *kube.Subscription
+	heartbeat time.Duration
+}
+
+func (s *watchStream) VisitWatchResourcesResponse(w http.ResponseWriter) error {
+	return s.write(w)
+}
+
+func (s *watchStream) VisitWatchNamespacedResourcesResponse(w http.ResponseWriter) error {
+	return s.write(w)
+}
+
+func (s *watchStream) write(w http.ResponseWriter) error {
+	defer s.sub.Close()
+	logger := log.From(s.ctx)
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Disable response buffering in nginx-style reverse proxies.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if s.sub.Resynced {
+		if err := writeEvent(w, eventResync, "", struct{}{}); err != nil {
+			return err
+		}
+	}
+	for _, event := range s.sub.Initial {
+		if err := writeEvent(w, event.Type, event.ResourceVersion, event.Object); err != nil {
+			return err
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(s.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+		case event, ok := <-s.sub.Events():
+			if !ok {
+				err := s.sub.Err()
+				if errors.Is(err, kube.ErrSlowConsumer) {
+					logger.Warn("disconnecting slow watch client")
+				}
+				_ = writeEvent(w, eventError, "", errorBody(s.ctx, "watch_closed", fmt.Sprint(err)))
+				_ = rc.Flush()
+				return nil
+			}
+			if err := writeEvent(w, event.Type, event.ResourceVersion, event.Object); err != nil {
+				return err
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func writeEvent(w io.Writer, event, id string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
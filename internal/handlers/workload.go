@@ -0,0 +1,389 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/operation"
+)
+
+type WorkloadHandler struct {
+	clusters   *kube.Registry
+	authorizer auth.Authorizer
+	operations *operation.Manager
+}
+
+func NewWorkloadHandler(clusters *kube.Registry, authorizer auth.Authorizer, operations *operation.Manager) *WorkloadHandler {
+	return &WorkloadHandler{
+		clusters:   clusters,
+		authorizer: authorizer,
+		operations: operations,
+	}
+}
+
+// workloadTarget identifies the workload of a request.
+type workloadTarget struct {
+	cluster string
+	ref     kube.WorkloadRef
+}
+
+func newWorkloadTarget(cluster, namespace, workload, name string) workloadTarget {
+	return workloadTarget{
+		cluster: cluster,
+		ref:     kube.WorkloadRef{Resource: workload, Namespace: namespace, Name: name},
+	}
+}
+
+// ifMatch conditions the changes of t on an If-Match header. The entity tags
+// of a workload are its quoted resource versions, as getWorkloadStatus
+// reports them. Weak tags never match an If-Match, so a header listing only
+// those fails every change.
+func (t workloadTarget) ifMatch(header *api.IfMatch) workloadTarget {
+	if header == nil || strings.TrimSpace(*header) == "" {
+		return t
+	}
+	versions := []string{}
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return t
+		}
+		if len(tag) >= 2 && tag[0] == '"' && tag[len(tag)-1] == '"' {
+			versions = append(versions, tag[1:len(tag)-1])
+		}
+	}
+	t.ref.ResourceVersions = versions
+	return t
+}
+
+// GetWorkloadStatus reports the rollout status of a workload
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/status)
+func (h *WorkloadHandler) GetWorkloadStatus(ctx context.Context, request api.GetWorkloadStatusRequestObject) (api.GetWorkloadStatusResponseObject, error) {
+	target := newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name)
+	cluster, err := h.authorize(ctx, target, "get")
+	if err == nil {
+		var status *kube.RolloutStatus
+		if status, err = cluster.RolloutStatus(ctx, target.ref); err == nil {
+			return api.GetWorkloadStatus200JSONResponse{
+				Body:    *toAPIRolloutStatus(target, status),
+				Headers: api.GetWorkloadStatus200ResponseHeaders{ETag: strconv.Quote(status.ResourceVersion)},
+			}, nil
+		}
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.GetWorkloadStatus400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.GetWorkloadStatus403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.GetWorkloadStatus404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.GetWorkloadStatus500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// ScaleWorkload sets the replica count of a workload
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/scale)
+func (h *WorkloadHandler) ScaleWorkload(ctx context.Context, request api.ScaleWorkloadRequestObject) (api.ScaleWorkloadResponseObject, error) {
+	if request.Body.Replicas < 0 {
+		return api.ScaleWorkload400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(
+			errorBody(ctx, "invalid_replicas", "replicas must not be negative"),
+		)}, nil
+	}
+
+	target := newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name).
+		ifMatch(request.Params.IfMatch)
+	op, err := h.operate(ctx, target, "scale", map[string]any{"replicas": request.Body.Replicas}, true,
+		func(cluster *kube.Cluster) error {
+			return cluster.Scale(ctx, target.ref, request.Body.Replicas)
+		})
+	if err == nil {
+		return api.ScaleWorkload202JSONResponse{OperationAcceptedJSONResponse: operationAccepted(op)}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.ScaleWorkload400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.ScaleWorkload403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.ScaleWorkload404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.ScaleWorkload409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	case http.StatusPreconditionFailed:
+		return api.ScaleWorkload412JSONResponse{PreconditionFailedJSONResponse: api.PreconditionFailedJSONResponse(body)}, nil
+	default:
+		return api.ScaleWorkload500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// RestartWorkload triggers a rolling restart of a workload
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/restart)
+func (h *WorkloadHandler) RestartWorkload(ctx context.Context, request api.RestartWorkloadRequestObject) (api.RestartWorkloadResponseObject, error) {
+	target := newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name).
+		ifMatch(request.Params.IfMatch)
+	op, err := h.operate(ctx, target, "restart", nil, true, func(cluster *kube.Cluster) error {
+		return cluster.Restart(ctx, target.ref, time.Now())
+	})
+	if err == nil {
+		return api.RestartWorkload202JSONResponse{OperationAcceptedJSONResponse: operationAccepted(op)}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.RestartWorkload400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.RestartWorkload403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.RestartWorkload404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.RestartWorkload409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	case http.StatusPreconditionFailed:
+		return api.RestartWorkload412JSONResponse{PreconditionFailedJSONResponse: api.PreconditionFailedJSONResponse(body)}, nil
+	default:
+		return api.RestartWorkload500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// PauseWorkload pauses the rollout of a workload
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/pause)
+func (h *WorkloadHandler) PauseWorkload(ctx context.Context, request api.PauseWorkloadRequestObject) (api.PauseWorkloadResponseObject, error) {
+	target := newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name).
+		ifMatch(request.Params.IfMatch)
+	op, err := h.operate(ctx, target, "pause", nil, false, func(cluster *kube.Cluster) error {
+		return cluster.SetPaused(ctx, target.ref, true)
+	})
+	if err == nil {
+		return api.PauseWorkload202JSONResponse{OperationAcceptedJSONResponse: operationAccepted(op)}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.PauseWorkload400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.PauseWorkload403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.PauseWorkload404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.PauseWorkload409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	case http.StatusPreconditionFailed:
+		return api.PauseWorkload412JSONResponse{PreconditionFailedJSONResponse: api.PreconditionFailedJSONResponse(body)}, nil
+	default:
+		return api.PauseWorkload500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// ResumeWorkload resumes a paused rollout
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/resume)
+func (h *WorkloadHandler) ResumeWorkload(ctx context.Context, request api.ResumeWorkloadRequestObject) (api.ResumeWorkloadResponseObject, error) {
+	target := newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name).
+		ifMatch(request.Params.IfMatch)
+	op, err := h.operate(ctx, target, "resume", nil, true, func(cluster *kube.Cluster) error {
+		return cluster.SetPaused(ctx, target.ref, false)
+	})
+	if err == nil {
+		return api.ResumeWorkload202JSONResponse{OperationAcceptedJSONResponse: operationAccepted(op)}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.ResumeWorkload400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.ResumeWorkload403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.ResumeWorkload404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.ResumeWorkload409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	case http.StatusPreconditionFailed:
+		return api.ResumeWorkload412JSONResponse{PreconditionFailedJSONResponse: api.PreconditionFailedJSONResponse(body)}, nil
+	default:
+		return api.ResumeWorkload500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// ListWorkloadRevisions lists the rollout history of a workload
+// (GET /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/revisions)
+func (h *WorkloadHandler) ListWorkloadRevisions(ctx context.Context, request api.ListWorkloadRevisionsRequestObject) (api.ListWorkloadRevisionsResponseObject, error) {
+	target := newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name)
+	cluster, err := h.authorize(ctx, target, "get")
+	if err == nil {
+		var revisions []kube.Revision
+		if revisions, err = cluster.Revisions(ctx, target.ref); err == nil {
+			items := make([]api.WorkloadRevision, 0, len(revisions))
+			for _, revision := range revisions {
+				item := api.WorkloadRevision{
+					Revision:  revision.Revision,
+					Images:    revision.Images,
+					CreatedAt: revision.Created,
+					Current:   revision.Current,
+				}
+				if revision.ChangeCause != "" {
+					item.ChangeCause = &revision.ChangeCause
+				}
+				items = append(items, item)
+			}
+			return api.ListWorkloadRevisions200JSONResponse{Items: items}, nil
+		}
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.ListWorkloadRevisions400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.ListWorkloadRevisions403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.ListWorkloadRevisions404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	default:
+		return api.ListWorkloadRevisions500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// RollbackWorkload rolls a workload back to a previous revision
+// (POST /api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/rollback)
+func (h *WorkloadHandler) RollbackWorkload(ctx context.Context, request api.RollbackWorkloadRequestObject) (api.RollbackWorkloadResponseObject, error) {
+	target := newWorkloadTarget(request.Cluster, request.Namespace, string(request.Workload), request.Name).
+		ifMatch(request.Params.IfMatch)
+	op, err := h.operate(ctx, target, "rollback", map[string]any{"revision": request.Body.Revision}, true,
+		func(cluster *kube.Cluster) error {
+			return cluster.Rollback(ctx, target.ref, request.Body.Revision)
+		})
+	if err == nil {
+		return api.RollbackWorkload202JSONResponse{OperationAcceptedJSONResponse: operationAccepted(op)}, nil
+	}
+
+	switch code, body := errorStatus(ctx, err); code {
+	case http.StatusBadRequest:
+		return api.RollbackWorkload400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse(body)}, nil
+	case http.StatusForbidden:
+		return api.RollbackWorkload403JSONResponse{ForbiddenJSONResponse: api.ForbiddenJSONResponse(body)}, nil
+	case http.StatusNotFound:
+		return api.RollbackWorkload404JSONResponse{NotFoundJSONResponse: api.NotFoundJSONResponse(body)}, nil
+	case http.StatusConflict:
+		return api.RollbackWorkload409JSONResponse{ConflictJSONResponse: api.ConflictJSONResponse(body)}, nil
+	case http.StatusPreconditionFailed:
+		return api.RollbackWorkload412JSONResponse{PreconditionFailedJSONResponse: api.PreconditionFailedJSONResponse(body)}, nil
+	default:
+		return api.RollbackWorkload500JSONResponse{InternalErrorJSONResponse: api.InternalErrorJSONResponse(body)}, nil
+	}
+}
+
+// authorize resolves the cluster of target and checks the caller may perform verb on it.
+func (h *WorkloadHandler) authorize(ctx context.Context, target workloadTarget, verb string) (*kube.Cluster, error) {
+	cluster, err := h.clusters.Get(target.cluster)
+	if err != nil {
+		return nil, err
+	}
+	err = h.authorizer.Authorize(ctx, auth.From(ctx), auth.Attributes{
+		Verb:      verb,
+		Cluster:   target.cluster,
+		Namespace: target.ref.Namespace,
+		Resource:  target.ref.Resource + ".apps",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
+
+// operate runs a mutating operation on a workload, records it in the audit
+// trail and starts a long-running operation reporting the resulting rollout.
+// With track set, the operation follows the rollout until it completes or
+// fails; otherwise it completes with the status right after the change.
+func (h *WorkloadHandler) operate(ctx context.Context, target workloadTarget, verb string, details map[string]any, track bool, fn func(*kube.Cluster) error) (operation.Operation, error) {
+	auditTarget := audit.Target{
+		Cluster:   target.cluster,
+		Namespace: target.ref.Namespace,
+		Resource:  target.ref.Resource,
+		Name:      target.ref.Name,
+	}
+	action := target.ref.Resource + "." + verb
+
+	cluster, err := h.authorize(ctx, target, verb)
+	if err == nil {
+		err = fn(cluster)
+	}
+	describeAudit(ctx, action, auditTarget, details, err)
+	if err != nil {
+		return operation.Operation{}, err
+	}
+
+	status, err := rolloutStatus(ctx, cluster, target)
+	if err != nil {
+		return operation.Operation{}, err
+	}
+
+	op := h.operations.Start(operation.Operation{
+		Type:      action,
+		Target:    auditTarget,
+		Principal: *auth.From(ctx),
+		Progress:  rolloutProgress(status),
+	}, func(ctx context.Context, report func(operation.Progress)) error {
+		if !track {
+			return nil
+		}
+		return cluster.WaitForRollout(ctx, target.ref, func(s *kube.RolloutStatus) {
+			report(rolloutProgress(toAPIRolloutStatus(target, s)))
+		})
+	})
+	return op, nil
+}
+
+func rolloutStatus(ctx context.Context, cluster *kube.Cluster, target workloadTarget) (*api.RolloutStatus, error) {
+	status, err := cluster.RolloutStatus(ctx, target.ref)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIRolloutStatus(target, status), nil
+}
+
+func toAPIRolloutStatus(target workloadTarget, status *kube.RolloutStatus) *api.RolloutStatus {
+	out := &api.RolloutStatus{
+		Cluster:            target.cluster,
+		Namespace:          target.ref.Namespace,
+		Workload:           target.ref.Resource,
+		Name:               target.ref.Name,
+		Generation:         status.Generation,
+		ObservedGeneration: status.ObservedGeneration,
+		Replicas:           status.Replicas,
+		UpdatedReplicas:    status.UpdatedReplicas,
+		ReadyReplicas:      status.ReadyReplicas,
+		AvailableReplicas:  status.AvailableReplicas,
+		Paused:             status.Paused,
+		Complete:           status.Complete,
+		Failed:             status.Failed,
+		Message:            status.Message,
+	}
+	if status.CurrentRevision != "" {
+		out.CurrentRevision = &status.CurrentRevision
+	}
+	if status.UpdateRevision != "" {
+		out.UpdateRevision = &status.UpdateRevision
+	}
+	return out
+}
+
+// rolloutProgress estimates progress as the share of desired replicas that
+// are both updated and available.
+func rolloutProgress(status *api.RolloutStatus) operation.Progress {
+	percent := 0
+	switch {
+	case status.Complete:
+		percent = 100
+	case status.Replicas > 0:
+		done := min(status.UpdatedReplicas, status.AvailableReplicas)
+		percent = min(int(done*100/status.Replicas), 99)
+	}
+	return operation.Progress{
+		Percent: percent,
+		Message: status.Message,
+		Detail:  status,
+	}
+}
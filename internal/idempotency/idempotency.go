@@ -0,0 +1,115 @@
+// Package idempotency remembers the responses of mutating requests sent with
+// an Idempotency-Key so that a retry of the same request replays the
+// response instead of repeating its effect. Records live in memory or, to
+// hold across replicas, in the database.
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInProgress is returned while the first request with a key is
+	// still being served.
+	ErrInProgress = errors.New("a request with this idempotency key is in progress")
+	// ErrMismatch is returned when a key is reused for a different request.
+	ErrMismatch = errors.New("the idempotency key was used for a different request")
+)
+
+// Response is a stored response.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// Store keeps the records of idempotency keys. key identifies the caller
+// and operation as well as the client's key; fingerprint identifies the
+// request. Each method must be atomic.
+type Store interface {
+	// Reserve claims key for the request. A live record of the same
+	// request with a response returns that response; one still in progress
+	// fails with ErrInProgress, and one of another request with
+	// ErrMismatch. Otherwise the key is reserved until lockedUntil and
+	// Reserve returns nil.
+	Reserve(ctx context.Context, key, fingerprint string, now, lockedUntil time.Time) (*Response, error)
+	// Complete stores the response of a reserved key until expires.
+	Complete(ctx context.Context, key, fingerprint string, resp Response, expires time.Time) error
+	// Release gives up a reserved key so that a retry runs again.
+	Release(ctx context.Context, key, fingerprint string) error
+}
+
+// Memory is a Store for a single replica.
+type Memory struct {
+	mu      sync.Mutex
+	records map[string]*memoryRecord
+	swept   time.Time
+}
+
+type memoryRecord struct {
+	fingerprint string
+	// response is nil while the request is in progress.
+	response *Response
+	expires  time.Time
+}
+
+// sweepInterval is how often Memory forgets expired records.
+const sweepInterval = time.Minute
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{records: map[string]*memoryRecord{}}
+}
+
+// Reserve implements Store.
+func (m *Memory) Reserve(ctx context.Context, key, fingerprint string, now, lockedUntil time.Time) (*Response, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if now.Sub(m.swept) > sweepInterval {
+		for k, r := range m.records {
+			if !now.Before(r.expires) {
+				delete(m.records, k)
+			}
+		}
+		m.swept = now
+	}
+
+	if r, ok := m.records[key]; ok && now.Before(r.expires) {
+		switch {
+		case r.fingerprint != fingerprint:
+			return nil, ErrMismatch
+		case r.response == nil:
+			return nil, ErrInProgress
+		default:
+			return r.response, nil
+		}
+	}
+	m.records[key] = &memoryRecord{fingerprint: fingerprint, expires: lockedUntil}
+	return nil, nil
+}
+
+// Complete implements Store.
+func (m *Memory) Complete(ctx context.Context, key, fingerprint string, resp Response, expires time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r, ok := m.records[key]; ok && r.fingerprint == fingerprint {
+		r.response = &resp
+		r.expires = expires
+	}
+	return nil
+}
+
+// Release implements Store.
+func (m *Memory) Release(ctx context.Context, key, fingerprint string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r, ok := m.records[key]; ok && r.fingerprint == fingerprint && r.response == nil {
+		delete(m.records, key)
+	}
+	return nil
+}
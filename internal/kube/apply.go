@@ -0,0 +1,304 @@
+package kube
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ErrInvalidManifest is returned for documents that cannot be applied as
+// they stand, such as objects without a kind or name.
+var ErrInvalidManifest = errors.New("invalid manifest")
+
+// Diff operations, named after their JSON patch counterparts.
+const (
+	DiffAdd     = "add"
+	DiffRemove  = "remove"
+	DiffReplace = "replace"
+)
+
+// Manifest is one document of a manifest batch. Documents that fail to
+// decode carry the error instead of an object.
+type Manifest struct {
+	// Index is the position of the document among the non-empty documents
+	// of the batch.
+	Index  int
+	Object *unstructured.Unstructured
+	Err    error
+}
+
+// DecodeManifests splits a multi-document YAML or JSON stream into its
+// documents. Documents holding only comments are skipped. A document that is not a valid
+// object does not stop decoding; only errors reading the stream itself are
+// returned.
+func DecodeManifests(r io.Reader) ([]Manifest, error) {
+	reader := yaml.NewYAMLReader(bufio.NewReader(r))
+	var manifests []Manifest
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return manifests, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		manifest := Manifest{Index: len(manifests)}
+		data, err := yaml.ToJSON(doc)
+		if err != nil {
+			manifest.Err = fmt.Errorf("%w: %v", ErrInvalidManifest, err)
+		} else if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+			// Only whitespace and comments.
+			continue
+		} else {
+			manifest.Object, manifest.Err = decodeObject(data)
+		}
+		manifests = append(manifests, manifest)
+	}
+}
+
+func decodeObject(data []byte) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidManifest, err)
+	}
+	if obj.IsList() {
+		return nil, fmt.Errorf("%w: lists are not supported, apply their items as separate documents", ErrInvalidManifest)
+	}
+	if obj.GetName() == "" {
+		return nil, fmt.Errorf("%w: %s has no metadata.name", ErrInvalidManifest, obj.GetKind())
+	}
+	return obj, nil
+}
+
+// MappingFor resolves the resource of obj and settles its namespace: a
+// namespaced object without one is placed in defaultNamespace, falling back
+// to "default", and the namespace of a cluster-scoped object is cleared.
+func (c *Cluster) MappingFor(obj *unstructured.Unstructured, defaultNamespace string) (*meta.RESTMapping, error) {
+	gvk := obj.GroupVersionKind()
+	if gvk.Kind == "" || gvk.Version == "" {
+		return nil, fmt.Errorf("%w: apiVersion and kind are required", ErrInvalidManifest)
+	}
+
+	mapping, err := c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		// The kind may have been installed since discovery was cached.
+		c.Mapper.Reset()
+		mapping, err = c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			if defaultNamespace == "" {
+				defaultNamespace = metav1.NamespaceDefault
+			}
+			obj.SetNamespace(defaultNamespace)
+		}
+	} else {
+		obj.SetNamespace("")
+	}
+	return mapping, nil
+}
+
+// ApplyOptions configure a server-side apply.
+type ApplyOptions struct {
+	FieldManager string
+	// Force takes ownership of fields managed by others instead of failing
+	// with a conflict.
+	Force bool
+	// DryRun has the API server run admission and return the result without
+	// persisting it.
+	DryRun bool
+}
+
+// ApplyResult is the outcome of applying a single object.
+type ApplyResult struct {
+	// Created is set when the object did not exist before.
+	Created bool
+	Object  *unstructured.Unstructured
+	// Diff lists the changes between the live object and the result of the
+	// apply, ignoring server-managed metadata.
+	Diff []DiffEntry
+}
+
+// Apply applies obj with server-side apply and diffs the result against
+// the live object.
+func (c *Cluster) Apply(ctx context.Context, mapping *meta.RESTMapping, obj *unstructured.Unstructured, opts ApplyOptions) (*ApplyResult, error) {
+	resource := c.Dynamic.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+
+	live, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		live = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	applyOpts := metav1.ApplyOptions{FieldManager: opts.FieldManager, Force: opts.Force}
+	if opts.DryRun {
+		applyOpts.DryRun = []string{metav1.DryRunAll}
+	}
+	applied, err := resource.Apply(ctx, obj.GetName(), obj, applyOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	var before map[string]any
+	if live != nil {
+		before = withoutServerFields(live.Object)
+	}
+	after := withoutServerFields(applied.Object)
+	if gvk := mapping.GroupVersionKind; gvk.Group == "" && gvk.Kind == "Secret" {
+		redactSecret(before, after)
+		applied = applied.DeepCopy()
+		redactSecret(nil, applied.Object)
+	}
+	return &ApplyResult{
+		Created: live == nil,
+		Object:  applied,
+		Diff:    Diff(before, after),
+	}, nil
+}
+
+// lastAppliedAnnotation is where kubectl apply keeps the object it applied,
+// values of Secrets included.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// redactSecret masks the values of two Secrets in place, as kubectl diff
+// does, so that the diff shows which keys changed but not their values.
+// Either may be nil.
+func redactSecret(before, after map[string]any) {
+	for _, field := range []string{"data", "stringData"} {
+		b, _ := before[field].(map[string]any)
+		a, _ := after[field].(map[string]any)
+		keys := make(map[string]bool, len(b)+len(a))
+		for key := range b {
+			keys[key] = true
+		}
+		for key := range a {
+			keys[key] = true
+		}
+		for key := range keys {
+			maskValue(b, a, key)
+		}
+	}
+	b, _, _ := unstructured.NestedFieldNoCopy(before, "metadata", "annotations")
+	a, _, _ := unstructured.NestedFieldNoCopy(after, "metadata", "annotations")
+	bAnnotations, _ := b.(map[string]any)
+	aAnnotations, _ := a.(map[string]any)
+	maskValue(bAnnotations, aAnnotations, lastAppliedAnnotation)
+}
+
+// maskValue replaces the value of key in b and a, either of which may be
+// nil, with a mask telling whether it changed.
+func maskValue(b, a map[string]any, key string) {
+	bv, inBefore := b[key]
+	av, inAfter := a[key]
+	if inBefore && inAfter && !reflect.DeepEqual(bv, av) {
+		b[key], a[key] = "*** (before)", "*** (after)"
+		return
+	}
+	if inBefore {
+		b[key] = "***"
+	}
+	if inAfter {
+		a[key] = "***"
+	}
+}
+
+// withoutServerFields returns a copy of obj without the metadata the server
+// maintains, which changes on every write and would drown the diff.
+func withoutServerFields(obj map[string]any) map[string]any {
+	out := (&unstructured.Unstructured{Object: obj}).DeepCopy().Object
+	for _, field := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(out, "metadata", field)
+	}
+	return out
+}
+
+// DiffEntry is a single change between two objects.
+type DiffEntry struct {
+	Op string
+	// Path is the JSON pointer of the changed field.
+	Path   string
+	Before any
+	After  any
+}
+
+// Diff compares two decoded JSON objects. Maps are compared key by key and
+// lists of equal length element by element; a list that changed length is
+// reported as replaced as a whole.
+func Diff(before, after map[string]any) []DiffEntry {
+	var entries []DiffEntry
+	diffMaps("", before, after, &entries)
+	return entries
+}
+
+func diffValues(path string, before, after any, entries *[]DiffEntry) {
+	switch b := before.(type) {
+	case map[string]any:
+		if a, ok := after.(map[string]any); ok {
+			diffMaps(path, b, a, entries)
+			return
+		}
+	case []any:
+		if a, ok := after.([]any); ok && len(a) == len(b) {
+			for i := range b {
+				diffValues(path+"/"+strconv.Itoa(i), b[i], a[i], entries)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(before, after) {
+		*entries = append(*entries, DiffEntry{Op: DiffReplace, Path: path, Before: before, After: after})
+	}
+}
+
+func diffMaps(path string, before, after map[string]any, entries *[]DiffEntry) {
+	keys := make([]string, 0, len(before)+len(after))
+	for key := range before {
+		keys = append(keys, key)
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		child := path + "/" + escapePointer(key)
+		b, inBefore := before[key]
+		a, inAfter := after[key]
+		switch {
+		case !inBefore:
+			*entries = append(*entries, DiffEntry{Op: DiffAdd, Path: child, After: a})
+		case !inAfter:
+			*entries = append(*entries, DiffEntry{Op: DiffRemove, Path: child, Before: b})
+		default:
+			diffValues(child, b, a, entries)
+		}
+	}
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// escapePointer escapes a key for use as a JSON pointer reference token.
+func escapePointer(key string) string {
+	return pointerEscaper.Replace(key)
+}
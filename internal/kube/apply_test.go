@@ -0,0 +1,149 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newApplyCluster returns a cluster holding objects whose API server
+// answers an apply with the applied object as it was sent.
+func newApplyCluster(t *testing.T, objects ...runtime.Object) *Cluster {
+	t.Helper()
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	client.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(action.(k8stesting.PatchAction).GetPatch(), &obj.Object); err != nil {
+			return true, nil, err
+		}
+		return true, obj, nil
+	})
+	return &Cluster{Name: "test", Dynamic: client}
+}
+
+func secret(data map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"data": data}}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Secret")
+	obj.SetNamespace("default")
+	obj.SetName("db")
+	return obj
+}
+
+func secretMapping() *meta.RESTMapping {
+	return &meta.RESTMapping{
+		Resource:         schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+		GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+		Scope:            meta.RESTScopeNamespace,
+	}
+}
+
+func TestApplyRedactsSecrets(t *testing.T) {
+	live := secret(map[string]any{"user": "YWRtaW4=", "password": "b2xk", "old": "b2xk"})
+	live.SetAnnotations(map[string]string{lastAppliedAnnotation: `{"data":{"password":"b2xk"}}`, "team": "db"})
+	cluster := newApplyCluster(t, live)
+	obj := secret(map[string]any{"user": "YWRtaW4=", "password": "bmV3", "token": "dG9rZW4="})
+	obj.Object["stringData"] = map[string]any{"extra": "plain"}
+	obj.SetAnnotations(map[string]string{lastAppliedAnnotation: `{"data":{"password":"bmV3"}}`, "team": "db"})
+
+	result, err := cluster.Apply(context.Background(), secretMapping(), obj, ApplyOptions{FieldManager: "test"})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	got := map[string]DiffEntry{}
+	for _, entry := range result.Diff {
+		got[entry.Path] = entry
+	}
+	want := map[string]DiffEntry{
+		"/data/old":      {Op: DiffRemove, Path: "/data/old", Before: "***"},
+		"/data/password": {Op: DiffReplace, Path: "/data/password", Before: "*** (before)", After: "*** (after)"},
+		"/data/token":    {Op: DiffAdd, Path: "/data/token", After: "***"},
+		"/stringData":    {Op: DiffAdd, Path: "/stringData", After: map[string]any{"extra": "***"}},
+		"/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration": {
+			Op:     DiffReplace,
+			Path:   "/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration",
+			Before: "*** (before)",
+			After:  "*** (after)",
+		},
+	}
+	if len(got) != len(want) {
+		t.Errorf("diff = %+v, want %+v", result.Diff, want)
+	}
+	for path, w := range want {
+		g, ok := got[path]
+		if !ok || g.Op != w.Op || !jsonEqual(g.Before, w.Before) || !jsonEqual(g.After, w.After) {
+			t.Errorf("%s: %+v, want %+v", path, g, w)
+		}
+	}
+	// Neither the diff nor the object returned gives a value away.
+	b, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []string{"b2xk", "bmV3", "dG9rZW4=", "YWRtaW4=", "plain"} {
+		if strings.Contains(string(b), value) {
+			t.Errorf("result contains %q: %s", value, b)
+		}
+	}
+	if team := result.Object.GetAnnotations()["team"]; team != "db" {
+		t.Errorf("annotation team = %q, want other annotations kept", team)
+	}
+	if value, _, _ := unstructured.NestedString(result.Object.Object, "data", "password"); value != "***" {
+		t.Errorf("applied password = %q, want it masked", value)
+	}
+}
+
+func TestApplyCreatedSecret(t *testing.T) {
+	cluster := newApplyCluster(t)
+	result, err := cluster.Apply(context.Background(), secretMapping(), secret(map[string]any{"password": "c2VjcmV0"}), ApplyOptions{})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if !result.Created {
+		t.Error("Created = false for a new secret")
+	}
+	var data any
+	for _, entry := range result.Diff {
+		if entry.Path == "/data" {
+			data = entry.After
+		}
+	}
+	if !jsonEqual(data, map[string]any{"password": "***"}) {
+		t.Errorf("created data = %v, want it masked", data)
+	}
+}
+
+func TestApplyDoesNotRedactOtherKinds(t *testing.T) {
+	live := configMap("db", "1")
+	live.Object["data"] = map[string]any{"user": "admin"}
+	cluster := newApplyCluster(t, live)
+	obj := configMap("db", "")
+	obj.Object["data"] = map[string]any{"user": "root"}
+
+	mapping := &meta.RESTMapping{
+		Resource:         configMapsResource,
+		GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+		Scope:            meta.RESTScopeNamespace,
+	}
+	result, err := cluster.Apply(context.Background(), mapping, obj, ApplyOptions{})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(result.Diff) != 1 || result.Diff[0].Before != "admin" || result.Diff[0].After != "root" {
+		t.Errorf("diff = %+v, want the plain values", result.Diff)
+	}
+}
+
+func jsonEqual(a, b any) bool {
+	ja, _ := json.Marshal(a)
+	jb, _ := json.Marshal(b)
+	return string(ja) == string(jb)
+}
@@ -0,0 +1,256 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// mirrorPodAnnotation marks static pods mirrored from a kubelet manifest.
+// They cannot be evicted through the API server.
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// maxDrainRetryInterval caps the backoff between eviction attempts.
+const maxDrainRetryInterval = time.Minute
+
+// ErrDrainIncomplete is returned when a drain times out with pods left on
+// the node.
+var ErrDrainIncomplete = errors.New("drain did not complete")
+
+// DrainOptions configure a node drain.
+type DrainOptions struct {
+	// GracePeriodSeconds overrides the termination grace period of evicted
+	// pods; nil keeps each pod's own.
+	GracePeriodSeconds *int64
+	// Force evicts pods not managed by a controller, which are not
+	// recreated elsewhere.
+	Force bool
+	// DeleteEmptyDirData evicts pods using emptyDir volumes, losing the data.
+	DeleteEmptyDirData bool
+	// Timeout bounds the whole drain.
+	Timeout time.Duration
+	// RetryInterval is the initial delay between eviction rounds. It doubles
+	// while evictions are refused, up to maxDrainRetryInterval.
+	RetryInterval time.Duration
+}
+
+// BlockingPod is a pod whose eviction was refused.
+type BlockingPod struct {
+	Namespace string
+	Name      string
+	Reason    string
+}
+
+// DrainStatus reports the progress of a drain.
+type DrainStatus struct {
+	Node string
+	// Total is the number of pods to evict.
+	Total int
+	// Evicted is the number of those pods gone from the node.
+	Evicted int
+	// Blocking lists the pods refused in the last eviction round.
+	Blocking []BlockingPod
+}
+
+// SetUnschedulable cordons or uncordons a node.
+func (c *Cluster) SetUnschedulable(ctx context.Context, node string, unschedulable bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := c.Clientset.CoreV1().Nodes().Patch(ctx, node, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
+// PlanDrain lists the pods a drain of node would evict. DaemonSet and mirror
+// pods are skipped. Pods that may only be evicted with Force or
+// DeleteEmptyDirData make it fail with ErrInvalidOperation naming them, so
+// a drain is rejected before anything is evicted.
+func (c *Cluster) PlanDrain(ctx context.Context, node string, opts DrainOptions) ([]corev1.Pod, error) {
+	if _, err := c.Clientset.CoreV1().Nodes().Get(ctx, node, metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	pods, err := c.podsOnNode(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	var evict []corev1.Pod
+	var refused []string
+	for _, pod := range pods {
+		if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+			continue
+		}
+		controller := metav1.GetControllerOf(&pod)
+		if controller != nil && controller.Kind == "DaemonSet" {
+			continue
+		}
+
+		finished := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+		switch {
+		case controller == nil && !finished && !opts.Force:
+			refused = append(refused, fmt.Sprintf("%s/%s is not managed by a controller", pod.Namespace, pod.Name))
+		case usesEmptyDir(pod) && !finished && !opts.DeleteEmptyDirData:
+			refused = append(refused, fmt.Sprintf("%s/%s uses emptyDir volumes", pod.Namespace, pod.Name))
+		default:
+			evict = append(evict, pod)
+		}
+	}
+	if len(refused) > 0 {
+		return nil, fmt.Errorf("%w: cannot drain node %s: %s", ErrInvalidOperation, node, strings.Join(refused, "; "))
+	}
+	return evict, nil
+}
+
+// Drain evicts the pods planned for node through the eviction API until
+// they are gone. Evictions refused by a PodDisruptionBudget, or failing
+// otherwise, are retried with backoff. The node is expected to be cordoned
+// already. report is called after every eviction round.
+func (c *Cluster) Drain(ctx context.Context, node string, opts DrainOptions, report func(*DrainStatus)) error {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	pods, err := c.PlanDrain(ctx, node, opts)
+	if err != nil {
+		return err
+	}
+
+	status := &DrainStatus{Node: node, Total: len(pods)}
+	pending := make(map[types.UID]corev1.Pod, len(pods))
+	for _, pod := range pods {
+		pending[pod.UID] = pod
+	}
+	evicting := map[types.UID]bool{}
+	interval := opts.RetryInterval
+
+	for {
+		status.Blocking = nil
+		for _, pod := range sortedPods(pending) {
+			if evicting[pod.UID] {
+				continue
+			}
+			err := c.evict(ctx, pod, opts.GracePeriodSeconds)
+			switch {
+			case err == nil, apierrors.IsNotFound(err):
+				evicting[pod.UID] = true
+			case apierrors.IsTooManyRequests(err):
+				status.Blocking = append(status.Blocking, BlockingPod{
+					Namespace: pod.Namespace,
+					Name:      pod.Name,
+					Reason:    "disruption budget: " + err.Error(),
+				})
+			case ctx.Err() != nil:
+				return drainIncomplete(status, opts.Timeout)
+			default:
+				status.Blocking = append(status.Blocking, BlockingPod{
+					Namespace: pod.Namespace,
+					Name:      pod.Name,
+					Reason:    err.Error(),
+				})
+			}
+		}
+
+		remaining, err := c.podsOnNode(ctx, node)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if err == nil {
+			present := make(map[types.UID]bool, len(remaining))
+			for _, pod := range remaining {
+				present[pod.UID] = true
+			}
+			for uid := range pending {
+				if !present[uid] {
+					delete(pending, uid)
+				}
+			}
+		}
+		status.Evicted = status.Total - len(pending)
+		report(status)
+		if len(pending) == 0 {
+			return nil
+		}
+
+		// Back off while evictions are refused; poll at the base interval
+		// while evicted pods terminate.
+		if len(status.Blocking) > 0 {
+			interval = min(interval*2, maxDrainRetryInterval)
+		} else {
+			interval = opts.RetryInterval
+		}
+		select {
+		case <-ctx.Done():
+			return drainIncomplete(status, opts.Timeout)
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (c *Cluster) evict(ctx context.Context, pod corev1.Pod, gracePeriodSeconds *int64) error {
+	return c.Clientset.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+		DeleteOptions: &metav1.DeleteOptions{
+			GracePeriodSeconds: gracePeriodSeconds,
+			// Do not evict a pod that was replaced under the same name.
+			Preconditions: &metav1.Preconditions{UID: &pod.UID},
+		},
+	})
+}
+
+func drainIncomplete(status *DrainStatus, timeout time.Duration) error {
+	blocking := make([]string, 0, len(status.Blocking))
+	for _, pod := range status.Blocking {
+		blocking = append(blocking, fmt.Sprintf("%s/%s (%s)", pod.Namespace, pod.Name, pod.Reason))
+	}
+	err := fmt.Errorf("%w within %s: %d of %d pods evicted from node %s",
+		ErrDrainIncomplete, timeout, status.Evicted, status.Total, status.Node)
+	if len(blocking) > 0 {
+		err = fmt.Errorf("%w; blocked by %s", err, strings.Join(blocking, ", "))
+	}
+	return err
+}
+
+func (c *Cluster) podsOnNode(ctx context.Context, node string) ([]corev1.Pod, error) {
+	list, err := c.Clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func usesEmptyDir(pod corev1.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedPods returns the pods ordered by namespace and name, so eviction
+// rounds and the reported blocking pods are stable.
+func sortedPods(pods map[types.UID]corev1.Pod) []corev1.Pod {
+	out := make([]corev1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		out = append(out, pod)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Namespace != out[j].Namespace {
+			return out[i].Namespace < out[j].Namespace
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
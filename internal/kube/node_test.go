@@ -0,0 +1,170 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// drainPod returns a running pod on node-1, owned by a controller of kind
+// unless kind is empty.
+func drainPod(name, kind string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: types.UID("uid-" + name)},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if kind != "" {
+		controller := true
+		pod.OwnerReferences = []metav1.OwnerReference{{Kind: kind, Name: name + "-owner", Controller: &controller}}
+	}
+	return pod
+}
+
+// evictions is an eviction API refusing each pod the number of times set
+// in refusals, as a PodDisruptionBudget does, before evicting it.
+type evictions struct {
+	mu       sync.Mutex
+	refusals map[string]int
+	requests []*policyv1.Eviction
+}
+
+// newDrainCluster returns a cluster holding node-1 and pods whose evictions
+// are answered by e.
+func newDrainCluster(e *evictions, pods ...*corev1.Pod) *Cluster {
+	objects := []runtime.Object{&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}}
+	for _, pod := range pods {
+		objects = append(objects, pod)
+	}
+	client := fake.NewClientset(objects...)
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.requests = append(e.requests, eviction)
+		if e.refusals[eviction.Name] != 0 {
+			e.refusals[eviction.Name]--
+			return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+		}
+		gvr := corev1.SchemeGroupVersion.WithResource("pods")
+		return true, nil, client.Tracker().Delete(gvr, eviction.Namespace, eviction.Name)
+	})
+	return &Cluster{Name: "test", Clientset: client}
+}
+
+func TestPlanDrain(t *testing.T) {
+	mirror := drainPod("mirror", "Node")
+	mirror.Annotations = map[string]string{mirrorPodAnnotation: "hash"}
+	scratch := drainPod("scratch", "ReplicaSet")
+	scratch.Spec.Volumes = []corev1.Volume{{Name: "tmp", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+	finished := drainPod("job-done", "")
+	finished.Status.Phase = corev1.PodSucceeded
+	cluster := newDrainCluster(&evictions{}, drainPod("web", "ReplicaSet"), drainPod("agent", "DaemonSet"),
+		mirror, drainPod("bare", ""), scratch, finished)
+	ctx := context.Background()
+
+	_, err := cluster.PlanDrain(ctx, "node-1", DrainOptions{})
+	if !errors.Is(err, ErrInvalidOperation) || !strings.Contains(err.Error(), "default/bare is not managed") ||
+		!strings.Contains(err.Error(), "default/scratch uses emptyDir") {
+		t.Errorf("PlanDrain = %v, want the unmanaged and emptyDir pods refused", err)
+	}
+
+	pods, err := cluster.PlanDrain(ctx, "node-1", DrainOptions{Force: true, DeleteEmptyDirData: true})
+	if err != nil {
+		t.Fatalf("PlanDrain: %v", err)
+	}
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	// DaemonSet and mirror pods stay.
+	if got := strings.Join(names, ","); got != "bare,job-done,scratch,web" {
+		t.Errorf("planned %s, want bare,job-done,scratch,web", got)
+	}
+
+	if _, err := cluster.PlanDrain(ctx, "node-2", DrainOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("PlanDrain of a missing node = %v, want not found", err)
+	}
+}
+
+func TestDrainRetriesDisruptionBudget(t *testing.T) {
+	e := &evictions{refusals: map[string]int{"db": 2}}
+	cluster := newDrainCluster(e, drainPod("db", "StatefulSet"), drainPod("web", "ReplicaSet"))
+	grace := int64(5)
+
+	var reports []DrainStatus
+	err := cluster.Drain(context.Background(), "node-1", DrainOptions{
+		GracePeriodSeconds: &grace,
+		Timeout:            5 * time.Second,
+		RetryInterval:      time.Millisecond,
+	}, func(status *DrainStatus) {
+		reports = append(reports, *status)
+	})
+	if err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+
+	if len(reports) != 3 {
+		t.Fatalf("%d reports, want one per round: %+v", len(reports), reports)
+	}
+	first := reports[0]
+	if first.Total != 2 || first.Evicted != 1 || len(first.Blocking) != 1 || first.Blocking[0].Name != "db" ||
+		!strings.HasPrefix(first.Blocking[0].Reason, "disruption budget: ") {
+		t.Errorf("first round = %+v, want web evicted and db blocked by its budget", first)
+	}
+	if last := reports[len(reports)-1]; last.Evicted != 2 || last.Blocking != nil {
+		t.Errorf("last round = %+v, want both pods evicted", last)
+	}
+
+	// Evicted pods are not evicted again; refused ones are retried.
+	evicted := map[string]int{}
+	for _, eviction := range e.requests {
+		evicted[eviction.Name]++
+		opts := eviction.DeleteOptions
+		if opts == nil || opts.GracePeriodSeconds == nil || *opts.GracePeriodSeconds != 5 ||
+			opts.Preconditions == nil || *opts.Preconditions.UID != types.UID("uid-"+eviction.Name) {
+			t.Errorf("eviction of %s with %+v, want the grace period and the pod's UID as precondition", eviction.Name, opts)
+		}
+	}
+	if evicted["web"] != 1 || evicted["db"] != 3 {
+		t.Errorf("evictions = %v, want web once and db three times", evicted)
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	// A negative count never runs out.
+	e := &evictions{refusals: map[string]int{"db": -1}}
+	cluster := newDrainCluster(e, drainPod("db", "StatefulSet"), drainPod("web", "ReplicaSet"))
+
+	var last DrainStatus
+	err := cluster.Drain(context.Background(), "node-1", DrainOptions{
+		Timeout:       50 * time.Millisecond,
+		RetryInterval: time.Millisecond,
+	}, func(status *DrainStatus) {
+		last = *status
+	})
+	if !errors.Is(err, ErrDrainIncomplete) {
+		t.Fatalf("Drain = %v, want ErrDrainIncomplete", err)
+	}
+	if !strings.Contains(err.Error(), "1 of 2 pods evicted") || !strings.Contains(err.Error(), "default/db (disruption budget: ") {
+		t.Errorf("error %q, want the progress and the blocking pod", err)
+	}
+	if last.Evicted != 1 {
+		t.Errorf("last report = %+v, want web evicted", last)
+	}
+}
@@ -0,0 +1,14 @@
+package kube
+
+import (
+	"context"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodLogs opens the log of a container. The caller closes the stream; with
+// opts.Follow it stays open until the container stops or ctx is done.
+func (c *Cluster) PodLogs(ctx context.Context, namespace, pod string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return c.Clientset.CoreV1().Pods(namespace).GetLogs(pod, opts).Stream(ctx)
+}
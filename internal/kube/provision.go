@@ -0,0 +1,513 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// Metadata the server records on the namespaces it provisions and the
+// objects it creates in them.
+const (
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "iu-k8s"
+
+	provisioningPrefix        = "iu-k8s.linecorp.com/"
+	teamLabel                 = provisioningPrefix + "team"
+	templateAnnotation        = provisioningPrefix + "template"
+	groupsAnnotation          = provisioningPrefix + "groups"
+	provisionedByAnnotation   = provisioningPrefix + "provisioned-by"
+	provisionedAtAnnotation   = provisioningPrefix + "provisioned-at"
+	provisionedObjsAnnotation = provisioningPrefix + "provisioned-resources"
+)
+
+// Kinds of the objects a template creates besides the namespace.
+const (
+	KindResourceQuota = "ResourceQuota"
+	KindLimitRange    = "LimitRange"
+	KindNetworkPolicy = "NetworkPolicy"
+	KindRoleBinding   = "RoleBinding"
+)
+
+var (
+	// ErrTemplateNotFound is returned for unknown namespace templates.
+	ErrTemplateNotFound = errors.New("namespace template not found")
+	// ErrInvalidProvisioning is returned for provisioning requests a
+	// template cannot be applied with.
+	ErrInvalidProvisioning = errors.New("invalid provisioning request")
+	// ErrNotProvisioned is returned when deprovisioning a namespace the
+	// server did not provision.
+	ErrNotProvisioned = errors.New("namespace was not provisioned by this server")
+)
+
+// NamespaceTemplate is a bundle of the objects a provisioned namespace
+// starts with.
+type NamespaceTemplate struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Labels and Annotations are set on the namespace, below those of the
+	// request.
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ResourceQuota and LimitRange are created named after the template.
+	ResourceQuota   *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+	LimitRange      *corev1.LimitRangeSpec    `json:"limitRange,omitempty"`
+	NetworkPolicies []TemplateNetworkPolicy   `json:"networkPolicies,omitempty"`
+	RoleBindings    []TemplateRoleBinding     `json:"roleBindings,omitempty"`
+}
+
+// TemplateNetworkPolicy is a NetworkPolicy of a template.
+type TemplateNetworkPolicy struct {
+	Name string                         `json:"name"`
+	Spec networkingv1.NetworkPolicySpec `json:"spec"`
+}
+
+// TemplateRoleBinding binds a ClusterRole to the groups of the team a
+// namespace is provisioned for.
+type TemplateRoleBinding struct {
+	// Name defaults to the name of the ClusterRole.
+	Name        string `json:"name,omitempty"`
+	ClusterRole string `json:"clusterRole"`
+}
+
+// NamespaceTemplates are the templates namespaces may be provisioned from,
+// sorted by name.
+type NamespaceTemplates []NamespaceTemplate
+
+// LoadNamespaceTemplates reads a YAML or JSON file holding a list of
+// templates under "templates" and checks them.
+func LoadNamespaceTemplates(path string) (NamespaceTemplates, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read namespace templates: %w", err)
+	}
+	var file struct {
+		Templates NamespaceTemplates `json:"templates"`
+	}
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("parse namespace templates: %w", err)
+	}
+
+	var errs []error
+	seen := map[string]bool{}
+	for i := range file.Templates {
+		t := &file.Templates[i]
+		if seen[t.Name] {
+			errs = append(errs, fmt.Errorf("template %q: defined more than once", t.Name))
+		}
+		seen[t.Name] = true
+		if err := t.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("template %q: %w", t.Name, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid namespace templates:\n%w", err)
+	}
+	sort.Slice(file.Templates, func(i, j int) bool { return file.Templates[i].Name < file.Templates[j].Name })
+	return file.Templates, nil
+}
+
+// Get returns the template called name.
+func (ts NamespaceTemplates) Get(name string) (*NamespaceTemplate, error) {
+	for i := range ts {
+		if ts[i].Name == name {
+			return &ts[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+}
+
+// Resources lists the objects a namespace provisioned from t starts with,
+// in the order they are created.
+func (t *NamespaceTemplate) Resources() []ProvisionedResource {
+	var resources []ProvisionedResource
+	if t.ResourceQuota != nil {
+		resources = append(resources, ProvisionedResource{Kind: KindResourceQuota, Name: t.Name})
+	}
+	if t.LimitRange != nil {
+		resources = append(resources, ProvisionedResource{Kind: KindLimitRange, Name: t.Name})
+	}
+	for _, np := range t.NetworkPolicies {
+		resources = append(resources, ProvisionedResource{Kind: KindNetworkPolicy, Name: np.Name})
+	}
+	for _, rb := range t.RoleBindings {
+		resources = append(resources, ProvisionedResource{Kind: KindRoleBinding, Name: rb.Name})
+	}
+	return resources
+}
+
+// validate checks the names of a template and fills in the names of its
+// role bindings.
+func (t *NamespaceTemplate) validate() error {
+	var errs []error
+	invalid := func(field string, msgs []string) {
+		if len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("%s: %s", field, strings.Join(msgs, "; ")))
+		}
+	}
+	invalid("name", validation.IsDNS1123Subdomain(t.Name))
+	errs = append(errs, metadataErrors(t.Labels, t.Annotations)...)
+
+	names := map[string]bool{}
+	for _, np := range t.NetworkPolicies {
+		invalid("networkPolicies: name", validation.IsDNS1123Subdomain(np.Name))
+		if names[np.Name] {
+			errs = append(errs, fmt.Errorf("networkPolicies: %q is defined more than once", np.Name))
+		}
+		names[np.Name] = true
+	}
+	clear(names)
+	for i := range t.RoleBindings {
+		rb := &t.RoleBindings[i]
+		if rb.ClusterRole == "" {
+			errs = append(errs, errors.New("roleBindings: clusterRole is required"))
+			continue
+		}
+		if rb.Name == "" {
+			rb.Name = rb.ClusterRole
+		}
+		invalid("roleBindings: name", validation.IsDNS1123Subdomain(rb.Name))
+		if names[rb.Name] {
+			errs = append(errs, fmt.Errorf("roleBindings: %q is defined more than once", rb.Name))
+		}
+		names[rb.Name] = true
+	}
+	return errors.Join(errs...)
+}
+
+// metadataErrors checks labels and annotations given for a namespace. The
+// keys the server records its own metadata under are reserved.
+func metadataErrors(labels, annotations map[string]string) []error {
+	var errs []error
+	for key, value := range labels {
+		msgs := append(validation.IsQualifiedName(key), validation.IsValidLabelValue(value)...)
+		if len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("label %q: %s", key, strings.Join(msgs, "; ")))
+		}
+	}
+	for key := range annotations {
+		if msgs := validation.IsQualifiedName(key); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("annotation %q: %s", key, strings.Join(msgs, "; ")))
+		}
+	}
+	for _, m := range []map[string]string{labels, annotations} {
+		for key := range m {
+			if strings.HasPrefix(key, provisioningPrefix) || key == managedByLabel {
+				errs = append(errs, fmt.Errorf("%q: reserved for the server's own metadata", key))
+			}
+		}
+	}
+	return errs
+}
+
+// ProvisionRequest describes a namespace to provision from a template.
+type ProvisionRequest struct {
+	Name string
+	Team string
+	// Groups are bound to the ClusterRoles of the template's role bindings.
+	Groups []string
+	// Labels and Annotations are set on the namespace, over those of the
+	// template.
+	Labels      map[string]string
+	Annotations map[string]string
+	// ProvisionedBy is the principal the namespace is recorded for.
+	ProvisionedBy string
+}
+
+// Validate checks req can be provisioned from t.
+func (req ProvisionRequest) Validate(t *NamespaceTemplate) error {
+	var errs []error
+	if msgs := validation.IsDNS1123Label(req.Name); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("name: %s", strings.Join(msgs, "; ")))
+	}
+	if req.Name == metav1.NamespaceDefault || strings.HasPrefix(req.Name, "kube-") {
+		errs = append(errs, fmt.Errorf("name: %q is reserved", req.Name))
+	}
+	if msgs := validation.IsDNS1123Label(req.Team); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("team: %s", strings.Join(msgs, "; ")))
+	}
+	if len(t.RoleBindings) > 0 && len(req.Groups) == 0 {
+		errs = append(errs, fmt.Errorf("groups: template %s binds roles and requires at least one group", t.Name))
+	}
+	for _, group := range req.Groups {
+		if strings.TrimSpace(group) == "" || strings.Contains(group, ",") {
+			errs = append(errs, fmt.Errorf("groups: %q is not a valid group name", group))
+		}
+	}
+	errs = append(errs, metadataErrors(req.Labels, req.Annotations)...)
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidProvisioning, err)
+	}
+	return nil
+}
+
+// ProvisionedResource is an object created for a provisioned namespace.
+type ProvisionedResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// Provisioning is the record of a provisioned namespace, kept in its
+// annotations.
+type Provisioning struct {
+	Namespace     string
+	Template      string
+	Team          string
+	Groups        []string
+	ProvisionedBy string
+	ProvisionedAt time.Time
+	// Resources are the objects created in the namespace, in the order
+	// they were created.
+	Resources []ProvisionedResource
+}
+
+// ProvisionNamespace creates a namespace and the objects of template t in
+// it. The namespace records what is created, so that DeprovisionNamespace
+// removes exactly that. If any object fails to be created, those created
+// before it are deleted along with the namespace.
+func (c *Cluster) ProvisionNamespace(ctx context.Context, t *NamespaceTemplate, req ProvisionRequest) (*Provisioning, error) {
+	if err := req.Validate(t); err != nil {
+		return nil, err
+	}
+
+	p := &Provisioning{
+		Namespace:     req.Name,
+		Template:      t.Name,
+		Team:          req.Team,
+		Groups:        req.Groups,
+		ProvisionedBy: req.ProvisionedBy,
+		ProvisionedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	creates := c.templateObjects(t, req)
+	for _, create := range creates {
+		p.Resources = append(p.Resources, create.resource)
+	}
+
+	ns, err := c.Clientset.CoreV1().Namespaces().Create(ctx, provisionedNamespace(t, req, p), metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i, create := range creates {
+		if err := create.fn(ctx); err != nil {
+			err = fmt.Errorf("create %s %s: %w", create.resource.Kind, create.resource.Name, err)
+			if rbErr := c.rollback(context.WithoutCancel(ctx), ns, p.Resources[:i]); rbErr != nil {
+				return nil, errors.Join(err, fmt.Errorf("roll back namespace %s: %w", ns.Name, rbErr))
+			}
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// GetNamespaceProvisioning returns the record of a provisioned namespace,
+// or ErrNotProvisioned for other namespaces.
+func (c *Cluster) GetNamespaceProvisioning(ctx context.Context, namespace string) (*Provisioning, error) {
+	ns, err := c.Clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return provisioningOf(ns)
+}
+
+// DeprovisionNamespace deletes the objects recorded on a provisioned
+// namespace, then the namespace. Recorded objects that are gone or were
+// replaced by objects the server did not create are skipped.
+func (c *Cluster) DeprovisionNamespace(ctx context.Context, namespace string) (*Provisioning, error) {
+	ns, err := c.Clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	p, err := provisioningOf(ns)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.rollback(ctx, ns, p.Resources); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// rollback deletes resources in reverse order of creation, then the
+// namespace itself.
+func (c *Cluster) rollback(ctx context.Context, ns *corev1.Namespace, resources []ProvisionedResource) error {
+	var errs []error
+	for _, res := range slices.Backward(resources) {
+		if err := c.deleteProvisioned(ctx, ns.Name, res); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("delete %s %s: %w", res.Kind, res.Name, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	// The precondition keeps a namespace recreated in the meantime.
+	err := c.Clientset.CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &ns.UID},
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// deleteProvisioned deletes an object recorded on a namespace if it is
+// still the one the server created.
+func (c *Cluster) deleteProvisioned(ctx context.Context, namespace string, res ProvisionedResource) error {
+	var (
+		obj metav1.Object
+		err error
+		del func(context.Context, string, metav1.DeleteOptions) error
+	)
+	switch res.Kind {
+	case KindResourceQuota:
+		client := c.Clientset.CoreV1().ResourceQuotas(namespace)
+		obj, err = client.Get(ctx, res.Name, metav1.GetOptions{})
+		del = client.Delete
+	case KindLimitRange:
+		client := c.Clientset.CoreV1().LimitRanges(namespace)
+		obj, err = client.Get(ctx, res.Name, metav1.GetOptions{})
+		del = client.Delete
+	case KindNetworkPolicy:
+		client := c.Clientset.NetworkingV1().NetworkPolicies(namespace)
+		obj, err = client.Get(ctx, res.Name, metav1.GetOptions{})
+		del = client.Delete
+	case KindRoleBinding:
+		client := c.Clientset.RbacV1().RoleBindings(namespace)
+		obj, err = client.Get(ctx, res.Name, metav1.GetOptions{})
+		del = client.Delete
+	default:
+		return fmt.Errorf("unknown kind %s", res.Kind)
+	}
+	if err != nil {
+		return err
+	}
+	if obj.GetLabels()[managedByLabel] != managedByValue {
+		return nil
+	}
+	uid := obj.GetUID()
+	err = del(ctx, res.Name, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &uid}})
+	if apierrors.IsConflict(err) {
+		// Replaced since it was read: no longer the object the server
+		// created.
+		return nil
+	}
+	return err
+}
+
+// templateCreate creates one object of a template.
+type templateCreate struct {
+	resource ProvisionedResource
+	fn       func(context.Context) error
+}
+
+// templateObjects lists the creates of the objects of t in the namespace
+// of req, in the order they run.
+func (c *Cluster) templateObjects(t *NamespaceTemplate, req ProvisionRequest) []templateCreate {
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: req.Name,
+			Labels:    map[string]string{managedByLabel: managedByValue, teamLabel: req.Team},
+		}
+	}
+	opts := metav1.CreateOptions{}
+
+	var creates []templateCreate
+	add := func(kind, name string, fn func(context.Context) error) {
+		creates = append(creates, templateCreate{resource: ProvisionedResource{Kind: kind, Name: name}, fn: fn})
+	}
+	if t.ResourceQuota != nil {
+		obj := &corev1.ResourceQuota{ObjectMeta: meta(t.Name), Spec: *t.ResourceQuota.DeepCopy()}
+		add(KindResourceQuota, obj.Name, func(ctx context.Context) error {
+			_, err := c.Clientset.CoreV1().ResourceQuotas(req.Name).Create(ctx, obj, opts)
+			return err
+		})
+	}
+	if t.LimitRange != nil {
+		obj := &corev1.LimitRange{ObjectMeta: meta(t.Name), Spec: *t.LimitRange.DeepCopy()}
+		add(KindLimitRange, obj.Name, func(ctx context.Context) error {
+			_, err := c.Clientset.CoreV1().LimitRanges(req.Name).Create(ctx, obj, opts)
+			return err
+		})
+	}
+	for _, np := range t.NetworkPolicies {
+		obj := &networkingv1.NetworkPolicy{ObjectMeta: meta(np.Name), Spec: *np.Spec.DeepCopy()}
+		add(KindNetworkPolicy, obj.Name, func(ctx context.Context) error {
+			_, err := c.Clientset.NetworkingV1().NetworkPolicies(req.Name).Create(ctx, obj, opts)
+			return err
+		})
+	}
+	for _, rb := range t.RoleBindings {
+		obj := &rbacv1.RoleBinding{
+			ObjectMeta: meta(rb.Name),
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: rb.ClusterRole},
+		}
+		for _, group := range req.Groups {
+			obj.Subjects = append(obj.Subjects, rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: group})
+		}
+		add(KindRoleBinding, obj.Name, func(ctx context.Context) error {
+			_, err := c.Clientset.RbacV1().RoleBindings(req.Name).Create(ctx, obj, opts)
+			return err
+		})
+	}
+	return creates
+}
+
+// provisionedNamespace builds the namespace of req with the record of p.
+func provisionedNamespace(t *NamespaceTemplate, req ProvisionRequest, p *Provisioning) *corev1.Namespace {
+	labels := map[string]string{}
+	annotations := map[string]string{}
+	for _, m := range []struct{ from, to map[string]string }{
+		{t.Labels, labels}, {req.Labels, labels}, {t.Annotations, annotations}, {req.Annotations, annotations},
+	} {
+		for key, value := range m.from {
+			m.to[key] = value
+		}
+	}
+
+	resources, _ := json.Marshal(p.Resources)
+	labels[managedByLabel] = managedByValue
+	labels[teamLabel] = p.Team
+	annotations[templateAnnotation] = p.Template
+	annotations[groupsAnnotation] = strings.Join(p.Groups, ",")
+	annotations[provisionedByAnnotation] = p.ProvisionedBy
+	annotations[provisionedAtAnnotation] = p.ProvisionedAt.Format(time.RFC3339)
+	annotations[provisionedObjsAnnotation] = string(resources)
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: p.Namespace, Labels: labels, Annotations: annotations}}
+}
+
+// provisioningOf reads the record of a provisioned namespace.
+func provisioningOf(ns *corev1.Namespace) (*Provisioning, error) {
+	recorded, ok := ns.Annotations[provisionedObjsAnnotation]
+	if !ok || ns.Labels[managedByLabel] != managedByValue {
+		return nil, fmt.Errorf("%w: %s", ErrNotProvisioned, ns.Name)
+	}
+	p := &Provisioning{
+		Namespace:     ns.Name,
+		Template:      ns.Annotations[templateAnnotation],
+		Team:          ns.Labels[teamLabel],
+		ProvisionedBy: ns.Annotations[provisionedByAnnotation],
+	}
+	if groups := ns.Annotations[groupsAnnotation]; groups != "" {
+		p.Groups = strings.Split(groups, ",")
+	}
+	if err := json.Unmarshal([]byte(recorded), &p.Resources); err != nil {
+		return nil, fmt.Errorf("namespace %s: malformed %s annotation: %w", ns.Name, provisionedObjsAnnotation, err)
+	}
+	// A malformed time is left zero rather than hiding the record.
+	p.ProvisionedAt, _ = time.Parse(time.RFC3339, ns.Annotations[provisionedAtAnnotation])
+	return p, nil
+}
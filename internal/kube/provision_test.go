@@ -0,0 +1,231 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// provisionTemplate returns a template creating one object of each kind.
+func provisionTemplate(t *testing.T) *NamespaceTemplate {
+	t.Helper()
+	tmpl := &NamespaceTemplate{
+		Name: "standard",
+		ResourceQuota: &corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{
+			corev1.ResourceRequestsCPU: resource.MustParse("4"),
+		}},
+		LimitRange:      &corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypeContainer}}},
+		NetworkPolicies: []TemplateNetworkPolicy{{Name: "deny-ingress"}},
+		RoleBindings:    []TemplateRoleBinding{{ClusterRole: "edit"}},
+	}
+	if err := tmpl.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	return tmpl
+}
+
+func provisionRequest() ProvisionRequest {
+	return ProvisionRequest{Name: "team-a", Team: "team-a", Groups: []string{"team-a-devs"}, ProvisionedBy: "alice"}
+}
+
+// newProvisionCluster returns a cluster whose API assigns UIDs on create and
+// checks the UID preconditions of deletes, as the API server does.
+func newProvisionCluster() (*Cluster, *fake.Clientset) {
+	client := fake.NewClientset()
+	uids := 0
+	client.PrependReactor("create", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		obj, err := meta.Accessor(action.(k8stesting.CreateAction).GetObject())
+		if err != nil {
+			return false, nil, err
+		}
+		uids++
+		obj.SetUID(types.UID(fmt.Sprintf("uid-%d", uids)))
+		return false, nil, nil
+	})
+	client.PrependReactor("delete", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		del := action.(k8stesting.DeleteAction)
+		precondition := del.GetDeleteOptions().Preconditions
+		if precondition == nil || precondition.UID == nil {
+			return false, nil, nil
+		}
+		current, err := client.Tracker().Get(action.GetResource(), action.GetNamespace(), del.GetName())
+		if err != nil {
+			return false, nil, nil
+		}
+		obj, err := meta.Accessor(current)
+		if err != nil {
+			return false, nil, err
+		}
+		if obj.GetUID() != *precondition.UID {
+			return true, nil, apierrors.NewConflict(action.GetResource().GroupResource(), del.GetName(),
+				fmt.Errorf("precondition failed: UID in precondition: %s, UID in object meta: %s", *precondition.UID, obj.GetUID()))
+		}
+		return false, nil, nil
+	})
+	return &Cluster{Name: "test", Clientset: client}, client
+}
+
+// deletes lists the resources deleted through client, in order.
+func deletes(client *fake.Clientset) []string {
+	var resources []string
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "delete" {
+			resources = append(resources, action.GetResource().Resource)
+		}
+	}
+	return resources
+}
+
+func TestProvisionNamespaceRollsBack(t *testing.T) {
+	cluster, client := newProvisionCluster()
+	client.PrependReactor("create", "rolebindings", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(action.GetResource().GroupResource(), "edit", errors.New("escalation"))
+	})
+	ctx := context.Background()
+
+	_, err := cluster.ProvisionNamespace(ctx, provisionTemplate(t), provisionRequest())
+	if !apierrors.IsForbidden(err) || !strings.Contains(err.Error(), "create RoleBinding edit") {
+		t.Fatalf("ProvisionNamespace = %v, want the failed create", err)
+	}
+
+	// What was created is deleted in reverse order, then the namespace.
+	want := []string{"networkpolicies", "limitranges", "resourcequotas", "namespaces"}
+	if got := deletes(client); !slices.Equal(got, want) {
+		t.Errorf("deleted %v, want %v", got, want)
+	}
+	if _, err := client.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("namespace left behind: %v", err)
+	}
+	if _, err := client.CoreV1().ResourceQuotas("team-a").Get(ctx, "standard", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("resource quota left behind: %v", err)
+	}
+	if _, err := client.NetworkingV1().NetworkPolicies("team-a").Get(ctx, "deny-ingress", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("network policy left behind: %v", err)
+	}
+}
+
+func TestDeprovisionNamespaceSkipsForeignObjects(t *testing.T) {
+	cluster, client := newProvisionCluster()
+	ctx := context.Background()
+	p, err := cluster.ProvisionNamespace(ctx, provisionTemplate(t), provisionRequest())
+	if err != nil {
+		t.Fatalf("ProvisionNamespace: %v", err)
+	}
+	if len(p.Resources) != 4 {
+		t.Fatalf("provisioned %+v, want 4 objects", p.Resources)
+	}
+
+	// Someone took over the LimitRange.
+	lr, err := client.CoreV1().LimitRanges("team-a").Get(ctx, "standard", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(lr.Labels, managedByLabel)
+	if _, err := client.CoreV1().LimitRanges("team-a").Update(ctx, lr, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	// The NetworkPolicy is replaced, labels and all, once it is read.
+	gvr := networkingv1.SchemeGroupVersion.WithResource("networkpolicies")
+	client.PrependReactor("get", "networkpolicies", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		read, err := client.Tracker().Get(gvr, a.GetNamespace(), "deny-ingress")
+		if err != nil {
+			return true, nil, err
+		}
+		replacement := read.DeepCopyObject()
+		obj, _ := meta.Accessor(replacement)
+		obj.SetUID("uid-replacement")
+		if err := client.Tracker().Update(gvr, replacement, a.GetNamespace()); err != nil {
+			return true, nil, err
+		}
+		return true, read, nil
+	})
+	client.ClearActions()
+
+	if _, err := cluster.DeprovisionNamespace(ctx, "team-a"); err != nil {
+		t.Fatalf("DeprovisionNamespace: %v", err)
+	}
+	if _, err := client.CoreV1().LimitRanges("team-a").Get(ctx, "standard", metav1.GetOptions{}); err != nil {
+		t.Errorf("unlabelled limit range deleted: %v", err)
+	}
+	np, err := client.Tracker().Get(gvr, "team-a", "deny-ingress")
+	if err != nil {
+		t.Errorf("replaced network policy deleted: %v", err)
+	} else if obj, _ := meta.Accessor(np); obj.GetUID() != "uid-replacement" {
+		t.Errorf("network policy UID %s, want the replacement", obj.GetUID())
+	}
+	if _, err := client.CoreV1().ResourceQuotas("team-a").Get(ctx, "standard", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("resource quota left behind: %v", err)
+	}
+	if _, err := client.RbacV1().RoleBindings("team-a").Get(ctx, "edit", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("role binding left behind: %v", err)
+	}
+	if _, err := client.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("namespace left behind: %v", err)
+	}
+
+	if _, err := cluster.DeprovisionNamespace(ctx, "team-a"); !apierrors.IsNotFound(err) {
+		t.Errorf("DeprovisionNamespace of a deleted namespace = %v, want not found", err)
+	}
+}
+
+func TestProvisionRequestValidate(t *testing.T) {
+	tmpl := provisionTemplate(t)
+	tests := []struct {
+		name   string
+		modify func(*ProvisionRequest)
+		want   string
+	}{
+		{"valid", func(*ProvisionRequest) {}, ""},
+		{"bad name", func(r *ProvisionRequest) { r.Name = "Team_A" }, "name: "},
+		{"default namespace", func(r *ProvisionRequest) { r.Name = "default" }, `name: "default" is reserved`},
+		{"system namespace", func(r *ProvisionRequest) { r.Name = "kube-extra" }, `name: "kube-extra" is reserved`},
+		{"bad team", func(r *ProvisionRequest) { r.Team = "" }, "team: "},
+		{"no groups", func(r *ProvisionRequest) { r.Groups = nil }, "requires at least one group"},
+		{"blank group", func(r *ProvisionRequest) { r.Groups = []string{" "} }, `groups: " " is not a valid group name`},
+		{"group with a comma", func(r *ProvisionRequest) { r.Groups = []string{"a,b"} }, `groups: "a,b" is not a valid group name`},
+		{"bad label", func(r *ProvisionRequest) { r.Labels = map[string]string{"env": "not valid"} }, `label "env": `},
+		{"reserved label", func(r *ProvisionRequest) { r.Labels = map[string]string{managedByLabel: "me"} }, "reserved for the server's own metadata"},
+		{"reserved annotation", func(r *ProvisionRequest) { r.Annotations = map[string]string{groupsAnnotation: "admins"} }, "reserved for the server's own metadata"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := provisionRequest()
+			tt.modify(&req)
+			err := req.Validate(tmpl)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Validate = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidProvisioning) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate = %v, want ErrInvalidProvisioning with %q", err, tt.want)
+			}
+		})
+	}
+
+	// Nothing is created for a request that does not validate.
+	cluster, client := newProvisionCluster()
+	req := provisionRequest()
+	req.Name = "default"
+	if _, err := cluster.ProvisionNamespace(context.Background(), tmpl, req); !errors.Is(err, ErrInvalidProvisioning) {
+		t.Errorf("ProvisionNamespace = %v, want ErrInvalidProvisioning", err)
+	}
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("%d API calls for an invalid request, want none", len(actions))
+	}
+}
@@ -0,0 +1,161 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/requestid"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// ErrClusterNotFound is returned when a cluster name is not registered.
+var ErrClusterNotFound = errors.New("cluster not found")
+
+// Cluster is a registered Kubernetes cluster and its clients.
+type Cluster struct {
+	Name      string
+	Config    *rest.Config
+	Clientset kubernetes.Interface
+	Dynamic   dynamic.Interface
+	Mapper    *restmapper.DeferredDiscoveryRESTMapper
+}
+
+// ResourceFor resolves a resource argument such as "deployments",
+// "deploy" or "deployments.apps" to its preferred group version resource.
+func (c *Cluster) ResourceFor(resource string) (schema.GroupVersionResource, error) {
+	gvr, err := c.resourceFor(resource)
+	if meta.IsNoMatchError(err) {
+		// The resource may have been installed after discovery was cached.
+		c.Mapper.Reset()
+		gvr, err = c.resourceFor(resource)
+	}
+	return gvr, err
+}
+
+func (c *Cluster) resourceFor(resource string) (schema.GroupVersionResource, error) {
+	fullySpecified, groupResource := schema.ParseResourceArg(resource)
+	if fullySpecified != nil {
+		if gvr, err := c.Mapper.ResourceFor(*fullySpecified); err == nil {
+			return gvr, nil
+		}
+	}
+	gvr, err := c.Mapper.ResourceFor(groupResource.WithVersion(""))
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	return gvr, nil
+}
+
+// Registry holds the clusters this server operates on, keyed by name.
+type Registry struct {
+	mu       sync.RWMutex
+	clusters map[string]*Cluster
+}
+
+// NewRegistry creates an empty cluster registry.
+func NewRegistry() *Registry {
+	return &Registry{clusters: map[string]*Cluster{}}
+}
+
+// Load creates a registry from configuration. Every context of the configured
+// kubeconfig becomes a cluster, plus the in-cluster config when enabled.
+func Load(cfg config.KubeConfig) (*Registry, error) {
+	reg := NewRegistry()
+
+	if cfg.Kubeconfig != "" {
+		rawConfig, err := clientcmd.LoadFromFile(cfg.Kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("load kubeconfig %s: %w", cfg.Kubeconfig, err)
+		}
+		for name := range rawConfig.Contexts {
+			restConfig, err := clientcmd.NewNonInteractiveClientConfig(
+				*rawConfig, name, &clientcmd.ConfigOverrides{}, nil,
+			).ClientConfig()
+			if err != nil {
+				return nil, fmt.Errorf("build client config for context %s: %w", name, err)
+			}
+			if err := reg.Add(name, restConfig); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if cfg.InCluster {
+		restConfig, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("load in-cluster config: %w", err)
+		}
+		if err := reg.Add(cfg.InClusterName, restConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return reg, nil
+}
+
+// Add registers a cluster under the given name.
+func (r *Registry) Add(name string, restConfig *rest.Config) error {
+	// Calls made for a request carry its ID, which shows up in the audit
+	// log of the API server.
+	restConfig = rest.CopyConfig(restConfig)
+	restConfig.Wrap(requestid.Transport)
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("create clientset for cluster %s: %w", name, err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("create dynamic client for cluster %s: %w", name, err)
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(clientset.Discovery()))
+
+	r.AddCluster(&Cluster{
+		Name:      name,
+		Config:    restConfig,
+		Clientset: clientset,
+		Dynamic:   dynamicClient,
+		Mapper:    mapper,
+	})
+	return nil
+}
+
+// AddCluster registers a cluster whose clients are built already, such as
+// the fake clients of tests, under its name.
+func (r *Registry) AddCluster(cluster *Cluster) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.clusters[cluster.Name] = cluster
+}
+
+// Get returns the cluster registered under name.
+func (r *Registry) Get(name string) (*Cluster, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	cluster, ok := r.clusters[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, name)
+	}
+	return cluster, nil
+}
+
+// Names returns the names of all registered clusters in sorted order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.clusters))
+	for name := range r.clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,340 @@
+package kube
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"iu-k8s.linecorp.com/server/internal/log"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// podMetricsResource holds the pod usage sampled by metrics-server.
+var podMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+
+// UsageOptions select the namespaces a usage report covers.
+type UsageOptions struct {
+	// Namespace limits the report to one namespace.
+	Namespace string
+	// Team limits the report to the namespaces with this team label.
+	Team string
+}
+
+// Resources are amounts of CPU in millicores and memory in bytes.
+type Resources struct {
+	CPU    int64
+	Memory int64
+}
+
+func (r *Resources) add(o Resources) {
+	r.CPU += o.CPU
+	r.Memory += o.Memory
+}
+
+func maxResources(a, b Resources) Resources {
+	return Resources{CPU: max(a.CPU, b.CPU), Memory: max(a.Memory, b.Memory)}
+}
+
+// Usage is what a set of running pods requests, is limited to and uses.
+type Usage struct {
+	Pods     int
+	Requests Resources
+	// Limits only sums the containers that have a limit.
+	Limits Resources
+	// Actual is the usage last sampled by metrics-server, zero when
+	// metrics are unavailable.
+	Actual Resources
+}
+
+// Add adds the pods of o to u.
+func (u *Usage) Add(o Usage) {
+	u.Pods += o.Pods
+	u.Requests.add(o.Requests)
+	u.Limits.add(o.Limits)
+	u.Actual.add(o.Actual)
+}
+
+// OverProvisioned reports whether the pods use less than threshold of the
+// CPU or memory they request.
+func (u Usage) OverProvisioned(threshold float64) bool {
+	return (u.Requests.CPU > 0 && float64(u.Actual.CPU) < threshold*float64(u.Requests.CPU)) ||
+		(u.Requests.Memory > 0 && float64(u.Actual.Memory) < threshold*float64(u.Requests.Memory))
+}
+
+// NamespaceUsage is the usage of the pods of a namespace.
+type NamespaceUsage struct {
+	Namespace string
+	Team      string
+	Usage
+}
+
+// WorkloadUsage is the usage of the pods of a workload: the deployment,
+// statefulset, daemonset or job controlling them, or a pod without one.
+type WorkloadUsage struct {
+	Namespace string
+	Team      string
+	Kind      string
+	Name      string
+	Usage
+}
+
+// QuotaUsage is one resource of a ResourceQuota.
+type QuotaUsage struct {
+	Namespace string
+	Team      string
+	Quota     string
+	Resource  corev1.ResourceName
+	Hard      resource.Quantity
+	Used      resource.Quantity
+}
+
+// Percent returns the percentage of the hard limit used, or false for a
+// zero limit.
+func (q QuotaUsage) Percent() (float64, bool) {
+	hard := q.Hard.AsApproximateFloat64()
+	if hard == 0 {
+		return 0, false
+	}
+	return 100 * q.Used.AsApproximateFloat64() / hard, true
+}
+
+// UsageReport is the resource usage of the namespaces of a cluster, sorted
+// by namespace and then by name.
+type UsageReport struct {
+	// MetricsAvailable is set when metrics.k8s.io answered and the actual
+	// usages are filled in.
+	MetricsAvailable bool
+	Namespaces       []NamespaceUsage
+	Workloads        []WorkloadUsage
+	Quotas           []QuotaUsage
+}
+
+// ByTeam sums the namespaces of each team, sorted by team. Namespaces
+// without a team label are summed under the empty team.
+func (r *UsageReport) ByTeam() []NamespaceUsage {
+	teams := map[string]*NamespaceUsage{}
+	for _, ns := range r.Namespaces {
+		team, ok := teams[ns.Team]
+		if !ok {
+			team = &NamespaceUsage{Team: ns.Team}
+			teams[ns.Team] = team
+		}
+		team.Add(ns.Usage)
+	}
+	out := make([]NamespaceUsage, 0, len(teams))
+	for _, team := range teams {
+		out = append(out, *team)
+	}
+	slices.SortFunc(out, func(a, b NamespaceUsage) int { return strings.Compare(a.Team, b.Team) })
+	return out
+}
+
+// Total sums all namespaces.
+func (r *UsageReport) Total() Usage {
+	var total Usage
+	for _, ns := range r.Namespaces {
+		total.Add(ns.Usage)
+	}
+	return total
+}
+
+// UsageReport sums the requests, limits and, when metrics.k8s.io is served,
+// actual usage of the running pods of the selected namespaces, and lists
+// their ResourceQuotas.
+func (c *Cluster) UsageReport(ctx context.Context, opts UsageOptions) (*UsageReport, error) {
+	teams, err := c.namespaceTeams(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := c.Clientset.CoreV1().Pods(opts.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	quotas, err := c.Clientset.CoreV1().ResourceQuotas(opts.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	actual, err := c.podMetrics(ctx, opts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &UsageReport{MetricsAvailable: actual != nil}
+	namespaces := map[string]*NamespaceUsage{}
+	for name, team := range teams {
+		namespaces[name] = &NamespaceUsage{Namespace: name, Team: team}
+	}
+	workloads := map[WorkloadUsage]*Usage{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		ns, ok := namespaces[pod.Namespace]
+		if !ok || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		requests, limits := podResources(&pod.Spec)
+		usage := Usage{Pods: 1, Requests: requests, Limits: limits, Actual: actual[pod.Namespace+"/"+pod.Name]}
+		ns.Add(usage)
+
+		kind, name := podWorkload(pod)
+		key := WorkloadUsage{Namespace: pod.Namespace, Team: ns.Team, Kind: kind, Name: name}
+		if workloads[key] == nil {
+			workloads[key] = &Usage{}
+		}
+		workloads[key].Add(usage)
+	}
+
+	for _, ns := range namespaces {
+		report.Namespaces = append(report.Namespaces, *ns)
+	}
+	slices.SortFunc(report.Namespaces, func(a, b NamespaceUsage) int { return strings.Compare(a.Namespace, b.Namespace) })
+	for key, usage := range workloads {
+		key.Usage = *usage
+		report.Workloads = append(report.Workloads, key)
+	}
+	slices.SortFunc(report.Workloads, func(a, b WorkloadUsage) int {
+		return cmp.Or(strings.Compare(a.Namespace, b.Namespace), strings.Compare(a.Kind, b.Kind), strings.Compare(a.Name, b.Name))
+	})
+
+	for _, quota := range quotas.Items {
+		team, ok := teams[quota.Namespace]
+		if !ok {
+			continue
+		}
+		for name, hard := range quota.Status.Hard {
+			report.Quotas = append(report.Quotas, QuotaUsage{
+				Namespace: quota.Namespace,
+				Team:      team,
+				Quota:     quota.Name,
+				Resource:  name,
+				Hard:      hard,
+				Used:      quota.Status.Used[name],
+			})
+		}
+	}
+	slices.SortFunc(report.Quotas, func(a, b QuotaUsage) int {
+		return cmp.Or(strings.Compare(a.Namespace, b.Namespace), strings.Compare(a.Quota, b.Quota),
+			strings.Compare(string(a.Resource), string(b.Resource)))
+	})
+	return report, nil
+}
+
+// namespaceTeams returns the team labels of the namespaces opts selects,
+// keyed by namespace.
+func (c *Cluster) namespaceTeams(ctx context.Context, opts UsageOptions) (map[string]string, error) {
+	var namespaces []corev1.Namespace
+	if opts.Namespace != "" {
+		ns, err := c.Clientset.CoreV1().Namespaces().Get(ctx, opts.Namespace, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, *ns)
+	} else {
+		listOpts := metav1.ListOptions{}
+		if opts.Team != "" {
+			listOpts.LabelSelector = labels.Set{teamLabel: opts.Team}.String()
+		}
+		list, err := c.Clientset.CoreV1().Namespaces().List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		namespaces = list.Items
+	}
+
+	teams := make(map[string]string, len(namespaces))
+	for _, ns := range namespaces {
+		if opts.Team == "" || ns.Labels[teamLabel] == opts.Team {
+			teams[ns.Name] = ns.Labels[teamLabel]
+		}
+	}
+	return teams, nil
+}
+
+// podMetrics returns the usage of the pods in namespace, or of all pods
+// for an empty namespace, keyed by namespace/name. It returns nil when
+// metrics.k8s.io is not served or fails, as without metrics-server.
+func (c *Cluster) podMetrics(ctx context.Context, namespace string) (map[string]Resources, error) {
+	list, err := c.Dynamic.Resource(podMetricsResource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !apierrors.IsNotFound(err) {
+			log.From(ctx).Warn("failed to read pod metrics", "cluster", c.Name, "error", err)
+		}
+		return nil, nil
+	}
+
+	usage := make(map[string]Resources, len(list.Items))
+	for _, item := range list.Items {
+		containers, _, _ := unstructured.NestedSlice(item.Object, "containers")
+		var pod Resources
+		for _, container := range containers {
+			values, _, _ := unstructured.NestedStringMap(container.(map[string]any), "usage")
+			cpu, _ := resource.ParseQuantity(values[string(corev1.ResourceCPU)])
+			memory, _ := resource.ParseQuantity(values[string(corev1.ResourceMemory)])
+			pod.add(Resources{CPU: cpu.MilliValue(), Memory: memory.Value()})
+		}
+		usage[item.GetNamespace()+"/"+item.GetName()] = pod
+	}
+	return usage, nil
+}
+
+// podResources returns the requests and limits of a pod as the scheduler
+// counts them: its containers and sidecars, or an init container and the
+// sidecars started before it if that is more, plus the pod overhead.
+func podResources(spec *corev1.PodSpec) (requests, limits Resources) {
+	sum := func(get func(corev1.ResourceRequirements) corev1.ResourceList) Resources {
+		var containers, sidecars, init Resources
+		for _, c := range spec.Containers {
+			containers.add(resources(get(c.Resources)))
+		}
+		for _, c := range spec.InitContainers {
+			r := resources(get(c.Resources))
+			if c.RestartPolicy != nil && *c.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+				sidecars.add(r)
+				init = maxResources(init, sidecars)
+				continue
+			}
+			r.add(sidecars)
+			init = maxResources(init, r)
+		}
+		containers.add(sidecars)
+		total := maxResources(containers, init)
+		total.add(resources(spec.Overhead))
+		return total
+	}
+	requests = sum(func(r corev1.ResourceRequirements) corev1.ResourceList { return r.Requests })
+	limits = sum(func(r corev1.ResourceRequirements) corev1.ResourceList { return r.Limits })
+	return requests, limits
+}
+
+func resources(list corev1.ResourceList) Resources {
+	return Resources{CPU: list.Cpu().MilliValue(), Memory: list.Memory().Value()}
+}
+
+// podWorkload returns the kind and name of the workload controlling pod,
+// or the pod itself if it has no controller. Pods of a ReplicaSet are
+// attributed to its Deployment, whose name the ReplicaSet's extends with
+// the pod template hash.
+func podWorkload(pod *corev1.Pod) (kind, name string) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "Pod", pod.Name
+	}
+	if owner.Kind == "ReplicaSet" {
+		if hash := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; hash != "" {
+			if deployment, ok := strings.CutSuffix(owner.Name, "-"+hash); ok {
+				return "Deployment", deployment
+			}
+		}
+	}
+	return owner.Kind, owner.Name
+}
@@ -0,0 +1,314 @@
+package kube
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func usageNamespace(name, team string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if team != "" {
+		ns.Labels = map[string]string{teamLabel: team}
+	}
+	return ns
+}
+
+func requirements(requestCPU, requestMemory, limitCPU, limitMemory string) corev1.ResourceRequirements {
+	r := corev1.ResourceRequirements{Requests: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(requestCPU),
+		corev1.ResourceMemory: resource.MustParse(requestMemory),
+	}}
+	if limitCPU != "" {
+		r.Limits = corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(limitCPU),
+			corev1.ResourceMemory: resource.MustParse(limitMemory),
+		}
+	}
+	return r
+}
+
+// webPod is a pod of the deployment web in team-a, through its ReplicaSet.
+func webPod(name string) *corev1.Pod {
+	controller := true
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team-a",
+			Name:      name,
+			Labels:    map[string]string{"pod-template-hash": "5d4f8"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5d4f8", Controller: &controller,
+			}},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "web", Resources: requirements("100m", "128Mi", "200m", "256Mi")},
+		}},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+// usageObjects are the namespaces of two teams and an unlabelled one,
+// their pods and a quota.
+func usageObjects() []runtime.Object {
+	always := corev1.ContainerRestartPolicyAlways
+	controller := true
+	db := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team-b",
+			Name:      "db-0",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1", Kind: "StatefulSet", Name: "db", Controller: &controller,
+			}},
+		},
+		Spec: corev1.PodSpec{
+			// The migration needs more CPU than the database and its
+			// sidecar, which runs alongside both.
+			InitContainers: []corev1.Container{
+				{Name: "proxy", RestartPolicy: &always, Resources: requirements("50m", "64Mi", "", "")},
+				{Name: "migrate", Resources: requirements("500m", "512Mi", "", "")},
+			},
+			Containers: []corev1.Container{{Name: "db", Resources: requirements("250m", "1Gi", "1", "2Gi")}},
+			Overhead:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10m")},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	done := webPod("migrate-once")
+	done.OwnerReferences = nil
+	done.Status.Phase = corev1.PodSucceeded
+	debug := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "sandbox", Name: "debug"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "shell", Resources: requirements("10m", "16Mi", "", "")},
+		}},
+	}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "compute"},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("1"),
+				corev1.ResourcePods:        resource.MustParse("0"),
+			},
+			Used: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("200m"),
+				corev1.ResourcePods:        resource.MustParse("2"),
+			},
+		},
+	}
+	return []runtime.Object{
+		usageNamespace("team-a", "a"), usageNamespace("team-b", "b"), usageNamespace("sandbox", ""),
+		webPod("web-5d4f8-1"), webPod("web-5d4f8-2"), db, done, debug, quota,
+	}
+}
+
+func podMetrics(namespace, name string, usage ...[2]string) *unstructured.Unstructured {
+	var containers []any
+	for _, u := range usage {
+		containers = append(containers, map[string]any{"usage": map[string]any{"cpu": u[0], "memory": u[1]}})
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "metrics.k8s.io/v1beta1",
+		"kind":       "PodMetrics",
+		"metadata":   map[string]any{"namespace": namespace, "name": name},
+		"containers": containers,
+	}}
+}
+
+// newUsageCluster returns a cluster holding usageObjects. metricsErr is
+// what metrics.k8s.io answers, or nil to serve the usage of the pods.
+func newUsageCluster(t *testing.T, metricsErr error) *Cluster {
+	t.Helper()
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podMetricsResource: "PodMetricsList"})
+	if metricsErr != nil {
+		dynamic.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, metricsErr
+		})
+	}
+	for _, m := range []*unstructured.Unstructured{
+		podMetrics("team-a", "web-5d4f8-1", [2]string{"20m", "64Mi"}),
+		podMetrics("team-a", "web-5d4f8-2", [2]string{"30m", "64Mi"}),
+		podMetrics("team-b", "db-0", [2]string{"400m", "900Mi"}, [2]string{"100m", "10Mi"}),
+	} {
+		_, err := dynamic.Resource(podMetricsResource).Namespace(m.GetNamespace()).Create(context.Background(), m, metav1.CreateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &Cluster{Name: "dev", Clientset: fake.NewClientset(usageObjects()...), Dynamic: dynamic}
+}
+
+const mi = 1 << 20
+
+func TestUsageReport(t *testing.T) {
+	web := Usage{
+		Pods:     2,
+		Requests: Resources{CPU: 200, Memory: 256 * mi},
+		Limits:   Resources{CPU: 400, Memory: 512 * mi},
+		Actual:   Resources{CPU: 50, Memory: 128 * mi},
+	}
+	db := Usage{
+		Pods: 1,
+		// The migration and the sidecar it starts after, and the overhead.
+		Requests: Resources{CPU: 560, Memory: 1088 * mi},
+		// The sidecar and the migration have no limits.
+		Limits: Resources{CPU: 1010, Memory: 2048 * mi},
+		Actual: Resources{CPU: 500, Memory: 910 * mi},
+	}
+	debug := Usage{Pods: 1, Requests: Resources{CPU: 10, Memory: 16 * mi}}
+
+	tests := []struct {
+		name       string
+		metricsErr error
+	}{
+		{"with metrics", nil},
+		{"without metrics-server", apierrors.NewNotFound(podMetricsResource.GroupResource(), "")},
+		{"failing metrics-server", apierrors.NewServiceUnavailable("metrics-server is starting")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := newUsageCluster(t, tt.metricsErr).UsageReport(context.Background(), UsageOptions{})
+			if err != nil {
+				t.Fatalf("UsageReport: %v", err)
+			}
+			withMetrics := tt.metricsErr == nil
+			if report.MetricsAvailable != withMetrics {
+				t.Errorf("MetricsAvailable = %v, want %v", report.MetricsAvailable, withMetrics)
+			}
+			actual := func(u Usage) Usage {
+				if !withMetrics {
+					u.Actual = Resources{}
+				}
+				return u
+			}
+
+			wantNamespaces := []NamespaceUsage{
+				{Namespace: "sandbox", Usage: actual(debug)},
+				{Namespace: "team-a", Team: "a", Usage: actual(web)},
+				{Namespace: "team-b", Team: "b", Usage: actual(db)},
+			}
+			if len(report.Namespaces) != len(wantNamespaces) {
+				t.Fatalf("namespaces = %+v, want %+v", report.Namespaces, wantNamespaces)
+			}
+			for i, want := range wantNamespaces {
+				if got := report.Namespaces[i]; got != want {
+					t.Errorf("namespace %d = %+v, want %+v", i, got, want)
+				}
+			}
+
+			wantWorkloads := []WorkloadUsage{
+				{Namespace: "sandbox", Kind: "Pod", Name: "debug", Usage: actual(debug)},
+				{Namespace: "team-a", Team: "a", Kind: "Deployment", Name: "web", Usage: actual(web)},
+				{Namespace: "team-b", Team: "b", Kind: "StatefulSet", Name: "db", Usage: actual(db)},
+			}
+			if len(report.Workloads) != len(wantWorkloads) {
+				t.Fatalf("workloads = %+v, want %+v", report.Workloads, wantWorkloads)
+			}
+			for i, want := range wantWorkloads {
+				if got := report.Workloads[i]; got != want {
+					t.Errorf("workload %d = %+v, want %+v", i, got, want)
+				}
+			}
+
+			var total Usage
+			for _, u := range []Usage{debug, web, db} {
+				total.Add(actual(u))
+			}
+			if got := report.Total(); got != total {
+				t.Errorf("Total = %+v, want %+v", got, total)
+			}
+			teams := report.ByTeam()
+			if len(teams) != 3 || teams[0].Team != "" || teams[0].Usage != actual(debug) ||
+				teams[1].Team != "a" || teams[1].Usage != actual(web) {
+				t.Errorf("ByTeam = %+v, want the unlabelled namespace first, then a and b", teams)
+			}
+		})
+	}
+}
+
+func TestUsageReportOptions(t *testing.T) {
+	c := newUsageCluster(t, nil)
+	tests := []struct {
+		name string
+		opts UsageOptions
+		want []string
+	}{
+		{"namespace", UsageOptions{Namespace: "team-b"}, []string{"team-b"}},
+		{"team", UsageOptions{Team: "a"}, []string{"team-a"}},
+		{"namespace of another team", UsageOptions{Namespace: "team-b", Team: "a"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := c.UsageReport(context.Background(), tt.opts)
+			if err != nil {
+				t.Fatalf("UsageReport: %v", err)
+			}
+			var got []string
+			for _, ns := range report.Namespaces {
+				got = append(got, ns.Namespace)
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+				t.Errorf("namespaces %v, want %v", got, tt.want)
+			}
+			for _, w := range report.Workloads {
+				if len(tt.want) == 0 || w.Namespace != tt.want[0] {
+					t.Errorf("workload %s/%s outside %v", w.Namespace, w.Name, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestQuotaPercent(t *testing.T) {
+	report, err := newUsageCluster(t, nil).UsageReport(context.Background(), UsageOptions{})
+	if err != nil {
+		t.Fatalf("UsageReport: %v", err)
+	}
+	if len(report.Quotas) != 2 {
+		t.Fatalf("quotas = %+v, want the two resources of compute", report.Quotas)
+	}
+	pods, cpu := report.Quotas[0], report.Quotas[1]
+	if pods.Resource != corev1.ResourcePods || cpu.Resource != corev1.ResourceRequestsCPU || cpu.Team != "a" {
+		t.Fatalf("quotas = %+v, want pods then requests.cpu of team a", report.Quotas)
+	}
+	if percent, ok := cpu.Percent(); !ok || percent != 20 {
+		t.Errorf("Percent of requests.cpu = %v, %v, want 20", percent, ok)
+	}
+	// A zero hard limit forbids the resource: there is no percentage of it.
+	if percent, ok := pods.Percent(); ok {
+		t.Errorf("Percent of a zero hard limit = %v, want none", percent)
+	}
+}
+
+func TestOverProvisioned(t *testing.T) {
+	requests := Resources{CPU: 200, Memory: 256 * mi}
+	tests := []struct {
+		name      string
+		usage     Usage
+		threshold float64
+		want      bool
+	}{
+		{"cpu below", Usage{Requests: requests, Actual: Resources{CPU: 50, Memory: 200 * mi}}, 0.5, true},
+		{"memory below", Usage{Requests: requests, Actual: Resources{CPU: 150, Memory: 100 * mi}}, 0.5, true},
+		{"at the threshold", Usage{Requests: requests, Actual: Resources{CPU: 100, Memory: 128 * mi}}, 0.5, false},
+		{"lower threshold", Usage{Requests: requests, Actual: Resources{CPU: 50, Memory: 128 * mi}}, 0.25, false},
+		{"nothing requested", Usage{Actual: Resources{CPU: 50}}, 0.5, false},
+		{"only cpu requested", Usage{Requests: Resources{CPU: 200}, Actual: Resources{CPU: 150}}, 0.5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.usage.OverProvisioned(tt.threshold); got != tt.want {
+				t.Errorf("OverProvisioned(%v) = %v, want %v", tt.threshold, got, tt.want)
+			}
+		})
+	}
+}
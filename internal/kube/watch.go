@@ -0,0 +1,349 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Watch event types, matching the Kubernetes watch API.
+const (
+	EventAdded    = "ADDED"
+	EventModified = "MODIFIED"
+	EventDeleted  = "DELETED"
+)
+
+var (
+	// ErrSlowConsumer is reported when a subscriber falls so far behind that
+	// its buffer fills up. The subscription is closed rather than blocking
+	// the shared informer.
+	ErrSlowConsumer = errors.New("subscriber is too slow to keep up with events")
+	// ErrWatchNotSynced is returned when the informer cache does not sync in time.
+	ErrWatchNotSynced = errors.New("timed out waiting for the watch cache to sync")
+)
+
+// WatchEvent is a change to a Kubernetes object.
+type WatchEvent struct {
+	Type            string
+	ResourceVersion string
+	Object          *unstructured.Unstructured
+}
+
+// WatchOptions configure a WatchHub.
+type WatchOptions struct {
+	// BufferSize is the number of events buffered per subscriber.
+	BufferSize int
+	// HistorySize is the number of recent events kept per informer to resume
+	// subscribers from a resource version.
+	HistorySize int
+	// SyncTimeout bounds how long a subscriber waits for the initial list.
+	SyncTimeout time.Duration
+}
+
+// WatchHub shares one informer per cluster, resource and namespace between
+// all subscribers. Informers are started by the first subscriber and stopped
+// when the last one leaves.
+type WatchHub struct {
+	opts WatchOptions
+
+	mu      sync.Mutex
+	watches map[watchKey]*resourceWatch
+}
+
+type watchKey struct {
+	cluster   string
+	resource  schema.GroupVersionResource
+	namespace string
+}
+
+// NewWatchHub creates an empty hub.
+func NewWatchHub(opts WatchOptions) *WatchHub {
+	return &WatchHub{
+		opts:    opts,
+		watches: map[watchKey]*resourceWatch{},
+	}
+}
+
+// Subscription receives the events of one subscriber.
+type Subscription struct {
+	// Initial holds the events to deliver before any from Events: either the
+	// events since the requested resource version, or a snapshot of all
+	// matching objects as ADDED events.
+	Initial []WatchEvent
+	// Resynced reports that the requested resource version could not be
+	// resumed and Initial is a full snapshot instead.
+	Resynced bool
+
+	events   chan WatchEvent
+	selector labels.Selector
+	watch    *resourceWatch
+
+	closeOnce sync.Once
+	err       error
+}
+
+// Events returns the channel of live events. It is closed when the
+// subscription ends; Err then reports why.
+func (s *Subscription) Events() <-chan WatchEvent {
+	return s.events
+}
+
+// Err returns ErrSlowConsumer if the subscription was dropped for falling behind.
+func (s *Subscription) Err() error {
+	s.watch.mu.Lock()
+	defer s.watch.mu.Unlock()
+	return s.err
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	s.watch.unsubscribe(s, nil)
+}
+
+// Subscribe registers a subscriber for changes of resource in namespace (all
+// namespaces when empty) of cluster, filtered by selector. When
+// lastResourceVersion is set, events after it are replayed if still in history.
+func (h *WatchHub) Subscribe(ctx context.Context, cluster *Cluster, resource schema.GroupVersionResource, namespace string, selector labels.Selector, lastResourceVersion string) (*Subscription, error) {
+	key := watchKey{cluster: cluster.Name, resource: resource, namespace: namespace}
+
+	h.mu.Lock()
+	w, ok := h.watches[key]
+	if !ok {
+		w = h.startWatch(cluster, key)
+		h.watches[key] = w
+	}
+	w.refs++
+	h.mu.Unlock()
+
+	syncCtx, cancel := context.WithTimeout(ctx, h.opts.SyncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), w.registration.HasSynced) {
+		h.release(w)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, ErrWatchNotSynced
+	}
+
+	sub := &Subscription{
+		events:   make(chan WatchEvent, h.opts.BufferSize),
+		selector: selector,
+		watch:    w,
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if replay, ok := w.since(lastResourceVersion); ok {
+		for _, event := range replay {
+			if sub.matches(event.Object) {
+				sub.Initial = append(sub.Initial, event)
+			}
+		}
+	} else {
+		sub.Resynced = lastResourceVersion != ""
+		for _, obj := range w.informer.GetStore().List() {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok || !sub.matches(u) {
+				continue
+			}
+			sub.Initial = append(sub.Initial, WatchEvent{
+				Type:            EventAdded,
+				ResourceVersion: u.GetResourceVersion(),
+				Object:          u,
+			})
+		}
+	}
+	w.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+func (h *WatchHub) startWatch(cluster *Cluster, key watchKey) *resourceWatch {
+	logger := slog.With("component", "watch", "cluster", key.cluster,
+		"resource", key.resource.String(), "namespace", key.namespace)
+
+	informer := dynamicinformer.NewFilteredDynamicInformer(
+		cluster.Dynamic, key.resource, key.namespace, 0, cache.Indexers{}, nil,
+	).Informer()
+	_ = informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+		logger.Warn("watch failed", "error", err)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &resourceWatch{
+		hub:         h,
+		key:         key,
+		informer:    informer,
+		cancel:      cancel,
+		subscribers: map[*Subscription]struct{}{},
+		history:     make([]WatchEvent, 0, h.opts.HistorySize),
+		historySize: h.opts.HistorySize,
+	}
+	// The objects of the initial list are not changes anyone could resume
+	// after, so they are kept out of the history: a subscriber resuming
+	// from one of their resource versions is resynced instead.
+	w.registration, _ = informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj any, isInInitialList bool) {
+			w.dispatch(EventAdded, nil, obj, !isInInitialList)
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			w.dispatch(EventModified, oldObj, newObj, true)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			w.dispatch(EventDeleted, nil, obj, true)
+		},
+	})
+
+	logger.Debug("starting informer")
+	go informer.RunWithContext(ctx)
+	return w
+}
+
+func (h *WatchHub) release(w *resourceWatch) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	w.refs--
+	if w.refs == 0 {
+		delete(h.watches, w.key)
+		w.cancel()
+	}
+}
+
+// resourceWatch is a running informer and its subscribers.
+type resourceWatch struct {
+	hub      *WatchHub
+	key      watchKey
+	informer cache.SharedIndexInformer
+	// registration syncs once the handler has seen the initial list.
+	registration cache.ResourceEventHandlerRegistration
+	cancel       context.CancelFunc
+	// refs is guarded by hub.mu.
+	refs int
+
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	history     []WatchEvent
+	historySize int
+}
+
+// dispatch sends a change to the subscribers, recording it in the history
+// when record is set.
+func (w *resourceWatch) dispatch(eventType string, oldObj, obj any, record bool) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	event := WatchEvent{Type: eventType, ResourceVersion: u.GetResourceVersion(), Object: u}
+	var old *unstructured.Unstructured
+	if oldObj != nil {
+		old, _ = oldObj.(*unstructured.Unstructured)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if record {
+		w.remember(event)
+	}
+	for sub := range w.subscribers {
+		filtered, ok := sub.filterTransition(event, old)
+		if !ok {
+			continue
+		}
+		select {
+		case sub.events <- filtered:
+		default:
+			w.drop(sub, ErrSlowConsumer)
+		}
+	}
+}
+
+// remember appends an event to the bounded history.
+func (w *resourceWatch) remember(event WatchEvent) {
+	if w.historySize <= 0 {
+		return
+	}
+	if len(w.history) == w.historySize {
+		copy(w.history, w.history[1:])
+		w.history = w.history[:len(w.history)-1]
+	}
+	w.history = append(w.history, event)
+}
+
+// since returns the events after resourceVersion, if it is still in
+// history. Resource versions of the initial list are never in it.
+func (w *resourceWatch) since(resourceVersion string) ([]WatchEvent, bool) {
+	if resourceVersion == "" {
+		return nil, false
+	}
+	for i := len(w.history) - 1; i >= 0; i-- {
+		if w.history[i].ResourceVersion == resourceVersion {
+			return append([]WatchEvent(nil), w.history[i+1:]...), true
+		}
+	}
+	return nil, false
+}
+
+func (w *resourceWatch) unsubscribe(sub *Subscription, err error) {
+	w.mu.Lock()
+	_, ok := w.subscribers[sub]
+	if ok {
+		w.drop(sub, err)
+	}
+	w.mu.Unlock()
+
+	if ok {
+		w.hub.release(w)
+	}
+}
+
+// drop removes a subscriber and closes its channel. Callers hold w.mu.
+func (w *resourceWatch) drop(sub *Subscription, err error) {
+	sub.closeOnce.Do(func() {
+		delete(w.subscribers, sub)
+		sub.err = err
+		close(sub.events)
+		if err != nil {
+			// The informer reference is released outside of the dispatch lock.
+			go w.hub.release(w)
+		}
+	})
+}
+
+func (s *Subscription) matches(obj *unstructured.Unstructured) bool {
+	return s.selector.Empty() || s.selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// filterTransition applies the label selector to a change. An object that is
+// modified out of the selection is reported as deleted to the subscriber, and
+// one modified into it as added.
+func (s *Subscription) filterTransition(event WatchEvent, old *unstructured.Unstructured) (WatchEvent, bool) {
+	if s.selector.Empty() {
+		return event, true
+	}
+	matches := s.matches(event.Object)
+	if event.Type != EventModified || old == nil {
+		return event, matches
+	}
+
+	matched := s.matches(old)
+	switch {
+	case matches && !matched:
+		event.Type = EventAdded
+	case !matches && matched:
+		event.Type = EventDeleted
+	case !matches:
+		return event, false
+	}
+	return event, true
+}
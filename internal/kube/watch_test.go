@@ -0,0 +1,95 @@
+package kube
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+var configMapsResource = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+func configMap(name, resourceVersion string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetNamespace("default")
+	obj.SetName(name)
+	obj.SetResourceVersion(resourceVersion)
+	return obj
+}
+
+func newWatchCluster(objects ...runtime.Object) *Cluster {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{configMapsResource: "ConfigMapList"}, objects...)
+	return &Cluster{Name: "test", Dynamic: client}
+}
+
+func subscribe(t *testing.T, hub *WatchHub, cluster *Cluster, lastResourceVersion string) *Subscription {
+	t.Helper()
+	sub, err := hub.Subscribe(context.Background(), cluster, configMapsResource, "default", labels.Everything(), lastResourceVersion)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	t.Cleanup(sub.Close)
+	return sub
+}
+
+func TestWatchResumeFromInitialListResyncs(t *testing.T) {
+	hub := NewWatchHub(WatchOptions{BufferSize: 10, HistorySize: 10, SyncTimeout: 5 * time.Second})
+	cluster := newWatchCluster(configMap("a", "1"), configMap("b", "2"))
+
+	// The first subscriber starts the informer; its initial list must not
+	// make the listed resource versions resumable.
+	first := subscribe(t, hub, cluster, "")
+	if first.Resynced || len(first.Initial) != 2 {
+		t.Fatalf("first subscriber: resynced %v with %d initial events, want a snapshot of 2", first.Resynced, len(first.Initial))
+	}
+
+	sub := subscribe(t, hub, cluster, "1")
+	if !sub.Resynced {
+		t.Error("resuming from a resource version of the initial list did not resync")
+	}
+	if len(sub.Initial) != 2 {
+		t.Errorf("resynced with %d objects, want 2", len(sub.Initial))
+	}
+}
+
+func TestWatchResumeAfterChange(t *testing.T) {
+	hub := NewWatchHub(WatchOptions{BufferSize: 10, HistorySize: 10, SyncTimeout: 5 * time.Second})
+	cluster := newWatchCluster(configMap("a", "1"))
+	first := subscribe(t, hub, cluster, "")
+
+	ctx := context.Background()
+	client := cluster.Dynamic.Resource(configMapsResource).Namespace("default")
+	if _, err := client.Update(ctx, configMap("a", "3"), metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if _, err := client.Create(ctx, configMap("c", "4"), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	for _, want := range []string{"3", "4"} {
+		select {
+		case event := <-first.Events():
+			if event.ResourceVersion != want {
+				t.Fatalf("got event at %s, want %s", event.ResourceVersion, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the event at %s", want)
+		}
+	}
+
+	sub := subscribe(t, hub, cluster, "3")
+	if sub.Resynced {
+		t.Fatal("resuming from a recorded change resynced")
+	}
+	if len(sub.Initial) != 1 || sub.Initial[0].Type != EventAdded || sub.Initial[0].ResourceVersion != "4" {
+		t.Errorf("replayed %+v, want the addition at 4", sub.Initial)
+	}
+}
@@ -0,0 +1,648 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// Workload resources that support rollout operations.
+const (
+	WorkloadDeployments  = "deployments"
+	WorkloadStatefulSets = "statefulsets"
+)
+
+const (
+	revisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+	// pausedPartitionAnnotation remembers the rolling update partition of a
+	// paused StatefulSet so resuming restores it.
+	pausedPartitionAnnotation = "iu-k8s.linecorp.com/paused-partition"
+)
+
+var (
+	// ErrUnsupportedWorkload is returned for workload resources other than
+	// deployments and statefulsets.
+	ErrUnsupportedWorkload = errors.New("unsupported workload resource")
+	// ErrRevisionNotFound is returned when rolling back to an unknown revision.
+	ErrRevisionNotFound = errors.New("revision not found")
+	// ErrInvalidOperation is returned when an operation does not apply to the
+	// current state of the workload.
+	ErrInvalidOperation = errors.New("invalid operation")
+	// ErrPreconditionFailed is returned when a workload is no longer at the
+	// resource version a change was conditioned on.
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
+
+// WorkloadRef identifies a Deployment or StatefulSet.
+type WorkloadRef struct {
+	Resource  string
+	Namespace string
+	Name      string
+	// ResourceVersions, when not nil, makes changes apply only while the
+	// workload is at one of the listed resource versions. An empty set
+	// matches no version.
+	ResourceVersions []string
+}
+
+func (r WorkloadRef) String() string {
+	return fmt.Sprintf("%s/%s/%s", r.Resource, r.Namespace, r.Name)
+}
+
+// checkVersion fails with ErrPreconditionFailed unless the workload at
+// resourceVersion satisfies the precondition of r.
+func (r WorkloadRef) checkVersion(resourceVersion string) error {
+	if r.ResourceVersions == nil || slices.Contains(r.ResourceVersions, resourceVersion) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s is at resource version %s", ErrPreconditionFailed, r, resourceVersion)
+}
+
+// conflicted maps the conflict of a change made under a precondition to
+// ErrPreconditionFailed: the workload changed since it was checked.
+func (r WorkloadRef) conflicted(err error) error {
+	if r.ResourceVersions != nil && apierrors.IsConflict(err) {
+		return fmt.Errorf("%w: %s changed: %w", ErrPreconditionFailed, r, err)
+	}
+	return err
+}
+
+// RolloutStatus summarises the progress of a workload rollout.
+type RolloutStatus struct {
+	Generation         int64
+	ObservedGeneration int64
+	Replicas           int32
+	UpdatedReplicas    int32
+	ReadyReplicas      int32
+	AvailableReplicas  int32
+	Paused             bool
+	CurrentRevision    string
+	UpdateRevision     string
+	// ResourceVersion is the resource version of the workload the status
+	// was read from.
+	ResourceVersion string
+	// Complete reports that the latest spec is fully rolled out and available.
+	Complete bool
+	// Failed reports that the rollout exceeded its progress deadline.
+	Failed  bool
+	Message string
+}
+
+// Revision is an entry of a workload's rollout history.
+type Revision struct {
+	Revision    int64
+	ChangeCause string
+	Images      []string
+	Created     time.Time
+	Current     bool
+}
+
+// Scale sets the replica count of a workload through its scale subresource.
+func (c *Cluster) Scale(ctx context.Context, ref WorkloadRef, replicas int32) error {
+	apps := c.Clientset.AppsV1()
+	switch ref.Resource {
+	case WorkloadDeployments:
+		scale, err := apps.Deployments(ref.Namespace).GetScale(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := ref.checkVersion(scale.ResourceVersion); err != nil {
+			return err
+		}
+		scale.Spec.Replicas = replicas
+		_, err = apps.Deployments(ref.Namespace).UpdateScale(ctx, ref.Name, scale, metav1.UpdateOptions{})
+		return ref.conflicted(err)
+	case WorkloadStatefulSets:
+		scale, err := apps.StatefulSets(ref.Namespace).GetScale(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := ref.checkVersion(scale.ResourceVersion); err != nil {
+			return err
+		}
+		scale.Spec.Replicas = replicas
+		_, err = apps.StatefulSets(ref.Namespace).UpdateScale(ctx, ref.Name, scale, metav1.UpdateOptions{})
+		return ref.conflicted(err)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedWorkload, ref.Resource)
+	}
+}
+
+// Restart triggers a rollout of all pods the way `kubectl rollout restart`
+// does, by stamping the pod template with the restart time.
+func (c *Cluster) Restart(ctx context.Context, ref WorkloadRef, at time.Time) error {
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]string{
+						restartedAtAnnotation: at.Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.patchWorkload(ctx, ref, types.StrategicMergePatchType, patch)
+}
+
+// SetPaused pauses or resumes the rollout of a workload. Deployments use
+// spec.paused. StatefulSets have no such field, so they are paused by raising
+// the rolling update partition to the replica count, which keeps every
+// existing pod on its current revision, and resumed by restoring it.
+func (c *Cluster) SetPaused(ctx context.Context, ref WorkloadRef, paused bool) error {
+	switch ref.Resource {
+	case WorkloadDeployments:
+		patch, _ := json.Marshal(map[string]any{"spec": map[string]any{"paused": paused}})
+		return c.patchWorkload(ctx, ref, types.StrategicMergePatchType, patch)
+	case WorkloadStatefulSets:
+		sts, err := c.Clientset.AppsV1().StatefulSets(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+			return fmt.Errorf("%w: statefulset %s uses the OnDelete update strategy", ErrInvalidOperation, ref.Name)
+		}
+		saved, isPaused := sts.Annotations[pausedPartitionAnnotation]
+		if paused == isPaused {
+			return nil
+		}
+
+		var partition int32
+		annotations := map[string]any{}
+		if paused {
+			if rollingUpdate := sts.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+				partition = *rollingUpdate.Partition
+			}
+			annotations[pausedPartitionAnnotation] = strconv.Itoa(int(partition))
+			partition = statefulSetReplicas(sts)
+		} else {
+			restored, err := strconv.Atoi(saved)
+			if err != nil {
+				return fmt.Errorf("parse %s annotation: %w", pausedPartitionAnnotation, err)
+			}
+			partition = int32(restored)
+			annotations[pausedPartitionAnnotation] = nil
+		}
+
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{"annotations": annotations},
+			"spec": map[string]any{
+				"updateStrategy": map[string]any{
+					"type":          appsv1.RollingUpdateStatefulSetStrategyType,
+					"rollingUpdate": map[string]any{"partition": partition},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		return c.patchWorkload(ctx, ref, types.StrategicMergePatchType, patch)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedWorkload, ref.Resource)
+	}
+}
+
+// Revisions lists the rollout history of a workload, oldest first.
+func (c *Cluster) Revisions(ctx context.Context, ref WorkloadRef) ([]Revision, error) {
+	var revisions []Revision
+	switch ref.Resource {
+	case WorkloadDeployments:
+		deployment, replicaSets, err := c.deploymentReplicaSets(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		current := deployment.Annotations[revisionAnnotation]
+		for _, rs := range replicaSets {
+			number, err := strconv.ParseInt(rs.Annotations[revisionAnnotation], 10, 64)
+			if err != nil {
+				continue
+			}
+			revisions = append(revisions, Revision{
+				Revision:    number,
+				ChangeCause: rs.Annotations[changeCauseAnnotation],
+				Images:      containerImages(rs.Spec.Template.Spec),
+				Created:     rs.CreationTimestamp.Time,
+				Current:     rs.Annotations[revisionAnnotation] == current,
+			})
+		}
+	case WorkloadStatefulSets:
+		sts, history, err := c.statefulSetRevisions(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		for _, rev := range history {
+			var template struct {
+				Spec struct {
+					Template corev1.PodTemplateSpec `json:"template"`
+				} `json:"spec"`
+			}
+			_ = json.Unmarshal(rev.Data.Raw, &template)
+			revisions = append(revisions, Revision{
+				Revision:    rev.Revision,
+				ChangeCause: rev.Annotations[changeCauseAnnotation],
+				Images:      containerImages(template.Spec.Template.Spec),
+				Created:     rev.CreationTimestamp.Time,
+				Current:     rev.Name == sts.Status.UpdateRevision,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedWorkload, ref.Resource)
+	}
+
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i].Revision < revisions[j].Revision })
+	return revisions, nil
+}
+
+// Rollback restores the pod template of a previous revision, like
+// `kubectl rollout undo --to-revision`.
+func (c *Cluster) Rollback(ctx context.Context, ref WorkloadRef, revision int64) error {
+	switch ref.Resource {
+	case WorkloadDeployments:
+		deployment, replicaSets, err := c.deploymentReplicaSets(ctx, ref)
+		if err != nil {
+			return err
+		}
+		if deployment.Spec.Paused {
+			return fmt.Errorf("%w: cannot roll back a paused deployment, resume it first", ErrInvalidOperation)
+		}
+		for _, rs := range replicaSets {
+			if rs.Annotations[revisionAnnotation] != strconv.FormatInt(revision, 10) {
+				continue
+			}
+			template := rs.Spec.Template.DeepCopy()
+			delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+
+			patch, err := json.Marshal([]map[string]any{
+				{"op": "test", "path": "/metadata/resourceVersion", "value": deployment.ResourceVersion},
+				{"op": "replace", "path": "/spec/template", "value": template},
+			})
+			if err != nil {
+				return err
+			}
+			return c.patchWorkload(ctx, ref, types.JSONPatchType, patch)
+		}
+	case WorkloadStatefulSets:
+		_, history, err := c.statefulSetRevisions(ctx, ref)
+		if err != nil {
+			return err
+		}
+		for _, rev := range history {
+			if rev.Revision == revision {
+				// ControllerRevision data is a strategic merge patch of the template.
+				return c.patchWorkload(ctx, ref, types.StrategicMergePatchType, rev.Data.Raw)
+			}
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedWorkload, ref.Resource)
+	}
+	return fmt.Errorf("%w: %d", ErrRevisionNotFound, revision)
+}
+
+// RolloutStatus reports the rollout progress of a workload.
+func (c *Cluster) RolloutStatus(ctx context.Context, ref WorkloadRef) (*RolloutStatus, error) {
+	switch ref.Resource {
+	case WorkloadDeployments:
+		deployment, err := c.Clientset.AppsV1().Deployments(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return deploymentStatus(deployment), nil
+	case WorkloadStatefulSets:
+		sts, err := c.Clientset.AppsV1().StatefulSets(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return statefulSetStatus(sts), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedWorkload, ref.Resource)
+	}
+}
+
+func deploymentStatus(d *appsv1.Deployment) *RolloutStatus {
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	status := &RolloutStatus{
+		Generation:         d.Generation,
+		ObservedGeneration: d.Status.ObservedGeneration,
+		Replicas:           replicas,
+		UpdatedReplicas:    d.Status.UpdatedReplicas,
+		ReadyReplicas:      d.Status.ReadyReplicas,
+		AvailableReplicas:  d.Status.AvailableReplicas,
+		Paused:             d.Spec.Paused,
+		CurrentRevision:    d.Annotations[revisionAnnotation],
+		UpdateRevision:     d.Annotations[revisionAnnotation],
+		ResourceVersion:    d.ResourceVersion,
+	}
+
+	// The same checks `kubectl rollout status` performs.
+	switch {
+	case d.Generation > d.Status.ObservedGeneration:
+		status.Message = "waiting for the deployment spec update to be observed"
+	case hasDeploymentCondition(d, appsv1.DeploymentProgressing, "ProgressDeadlineExceeded"):
+		status.Failed = true
+		status.Message = fmt.Sprintf("deployment %q exceeded its progress deadline", d.Name)
+	case d.Status.UpdatedReplicas < replicas:
+		status.Message = fmt.Sprintf("%d of %d updated replicas are available", d.Status.UpdatedReplicas, replicas)
+	case d.Status.Replicas > d.Status.UpdatedReplicas:
+		status.Message = fmt.Sprintf("%d old replicas are pending termination", d.Status.Replicas-d.Status.UpdatedReplicas)
+	case d.Status.AvailableReplicas < d.Status.UpdatedReplicas:
+		status.Message = fmt.Sprintf("%d of %d updated replicas are available", d.Status.AvailableReplicas, d.Status.UpdatedReplicas)
+	default:
+		status.Complete = true
+		status.Message = fmt.Sprintf("deployment %q successfully rolled out", d.Name)
+	}
+	if d.Spec.Paused && !status.Complete {
+		status.Message = "rollout is paused: " + status.Message
+	}
+	return status
+}
+
+func statefulSetStatus(sts *appsv1.StatefulSet) *RolloutStatus {
+	replicas := statefulSetReplicas(sts)
+	_, paused := sts.Annotations[pausedPartitionAnnotation]
+	status := &RolloutStatus{
+		Generation:         sts.Generation,
+		ObservedGeneration: sts.Status.ObservedGeneration,
+		Replicas:           replicas,
+		UpdatedReplicas:    sts.Status.UpdatedReplicas,
+		ReadyReplicas:      sts.Status.ReadyReplicas,
+		AvailableReplicas:  sts.Status.AvailableReplicas,
+		Paused:             paused,
+		CurrentRevision:    sts.Status.CurrentRevision,
+		UpdateRevision:     sts.Status.UpdateRevision,
+		ResourceVersion:    sts.ResourceVersion,
+	}
+
+	var partition int32
+	if rollingUpdate := sts.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+		partition = *rollingUpdate.Partition
+	}
+
+	switch {
+	case sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType:
+		status.Complete = true
+		status.Message = "rollout status is not available for the OnDelete update strategy"
+	case sts.Status.ObservedGeneration == 0 || sts.Generation > sts.Status.ObservedGeneration:
+		status.Message = "waiting for the statefulset spec update to be observed"
+	case sts.Status.ReadyReplicas < replicas:
+		status.Message = fmt.Sprintf("%d of %d pods are ready", sts.Status.ReadyReplicas, replicas)
+	case partition > 0 && sts.Status.UpdatedReplicas < replicas-partition:
+		status.Message = fmt.Sprintf("%d of %d pods above partition %d are updated",
+			sts.Status.UpdatedReplicas, replicas-partition, partition)
+	case partition == 0 && sts.Status.UpdateRevision != sts.Status.CurrentRevision:
+		status.Message = fmt.Sprintf("%d of %d pods are updated", sts.Status.UpdatedReplicas, replicas)
+	default:
+		status.Complete = true
+		status.Message = fmt.Sprintf("statefulset %q successfully rolled out", sts.Name)
+	}
+	if paused && !status.Complete {
+		status.Message = "rollout is paused: " + status.Message
+	}
+	return status
+}
+
+func (c *Cluster) patchWorkload(ctx context.Context, ref WorkloadRef, patchType types.PatchType, patch []byte) error {
+	if ref.ResourceVersions != nil {
+		conditioned, err := c.preconditionPatch(ctx, ref, patchType, patch)
+		if err != nil {
+			return err
+		}
+		patch = conditioned
+	}
+	apps := c.Clientset.AppsV1()
+	var err error
+	switch ref.Resource {
+	case WorkloadDeployments:
+		_, err = apps.Deployments(ref.Namespace).Patch(ctx, ref.Name, patchType, patch, metav1.PatchOptions{})
+	case WorkloadStatefulSets:
+		_, err = apps.StatefulSets(ref.Namespace).Patch(ctx, ref.Name, patchType, patch, metav1.PatchOptions{})
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnsupportedWorkload, ref.Resource)
+	}
+	return ref.conflicted(err)
+}
+
+// preconditionPatch checks the precondition of ref against the current
+// workload and makes patch apply only at the version checked, so that a
+// change in between fails with a conflict.
+func (c *Cluster) preconditionPatch(ctx context.Context, ref WorkloadRef, patchType types.PatchType, patch []byte) ([]byte, error) {
+	var (
+		meta *metav1.ObjectMeta
+		err  error
+	)
+	switch ref.Resource {
+	case WorkloadDeployments:
+		var deployment *appsv1.Deployment
+		if deployment, err = c.Clientset.AppsV1().Deployments(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{}); err == nil {
+			meta = &deployment.ObjectMeta
+		}
+	case WorkloadStatefulSets:
+		var sts *appsv1.StatefulSet
+		if sts, err = c.Clientset.AppsV1().StatefulSets(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{}); err == nil {
+			meta = &sts.ObjectMeta
+		}
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnsupportedWorkload, ref.Resource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := ref.checkVersion(meta.ResourceVersion); err != nil {
+		return nil, err
+	}
+
+	switch patchType {
+	case types.JSONPatchType:
+		var ops []any
+		if err := json.Unmarshal(patch, &ops); err != nil {
+			return nil, err
+		}
+		test := map[string]any{"op": "test", "path": "/metadata/resourceVersion", "value": meta.ResourceVersion}
+		return json.Marshal(append([]any{test}, ops...))
+	default:
+		// The API server rejects merge patches carrying a resource version
+		// other than the current one with a conflict.
+		var fields map[string]any
+		if err := json.Unmarshal(patch, &fields); err != nil {
+			return nil, err
+		}
+		metadata, _ := fields["metadata"].(map[string]any)
+		if metadata == nil {
+			metadata = map[string]any{}
+			fields["metadata"] = metadata
+		}
+		metadata["resourceVersion"] = meta.ResourceVersion
+		return json.Marshal(fields)
+	}
+}
+
+// deploymentReplicaSets returns a deployment and the ReplicaSets it owns.
+func (c *Cluster) deploymentReplicaSets(ctx context.Context, ref WorkloadRef) (*appsv1.Deployment, []appsv1.ReplicaSet, error) {
+	apps := c.Clientset.AppsV1()
+	deployment, err := apps.Deployments(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, err := apps.ReplicaSets(ref.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var owned []appsv1.ReplicaSet
+	for _, rs := range list.Items {
+		if isControlledBy(rs.OwnerReferences, deployment.UID) {
+			owned = append(owned, rs)
+		}
+	}
+	return deployment, owned, nil
+}
+
+// statefulSetRevisions returns a statefulset and the ControllerRevisions it owns.
+func (c *Cluster) statefulSetRevisions(ctx context.Context, ref WorkloadRef) (*appsv1.StatefulSet, []appsv1.ControllerRevision, error) {
+	apps := c.Clientset.AppsV1()
+	sts, err := apps.StatefulSets(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, err := apps.ControllerRevisions(ref.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var owned []appsv1.ControllerRevision
+	for _, rev := range list.Items {
+		if isControlledBy(rev.OwnerReferences, sts.UID) {
+			owned = append(owned, rev)
+		}
+	}
+	return sts, owned, nil
+}
+
+func isControlledBy(refs []metav1.OwnerReference, uid types.UID) bool {
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller && ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDeploymentCondition(d *appsv1.Deployment, conditionType appsv1.DeploymentConditionType, reason string) bool {
+	for _, condition := range d.Status.Conditions {
+		if condition.Type == conditionType && condition.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func statefulSetReplicas(sts *appsv1.StatefulSet) int32 {
+	if sts.Spec.Replicas != nil {
+		return *sts.Spec.Replicas
+	}
+	return 1
+}
+
+func containerImages(spec corev1.PodSpec) []string {
+	images := make([]string, 0, len(spec.Containers))
+	for _, container := range spec.Containers {
+		images = append(images, container.Image)
+	}
+	return images
+}
+
+// ErrRolloutFailed is returned when a rollout exceeds its progress deadline.
+var ErrRolloutFailed = errors.New("rollout failed")
+
+// WaitForRollout watches a workload until its rollout completes or fails,
+// reporting the status after every change. It returns ErrRolloutFailed when a
+// Deployment reports ProgressDeadlineExceeded and the context error when ctx
+// ends first.
+func (c *Cluster) WaitForRollout(ctx context.Context, ref WorkloadRef, report func(*RolloutStatus)) error {
+	for {
+		status, err := c.RolloutStatus(ctx, ref)
+		if err != nil {
+			return err
+		}
+		report(status)
+		if status.Failed {
+			return fmt.Errorf("%w: %s", ErrRolloutFailed, status.Message)
+		}
+		if status.Complete {
+			return nil
+		}
+
+		if err := c.waitForChange(ctx, ref, status.ResourceVersion); err != nil {
+			return err
+		}
+	}
+}
+
+// rolloutResync bounds how long WaitForRollout trusts a watch without re-reading the workload.
+const rolloutResync = 30 * time.Second
+
+// waitForChange blocks until the workload changes after resourceVersion,
+// the version last read, the watch ends or the resync interval passes.
+// Watching from the version read, not from now, keeps the API server from
+// replaying the current object as a change.
+func (c *Cluster) waitForChange(ctx context.Context, ref WorkloadRef, resourceVersion string) error {
+	opts := metav1.ListOptions{
+		FieldSelector:   "metadata.name=" + ref.Name,
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  ptrTo(int64(rolloutResync / time.Second)),
+	}
+
+	var (
+		w   watch.Interface
+		err error
+	)
+	switch ref.Resource {
+	case WorkloadDeployments:
+		w, err = c.Clientset.AppsV1().Deployments(ref.Namespace).Watch(ctx, opts)
+	case WorkloadStatefulSets:
+		w, err = c.Clientset.AppsV1().StatefulSets(ref.Namespace).Watch(ctx, opts)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedWorkload, ref.Resource)
+	}
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-w.ResultChan():
+		return nil
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,87 @@
+package kube
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func rollingDeployment(resourceVersion string, updated int32) *appsv1.Deployment {
+	replicas := int32(2)
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web", Generation: 1, ResourceVersion: resourceVersion},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           updated,
+			UpdatedReplicas:    updated,
+			ReadyReplicas:      updated,
+			AvailableReplicas:  updated,
+		},
+	}
+}
+
+func TestWaitForRolloutWatchesFromVersionRead(t *testing.T) {
+	client := fake.NewClientset(rollingDeployment("1", 1))
+	var gets atomic.Int32
+	client.PrependReactor("get", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		gets.Add(1)
+		return false, nil, nil
+	})
+	watching := make(chan string, 10)
+	client.PrependWatchReactor("deployments", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		resourceVersion := action.(k8stesting.WatchAction).GetWatchRestrictions().ResourceVersion
+		if resourceVersion == "" {
+			// The API server starts a watch without a version with the
+			// current object.
+			w := watch.NewFakeWithChanSize(1, false)
+			w.Add(rollingDeployment("1", 1))
+			watching <- resourceVersion
+			return true, w, nil
+		}
+		w, err := client.Tracker().Watch(appsv1.SchemeGroupVersion.WithResource("deployments"), action.GetNamespace())
+		watching <- resourceVersion
+		return true, w, err
+	})
+	cluster := &Cluster{Name: "test", Clientset: client}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var reports []*RolloutStatus
+	done := make(chan error, 1)
+	go func() {
+		done <- cluster.WaitForRollout(ctx, WorkloadRef{Resource: WorkloadDeployments, Namespace: "default", Name: "web"},
+			func(status *RolloutStatus) { reports = append(reports, status) })
+	}()
+
+	if resourceVersion := <-watching; resourceVersion != "1" {
+		t.Fatalf("watch from resource version %q, want 1, the version read", resourceVersion)
+	}
+	// Nothing changed: the rollout is not read again.
+	time.Sleep(50 * time.Millisecond)
+	if got := gets.Load(); got != 1 {
+		t.Fatalf("%d reads while the deployment did not change, want 1", got)
+	}
+
+	_, err := client.AppsV1().Deployments("default").UpdateStatus(ctx, rollingDeployment("2", 2), metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("WaitForRollout: %v", err)
+	}
+	if got := gets.Load(); got != 2 {
+		t.Errorf("%d reads, want 2: one before and one after the change", got)
+	}
+	if len(reports) != 2 || reports[0].Complete || !reports[1].Complete {
+		t.Errorf("reports = %+v, want in progress, then complete", reports)
+	}
+}
@@ -0,0 +1,164 @@
+// Package loadshed bounds the number of requests served at once. Requests
+// over the bound queue briefly; low priority requests that queue longer
+// than the configured delay are rejected, and while queueing is slow on
+// average they are rejected without queueing.
+package loadshed
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOverloaded is returned when a request is shed.
+var ErrOverloaded = errors.New("server overloaded")
+
+// Priority orders queued requests. High priority requests are admitted
+// first and never shed.
+type Priority int
+
+const (
+	Low Priority = iota
+	High
+)
+
+// String returns the metric label of p.
+func (p Priority) String() string {
+	if p == High {
+		return "high"
+	}
+	return "low"
+}
+
+// delayWeight is the weight of the latest queueing delay in the moving
+// average that decides whether to shed.
+const delayWeight = 0.1
+
+// Limiter admits up to a fixed number of requests at once.
+type Limiter struct {
+	max      int
+	maxDelay time.Duration
+
+	mu       sync.Mutex
+	inFlight int
+	// queues holds the waiters of each priority in arrival order.
+	queues [2]*list.List
+	// delay is the moving average of the queueing delay.
+	delay time.Duration
+}
+
+type waiter struct {
+	ready chan struct{}
+	// admitted is set under the lock when the waiter gets a slot.
+	admitted bool
+}
+
+// New creates a limiter admitting max requests at once and shedding low
+// priority requests that would queue longer than maxDelay.
+func New(max int, maxDelay time.Duration) *Limiter {
+	return &Limiter{
+		max:      max,
+		maxDelay: maxDelay,
+		queues:   [2]*list.List{list.New(), list.New()},
+	}
+}
+
+// Acquire waits for a slot and returns the function releasing it along
+// with how long the request queued. Low priority requests fail with
+// ErrOverloaded after queueing for the maximum delay, or at once while the
+// average delay is high. High priority requests wait until ctx is done.
+func (l *Limiter) Acquire(ctx context.Context, p Priority) (func(), time.Duration, error) {
+	l.mu.Lock()
+	// Release hands slots to waiters, so a free slot means an empty queue.
+	if l.inFlight < l.max {
+		l.inFlight++
+		l.observe(0)
+		l.mu.Unlock()
+		return l.release, 0, nil
+	}
+	// Queued requests give up after maxDelay, so the average stays below
+	// it; half of it means most requests queue for long.
+	if p == Low && l.delay >= l.maxDelay/2 {
+		l.mu.Unlock()
+		return nil, 0, ErrOverloaded
+	}
+	w := &waiter{ready: make(chan struct{})}
+	elem := l.queues[p].PushBack(w)
+	l.mu.Unlock()
+
+	start := time.Now()
+	var timeout <-chan time.Time
+	if p == Low {
+		timer := time.NewTimer(l.maxDelay)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case <-w.ready:
+		waited := time.Since(start)
+		l.mu.Lock()
+		l.observe(waited)
+		l.mu.Unlock()
+		return l.release, waited, nil
+	case <-timeout:
+		err = ErrOverloaded
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	waited := time.Since(start)
+	l.observe(waited)
+	if w.admitted {
+		// The slot arrived while giving up; take it after all.
+		return l.release, waited, nil
+	}
+	l.queues[p].Remove(elem)
+	return nil, waited, err
+}
+
+func (l *Limiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, q := range []*list.List{l.queues[High], l.queues[Low]} {
+		if front := q.Front(); front != nil {
+			w := q.Remove(front).(*waiter)
+			w.admitted = true
+			close(w.ready)
+			// The slot passes to the waiter; inFlight stays.
+			return
+		}
+	}
+	l.inFlight--
+	// An idle server has no queue; let the average decay so that shedding
+	// stops once load drops.
+	if l.inFlight == 0 {
+		l.delay = 0
+	}
+}
+
+// observe folds a queueing delay into the moving average. The caller holds
+// the lock.
+func (l *Limiter) observe(d time.Duration) {
+	l.delay = time.Duration((1-delayWeight)*float64(l.delay) + delayWeight*float64(d))
+}
+
+// Delay returns the moving average of the queueing delay, a hint of how
+// long a shed client should wait.
+func (l *Limiter) Delay() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.delay
+}
+
+// InFlight returns the number of admitted requests.
+func (l *Limiter) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight
+}
@@ -0,0 +1,139 @@
+package loadshed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func acquire(t *testing.T, l *Limiter, p Priority) func() {
+	t.Helper()
+	release, _, err := l.Acquire(context.Background(), p)
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	return release
+}
+
+// waitQueued waits until n requests of priority p are queued.
+func waitQueued(t *testing.T, l *Limiter, p Priority, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		l.mu.Lock()
+		queued := l.queues[p].Len()
+		l.mu.Unlock()
+		if queued == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d %s requests queued, want %d", queued, p, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestShedAfterMaxDelay(t *testing.T) {
+	l := New(2, 50*time.Millisecond)
+	defer acquire(t, l, Low)()
+	release := acquire(t, l, High)
+
+	_, waited, err := l.Acquire(context.Background(), Low)
+	if !errors.Is(err, ErrOverloaded) {
+		t.Fatalf("Acquire over the limit = %v, want ErrOverloaded", err)
+	}
+	if waited < 50*time.Millisecond {
+		t.Errorf("shed after %v, want after queueing 50ms", waited)
+	}
+
+	// A slot freed while queued is handed over.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		release()
+	}()
+	release, waited, err = l.Acquire(context.Background(), Low)
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	defer release()
+	if waited == 0 || waited >= 50*time.Millisecond {
+		t.Errorf("waited %v, want until the release", waited)
+	}
+	if got := l.InFlight(); got != 2 {
+		t.Errorf("InFlight = %d, want 2", got)
+	}
+}
+
+func TestHighPriorityFirst(t *testing.T) {
+	l := New(1, time.Second)
+	release := acquire(t, l, Low)
+
+	admitted := make(chan Priority, 2)
+	for _, p := range []Priority{Low, High} {
+		go func() {
+			release, _, err := l.Acquire(context.Background(), p)
+			if err != nil {
+				return
+			}
+			admitted <- p
+			release()
+		}()
+		waitQueued(t, l, p, 1)
+	}
+	release()
+
+	if first := <-admitted; first != High {
+		t.Errorf("admitted %s first, want high", first)
+	}
+	if second := <-admitted; second != Low {
+		t.Errorf("admitted %s second, want low", second)
+	}
+}
+
+func TestShedAtOnceWhileDelayHigh(t *testing.T) {
+	l := New(1, 20*time.Millisecond)
+	release := acquire(t, l, Low)
+
+	// Timeouts raise the average delay to half the maximum delay.
+	for l.Delay() < 10*time.Millisecond {
+		if _, _, err := l.Acquire(context.Background(), Low); !errors.Is(err, ErrOverloaded) {
+			t.Fatalf("Acquire = %v, want ErrOverloaded", err)
+		}
+	}
+	start := time.Now()
+	_, waited, err := l.Acquire(context.Background(), Low)
+	if !errors.Is(err, ErrOverloaded) || waited != 0 || time.Since(start) > 10*time.Millisecond {
+		t.Errorf("Acquire = %v after %v, want ErrOverloaded without queueing", err, waited)
+	}
+
+	// High priority requests still queue.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		release()
+	}()
+	release = acquire(t, l, High)
+
+	// An idle server forgets the delay.
+	release()
+	if got := l.Delay(); got != 0 {
+		t.Errorf("Delay = %v when idle, want 0", got)
+	}
+	acquire(t, l, Low)()
+}
+
+func TestHighPriorityIsNotShed(t *testing.T) {
+	l := New(1, 10*time.Millisecond)
+	defer acquire(t, l, Low)()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, waited, err := l.Acquire(ctx, High)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire = %v, want the context error", err)
+	}
+	if waited < 50*time.Millisecond {
+		t.Errorf("gave up after %v, want to wait for the context", waited)
+	}
+	waitQueued(t, l, High, 0)
+}
@@ -5,12 +5,10 @@ import (
 	"log/slog"
 )
 
-var (
-	loggerKey = &struct{}{}
-)
+type loggerKey struct{}
 
 func From(ctx context.Context) *slog.Logger {
-	logger, ok := ctx.Value(loggerKey).(*slog.Logger)
+	logger, ok := ctx.Value(loggerKey{}).(*slog.Logger)
 	if !ok {
 		return slog.Default()
 	}
@@ -18,5 +16,5 @@ func From(ctx context.Context) *slog.Logger {
 }
 
 func With(ctx context.Context, logger *slog.Logger) context.Context {
-	return context.WithValue(ctx, loggerKey, logger)
+	return context.WithValue(ctx, loggerKey{}, logger)
 }
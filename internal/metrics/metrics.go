@@ -0,0 +1,171 @@
+// Package metrics exposes Prometheus metrics of the server, which the admin
+// listener serves on /metrics.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry holds every metric of the server. It is separate from the
+// default registry so dependencies cannot add metrics behind our back.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iu_http_requests_total",
+		Help: "HTTP requests served by the public API, by route and status code.",
+	}, []string{"method", "route", "code"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "iu_http_request_duration_seconds",
+		Help:    "Latency of HTTP requests served by the public API, by route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	httpInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "iu_http_requests_in_flight",
+		Help: "HTTP requests of the public API currently being served.",
+	})
+
+	rateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iu_rate_limited_requests_total",
+		Help: "Requests rejected by the rate limit, by operation.",
+	}, []string{"operation"})
+
+	admitted = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "iu_admitted_requests",
+		Help: "Requests holding a slot of the in-flight limit, by priority.",
+	}, []string{"priority"})
+
+	queueDelay = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "iu_request_queue_duration_seconds",
+		Help:    "Time requests waited for a slot of the in-flight limit, by priority.",
+		Buckets: []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"priority"})
+
+	shed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iu_shed_requests_total",
+		Help: "Requests rejected because the server was overloaded, by priority.",
+	}, []string{"priority"})
+
+	timedOut = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iu_request_timeouts_total",
+		Help: "Requests that exceeded their deadline, by operation.",
+	}, []string{"operation"})
+
+	panics = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iu_panics_total",
+		Help: "Panics recovered in request handlers and background tasks, by source.",
+	}, []string{"source"})
+
+	notifications = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iu_notifications_total",
+		Help: "Notification delivery attempts, by sink and outcome: delivered, retried or dead_lettered.",
+	}, []string{"sink", "outcome"})
+
+	eventsCollected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iu_events_collected_total",
+		Help: "Kubernetes events received by the collector, by cluster and outcome: saved or failed.",
+	}, []string{"cluster", "outcome"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		httpInFlight,
+		rateLimited,
+		admitted,
+		queueDelay,
+		shed,
+		timedOut,
+		panics,
+		notifications,
+		eventsCollected,
+	)
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Middleware records the requests of a chi router. Requests are labelled
+// with the route pattern rather than the path so that resource names do not
+// create a series each.
+func Middleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		httpInFlight.Inc()
+		defer httpInFlight.Dec()
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		start := time.Now()
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			// Hijacked connections and handlers that never wrote.
+			status = http.StatusOK
+		}
+		httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(status)).Inc()
+		httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	}
+	return http.HandlerFunc(fn)
+}
+
+// RateLimited counts a request to operationID rejected by the rate limit.
+// Requests matching no operation have an empty operationID.
+func RateLimited(operationID string) {
+	rateLimited.WithLabelValues(operationID).Inc()
+}
+
+// Admitted counts a request of priority holding a slot of the in-flight
+// limit after queueing for waited. Call the returned function when it
+// releases the slot.
+func Admitted(priority string, waited time.Duration) func() {
+	queueDelay.WithLabelValues(priority).Observe(waited.Seconds())
+	gauge := admitted.WithLabelValues(priority)
+	gauge.Inc()
+	return gauge.Dec
+}
+
+// Shed counts a request of priority rejected after queueing for waited.
+func Shed(priority string, waited time.Duration) {
+	queueDelay.WithLabelValues(priority).Observe(waited.Seconds())
+	shed.WithLabelValues(priority).Inc()
+}
+
+// TimedOut counts a request to operationID that exceeded its deadline.
+func TimedOut(operationID string) {
+	timedOut.WithLabelValues(operationID).Inc()
+}
+
+// Panicked counts a panic recovered in source.
+func Panicked(source string) {
+	panics.WithLabelValues(source).Inc()
+}
+
+// Notified counts a notification delivery attempt to sink with outcome.
+func Notified(sink, outcome string) {
+	notifications.WithLabelValues(sink, outcome).Inc()
+}
+
+// EventCollected counts a Kubernetes event of cluster received by the
+// collector with outcome.
+func EventCollected(cluster, outcome string) {
+	eventsCollected.WithLabelValues(cluster, outcome).Inc()
+}
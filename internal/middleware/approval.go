@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/approval"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+)
+
+// Approval returns a strict middleware holding back the operations the
+// policy marks as needing approval. Such a request does not run; it is
+// stored as a pending approval, which pending answers, and runs as its
+// requester once another principal approves it. authorize checks the
+// caller may perform the operation first; nothing is stored when it fails.
+// Raw request bodies are read up to maxBodySize, the largest any operation
+// accepts, to store them.
+func Approval(policy *auth.Policy, approvals *approval.Manager, maxBodySize int,
+	authorize func(ctx context.Context, request interface{}, body []byte) error,
+	pending func(w http.ResponseWriter, r *http.Request, a *approval.Approval)) api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		operationID = strings.ToLower(operationID[:1]) + operationID[1:]
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			if len(policy.Approvals) == 0 || !mutating(r.Method) {
+				return f(ctx, w, r, request)
+			}
+
+			request, body, err := bufferBody(request, maxBodySize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read request: %w", err)
+			}
+			// The raw body is stored apart from the request.
+			data, err := json.Marshal(withoutBody(request))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode request: %w", err)
+			}
+			params := requestParameters(data)
+			if !policy.RequiresApproval(operationID, params) {
+				return f(ctx, w, r, request)
+			}
+
+			if err := authorize(ctx, request, body); err != nil {
+				return nil, err
+			}
+
+			target := approvalTarget(params)
+			a, err := approvals.Request(ctx, approval.Approval{
+				OperationID: operationID,
+				Target:      target,
+				Summary:     r.Method + " " + r.URL.RequestURI(),
+				Request:     data,
+				Body:        body,
+				Requester:   *auth.From(ctx),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to request approval: %w", err)
+			}
+			audit.Annotate(ctx, func(req *audit.Request) {
+				req.Entry.Action = "approvals.request"
+				req.Entry.Target = target
+				req.Entry.Details = map[string]any{"approvalId": a.ID}
+			})
+			pending(w, r, a)
+			return nil, nil
+		}
+	}
+}
+
+// withoutBody returns a copy of a request object of the generated strict
+// handlers without its raw body.
+func withoutBody(request interface{}) interface{} {
+	v := reflect.ValueOf(request)
+	if v.Kind() != reflect.Struct {
+		return request
+	}
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	for i := range copied.NumField() {
+		if field := copied.Field(i); field.Type() == readerType {
+			field.Set(reflect.Zero(readerType))
+		}
+	}
+	return copied.Interface()
+}
+
+// requestParameters flattens a request object encoded as JSON into its
+// path parameters, and the query parameters and body fields, which do not
+// override them.
+func requestParameters(data []byte) map[string]any {
+	params := map[string]any{}
+	if err := json.Unmarshal(data, &params); err != nil {
+		return params
+	}
+	for _, nested := range []string{"Params", "Body"} {
+		fields, _ := params[nested].(map[string]any)
+		delete(params, nested)
+		for name, value := range fields {
+			if _, ok := params[name]; !ok {
+				params[name] = value
+			}
+		}
+	}
+	return params
+}
+
+// approvalTarget names the object an operation acts on from its parameters.
+func approvalTarget(params map[string]any) audit.Target {
+	var target audit.Target
+	target.Cluster, _ = params["cluster"].(string)
+	target.Namespace, _ = params["namespace"].(string)
+	if target.Resource, _ = params["workload"].(string); target.Resource != "" {
+		target.Name, _ = params["name"].(string)
+	} else if target.Name, _ = params["node"].(string); target.Name != "" {
+		target.Resource = "nodes"
+	}
+	return target
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/log"
+)
+
+// Audit records an audit entry for every request that may change state:
+// any method but GET, HEAD and OPTIONS, and reads that handlers mark as
+// audited. Handlers describe the action through audit.Annotate; the
+// middleware records who called, from where, and how the request ended. It
+// must run after Authenticate and RealIP.
+func Audit(recorder audit.Recorder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			started := time.Now()
+			req := &audit.Request{Entry: audit.Entry{
+				Time:      started,
+				Principal: auth.From(r.Context()).Name,
+				SourceIP:  sourceIP(r.RemoteAddr),
+				RequestID: GetReqID(r.Context()),
+				Summary:   r.Method + " " + r.URL.RequestURI(),
+			}}
+
+			rw := &responseWriter{ResponseWriter: w}
+			next.ServeHTTP(rw, r.WithContext(audit.WithRequest(r.Context(), req)))
+
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				if !req.Always {
+					return
+				}
+			}
+
+			entry := req.Entry
+			entry.Duration = time.Since(started)
+			if entry.Action == "" {
+				entry.Action = entry.OperationID
+			}
+			status := rw.statusCode
+			if status == 0 {
+				status = http.StatusOK
+			}
+			switch {
+			case entry.Outcome != "":
+				// Set by the handler, e.g. for a batch that partly failed.
+			case status < http.StatusBadRequest:
+				entry.Outcome = audit.OutcomeSuccess
+			case status == http.StatusUnauthorized, status == http.StatusForbidden:
+				entry.Outcome = audit.OutcomeDenied
+			default:
+				entry.Outcome = audit.OutcomeFailure
+			}
+			if entry.Outcome != audit.OutcomeSuccess && entry.Error == "" {
+				entry.Error = http.StatusText(status)
+			}
+
+			// Record requests the client gave up on as well.
+			ctx := context.WithoutCancel(r.Context())
+			if err := recorder.Record(ctx, entry); err != nil {
+				log.From(ctx).Error("failed to record audit entry", "error", err, "action", entry.Action)
+			}
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// AuditOperation names the API operation in the audit entry of a request
+// served by the generated strict handlers.
+func AuditOperation(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+	// The generated handlers pass the Go method name; the OpenAPI
+	// operationId starts in lower case.
+	operationID = strings.ToLower(operationID[:1]) + operationID[1:]
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		audit.Annotate(ctx, func(req *audit.Request) {
+			req.Entry.OperationID = operationID
+		})
+		return f(ctx, w, r, request)
+	}
+}
+
+// sourceIP strips the port RealIP leaves on addresses it did not rewrite.
+func sourceIP(remoteAddr string) string {
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+	return remoteAddr
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/log"
+)
+
+// Authenticate resolves the bearer token of a request into a principal and
+// stores it in the request context. Without a bearer token, the subject of
+// a verified TLS client certificate is the principal. Requests without
+// credentials proceed as the anonymous principal; authorization decides
+// what they may do.
+func Authenticate(authn auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok || authn == nil {
+				principal := auth.Anonymous()
+				if cert := clientCertificate(r); cert != nil {
+					principal = auth.FromCertificate(cert)
+				}
+				ctx := auth.With(r.Context(), principal)
+				if !principal.IsAnonymous() {
+					ctx = log.With(ctx, log.From(ctx).With("principal", principal.Name))
+				}
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			principal, err := authn.AuthenticateToken(r.Context(), token)
+			if err != nil {
+				log.From(r.Context()).Debug("authentication failed", "error", err)
+				render.Status(r, http.StatusUnauthorized)
+				render.JSON(w, r, api.ErrorResponse{
+					Error:     "unauthorized",
+					Message:   "invalid bearer token",
+					Timestamp: ptr(time.Now()),
+					RequestId: requestID(r),
+				})
+				return
+			}
+
+			ctx := auth.With(r.Context(), principal)
+			ctx = log.With(ctx, log.From(ctx).With("principal", principal.Name))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// RequireAuthenticated rejects anonymous requests, except those for the
+// exempt paths such as probes. It runs after Authenticate.
+func RequireAuthenticated(exempt ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if auth.From(r.Context()).IsAnonymous() && !slices.Contains(exempt, r.URL.Path) {
+				render.Status(r, http.StatusUnauthorized)
+				render.JSON(w, r, api.ErrorResponse{
+					Error:     "unauthorized",
+					Message:   "authentication required",
+					Timestamp: ptr(time.Now()),
+					RequestId: requestID(r),
+				})
+				return
+			}
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// clientCertificate returns the leaf of the verified client certificate
+// chain, or nil when the client presented none or it names no user.
+func clientCertificate(r *http.Request) *x509.Certificate {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	if cert.Subject.CommonName == "" {
+		return nil
+	}
+	return cert
+}
+
+// webSocketTokenProtocolPrefix carries a bearer token in the WebSocket
+// subprotocol list for browsers, which cannot set an Authorization header on
+// WebSocket requests. This is the same convention the Kubernetes API server uses.
+const webSocketTokenProtocolPrefix = "base64url.bearer.authorization.k8s.io."
+
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if token, ok := strings.CutPrefix(header, "Bearer "); ok && token != "" {
+		return token, true
+	}
+
+	for _, protocols := range r.Header.Values("Sec-WebSocket-Protocol") {
+		for _, protocol := range strings.Split(protocols, ",") {
+			encoded, ok := strings.CutPrefix(strings.TrimSpace(protocol), webSocketTokenProtocolPrefix)
+			if !ok {
+				continue
+			}
+			token, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encoded, "="))
+			if err == nil && len(token) > 0 {
+				return string(token), true
+			}
+		}
+	}
+	return "", false
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,248 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/klauspost/compress/zstd"
+)
+
+// encoder is a pooled compressor.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// encoders pool the compressors of each content coding the server offers,
+// in order of preference.
+var encoders = []struct {
+	name string
+	pool *sync.Pool
+}{
+	{"zstd", &sync.Pool{New: func() any {
+		// Concurrency 1 compresses on the handler goroutine, as gzip does.
+		enc, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		return enc
+	}}},
+	{"gzip", &sync.Pool{New: func() any {
+		return gzip.NewWriter(nil)
+	}}},
+}
+
+// Compress returns a middleware compressing responses with the content
+// coding the client prefers among zstd and gzip. Only responses of one of
+// types, a list of media types where type/* matches every subtype, and of
+// at least minSize bytes are compressed; a handler that flushes before
+// writing minSize bytes is streaming, and its response is compressed too.
+// Event streams, streaming operations, WebSocket sessions included, and
+// responses that already have a content coding pass through untouched.
+// routes is the router the middleware is used on.
+func Compress(minSize int, types []string, routes chi.Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if encoding < 0 || r.Method == http.MethodHead || isLongLived(operationOf(routes, r)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			cw := &compressWriter{ResponseWriter: w, encoding: encoding, minSize: minSize, types: types}
+			defer cw.close()
+			next.ServeHTTP(cw, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// negotiateEncoding returns the index in encoders of the coding accept
+// ranks highest, or -1 if it accepts none of them.
+func negotiateEncoding(accept string) int {
+	if accept == "" {
+		return -1
+	}
+	qualities := map[string]float64{}
+	for _, part := range strings.Split(accept, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+		qualities[strings.ToLower(strings.TrimSpace(coding))] = q
+	}
+
+	best, bestQ := -1, 0.0
+	for i, e := range encoders {
+		q, ok := qualities[e.name]
+		if !ok {
+			q = qualities["*"]
+		}
+		if q > bestQ {
+			best, bestQ = i, q
+		}
+	}
+	return best
+}
+
+// compressWriter holds the start of a response back until it knows whether
+// to compress it.
+type compressWriter struct {
+	http.ResponseWriter
+	encoding int
+	minSize  int
+	types    []string
+
+	// status is the status code held back until the decision.
+	status int
+	// decided is set once the header is sent, and enc once it was sent
+	// with a content coding.
+	decided bool
+	enc     encoder
+	buf     []byte
+}
+
+func (w *compressWriter) WriteHeader(statusCode int) {
+	if w.decided || w.status != 0 {
+		return
+	}
+	if statusCode < http.StatusOK {
+		// Informational responses precede the real one.
+		w.ResponseWriter.WriteHeader(statusCode)
+		return
+	}
+	w.status = statusCode
+	if !w.compressible() {
+		_ = w.decide(false)
+	}
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.decided {
+		w.buf = append(w.buf, b...)
+		if len(w.buf) < w.minSize {
+			return len(b), nil
+		}
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	if w.enc != nil {
+		return w.enc.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush sends what the handler wrote so far, compressed if the response is
+// compressible at all.
+func (w *compressWriter) Flush() {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.decided {
+		if err := w.decide(true); err != nil {
+			return
+		}
+	}
+	if w.enc != nil {
+		if err := w.enc.Flush(); err != nil {
+			return
+		}
+	}
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying ResponseWriter for http.ResponseController.
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// compressible reports whether the response so far may be compressed.
+func (w *compressWriter) compressible() bool {
+	header := w.Header()
+	switch {
+	case w.status == http.StatusNoContent, w.status == http.StatusNotModified, w.status == http.StatusPartialContent:
+		return false
+	case header.Get("Content-Encoding") != "", header.Get("Content-Range") != "":
+		return false
+	}
+	if length, err := strconv.Atoi(header.Get("Content-Length")); err == nil && length < w.minSize {
+		return false
+	}
+	return w.compressibleType()
+}
+
+// compressibleType reports whether the content type of the response is one
+// of the types compressed.
+func (w *compressWriter) compressibleType() bool {
+	mediaType, _, err := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	if err != nil || mediaType == "text/event-stream" {
+		return false
+	}
+	for _, t := range w.types {
+		if t == mediaType || strings.HasSuffix(t, "/*") && strings.HasPrefix(mediaType, t[:len(t)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// decide sends the header held back, with a content coding if compress is
+// set and the response is compressible, followed by the body buffered.
+func (w *compressWriter) decide(compress bool) error {
+	w.decided = true
+	if compress && w.compressible() {
+		header := w.Header()
+		header.Del("Content-Length")
+		header.Set("Content-Encoding", encoders[w.encoding].name)
+		header.Add("Vary", "Accept-Encoding")
+		w.enc = encoders[w.encoding].pool.Get().(encoder)
+		w.enc.Reset(w.ResponseWriter)
+	} else if w.compressibleType() {
+		// Larger bodies of the same resource would be compressed.
+		w.Header().Add("Vary", "Accept-Encoding")
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if w.enc != nil {
+		_, err = w.enc.Write(buf)
+	} else {
+		_, err = w.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+// close ends the response once the handler returns, sending a body shorter
+// than the minimum size as is.
+func (w *compressWriter) close() {
+	if w.status == 0 {
+		// The handler wrote nothing, or hijacked the connection.
+		return
+	}
+	if !w.decided {
+		_ = w.decide(false)
+	}
+	if w.enc != nil {
+		_ = w.enc.Close()
+		w.enc.Reset(nil)
+		encoders[w.encoding].pool.Put(w.enc)
+		w.enc = nil
+	}
+}
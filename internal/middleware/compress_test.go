@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/klauspost/compress/zstd"
+)
+
+// newCompressed returns a router compressing bodies of at least 64 bytes of
+// JSON and text, as serve.go does, whose routes respond with body.
+func newCompressed(contentType, body string) http.Handler {
+	r := chi.NewRouter()
+	r.Use(Compress(64, []string{"application/json", "text/*"}, r))
+	r.Use(Conditional(r))
+	respond := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = io.WriteString(w, body)
+	}
+	r.Get("/api/v1/clusters", respond)
+	r.Post("/api/v1/clusters/{cluster}/apply", respond)
+	r.Get("/api/v1/clusters/{cluster}/watch/{resource}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = io.WriteString(w, body)
+		w.(http.Flusher).Flush()
+	})
+	r.Get("/stream", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = io.WriteString(w, "{")
+		w.(http.Flusher).Flush()
+		_, _ = io.WriteString(w, "}")
+	})
+	return r
+}
+
+// decode returns the body of rec decoded from its content coding.
+func decode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	var r io.Reader = rec.Body
+	switch coding := rec.Header().Get("Content-Encoding"); coding {
+	case "":
+	case "gzip":
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			t.Fatalf("gzip: %v", err)
+		}
+		r = zr
+	case "zstd":
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			t.Fatalf("zstd: %v", err)
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		t.Fatalf("unexpected Content-Encoding %q", coding)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("decoding %s: %v", rec.Header().Get("Content-Encoding"), err)
+	}
+	return string(b)
+}
+
+func TestCompress(t *testing.T) {
+	large := `{"items":["` + strings.Repeat("a", 100) + `"]}`
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		acceptEncoding string
+		contentType    string
+		body           string
+		wantEncoding   string
+		wantVary       bool
+	}{
+		{"gzip", http.MethodGet, "/api/v1/clusters", "gzip", "application/json", large, "gzip", true},
+		{"zstd preferred", http.MethodGet, "/api/v1/clusters", "gzip, zstd", "application/json", large, "zstd", true},
+		{"by quality", http.MethodGet, "/api/v1/clusters", "zstd;q=0.5, gzip", "application/json", large, "gzip", true},
+		{"wildcard", http.MethodGet, "/api/v1/clusters", "*", "application/json", large, "zstd", true},
+		{"refused", http.MethodGet, "/api/v1/clusters", "gzip;q=0, zstd;q=0", "application/json", large, "", false},
+		{"unsupported coding", http.MethodGet, "/api/v1/clusters", "br", "application/json", large, "", false},
+		{"no Accept-Encoding", http.MethodGet, "/api/v1/clusters", "", "application/json", large, "", false},
+		{"subtype wildcard", http.MethodGet, "/api/v1/clusters", "gzip", "text/plain; charset=utf-8", large, "gzip", true},
+		{"below the minimum size", http.MethodGet, "/api/v1/clusters", "gzip", "application/json", `{}`, "", true},
+		{"type not compressed", http.MethodGet, "/api/v1/clusters", "gzip", "application/octet-stream", large, "", false},
+		{"POST", http.MethodPost, "/api/v1/clusters/c/apply", "gzip", "application/json", large, "gzip", true},
+		{"streaming operation", http.MethodGet, "/api/v1/clusters/c/watch/pods", "gzip", "", large, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			rec := httptest.NewRecorder()
+			newCompressed(tt.contentType, tt.body).ServeHTTP(rec, req)
+
+			if got := rec.Header().Get("Content-Encoding"); got != tt.wantEncoding {
+				t.Errorf("Content-Encoding = %q, want %q", got, tt.wantEncoding)
+			}
+			if got := rec.Header().Get("Vary") == "Accept-Encoding"; got != tt.wantVary {
+				t.Errorf("Vary = %q, want Accept-Encoding: %v", rec.Header().Get("Vary"), tt.wantVary)
+			}
+			if got := decode(t, rec); got != tt.body {
+				t.Errorf("body = %q, want %q", got, tt.body)
+			}
+		})
+	}
+}
+
+func TestCompressFlushed(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	newCompressed("application/json", "").ServeHTTP(rec, req)
+
+	// A handler that flushes streams, so it is compressed before it wrote
+	// the minimum size.
+	if got := rec.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Errorf("Content-Encoding = %q, want gzip", got)
+	}
+	if got := decode(t, rec); got != "{}" {
+		t.Errorf("body = %q, want {}", got)
+	}
+	if rec.Header().Get("ETag") != "" {
+		t.Error("flushed response was tagged")
+	}
+}
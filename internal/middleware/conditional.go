@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// maxETagBodySize bounds the response bodies Conditional holds back to hash.
+// Larger responses are sent without an ETag.
+const maxETagBodySize = 1 << 20
+
+// Conditional returns a middleware giving successful GET responses an
+// ETag and answering requests whose If-None-Match lists it with 304 Not
+// Modified. Handlers that know the version of what they return, such as
+// the resource version of a Kubernetes object, set the ETag themselves;
+// other responses are tagged with a hash of their body. Responses that
+// flush and streaming operations, WebSocket sessions included, pass
+// through untagged. routes is the router the middleware is used on.
+func Conditional(routes chi.Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead || isLongLived(operationOf(routes, r)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ew := &etagWriter{ResponseWriter: w}
+			next.ServeHTTP(ew, r)
+			ew.finish(r.Header.Get("If-None-Match"))
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// etagWriter holds a response back until it is complete so that it can be
+// tagged.
+type etagWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+	// passed is set once the response went out without a tag.
+	passed bool
+}
+
+func (w *etagWriter) WriteHeader(statusCode int) {
+	switch {
+	case w.passed || w.status != 0:
+	case statusCode < http.StatusOK:
+		w.ResponseWriter.WriteHeader(statusCode)
+	case statusCode != http.StatusOK:
+		w.status = statusCode
+		w.pass()
+	default:
+		w.status = statusCode
+	}
+}
+
+func (w *etagWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.passed {
+		return w.ResponseWriter.Write(b)
+	}
+	if w.body.Len()+len(b) > maxETagBodySize {
+		if err := w.pass(); err != nil {
+			return 0, err
+		}
+		return w.ResponseWriter.Write(b)
+	}
+	return w.body.Write(b)
+}
+
+// Flush gives up on the tag: the handler streams its response.
+func (w *etagWriter) Flush() {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.passed {
+		if err := w.pass(); err != nil {
+			return
+		}
+	}
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying ResponseWriter for http.ResponseController.
+func (w *etagWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// pass sends the response held back as is and the rest as it comes.
+func (w *etagWriter) pass() error {
+	w.passed = true
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.body.Len() == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(w.body.Bytes())
+	w.body.Reset()
+	return err
+}
+
+// finish tags the complete response and sends it, or 304 if ifNoneMatch
+// lists its tag.
+func (w *etagWriter) finish(ifNoneMatch string) {
+	if w.passed || w.status == 0 {
+		// Sent already, or the handler wrote nothing.
+		return
+	}
+	header := w.Header()
+	tag := header.Get("ETag")
+	if tag == "" {
+		sum := sha256.Sum256(w.body.Bytes())
+		tag = `"` + hex.EncodeToString(sum[:16]) + `"`
+		header.Set("ETag", tag)
+	}
+	if ifNoneMatch != "" && etagListed(ifNoneMatch, tag) {
+		header.Del("Content-Length")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+		return
+	}
+	_ = w.pass()
+}
+
+// etagListed reports whether list, the value of If-None-Match, contains tag
+// by the weak comparison the header calls for.
+func etagListed(list, tag string) bool {
+	tag = strings.TrimPrefix(tag, "W/")
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == tag {
+			return true
+		}
+	}
+	return false
+}
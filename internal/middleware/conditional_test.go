@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestConditional(t *testing.T) {
+	body := `{"items":["` + strings.Repeat("a", 100) + `"]}`
+	h := newCompressed("application/json", body)
+	send := func(acceptEncoding, ifNoneMatch string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/clusters", nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// The tag is a hash of the body before compression, so every coding
+	// shares it.
+	tag := send("", "").Header().Get("ETag")
+	if tag == "" {
+		t.Fatal("response was not tagged")
+	}
+	for _, coding := range []string{"gzip", "zstd"} {
+		rec := send(coding, "")
+		if got := rec.Header().Get("ETag"); got != tag || rec.Header().Get("Content-Encoding") != coding {
+			t.Errorf("%s: ETag %q with Content-Encoding %q, want %q", coding, got, rec.Header().Get("Content-Encoding"), tag)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        int
+	}{
+		{"match", tag, http.StatusNotModified},
+		{"weak match", "W/" + tag, http.StatusNotModified},
+		{"in a list", `"other", ` + tag, http.StatusNotModified},
+		{"any", "*", http.StatusNotModified},
+		{"no match", `"other"`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := send("gzip", tt.ifNoneMatch)
+			if rec.Code != tt.want {
+				t.Fatalf("status %d, want %d", rec.Code, tt.want)
+			}
+			if rec.Code != http.StatusNotModified {
+				return
+			}
+			if rec.Body.Len() != 0 || rec.Header().Get("Content-Encoding") != "" {
+				t.Errorf("304 with %d bytes coded %q, want no body", rec.Body.Len(), rec.Header().Get("Content-Encoding"))
+			}
+			if got := rec.Header().Get("ETag"); got != tag {
+				t.Errorf("ETag = %q, want %q", got, tag)
+			}
+		})
+	}
+}
+
+func TestConditionalUntagged(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Conditional(r))
+	r.Get("/api/v1/clusters/{cluster}/namespaces/{namespace}/{workload}/{name}/status", func(w http.ResponseWriter, r *http.Request) {
+		// Handlers that know the version of what they return tag it.
+		w.Header().Set("ETag", `"42"`)
+		_, _ = io.WriteString(w, "{}")
+	})
+	r.Get("/api/v1/clusters", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no", http.StatusForbidden)
+	})
+	r.Get("/api/v1/clusters/{cluster}/watch/{resource}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "{}")
+	})
+	r.Post("/api/v1/clusters/{cluster}/apply", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "{}")
+	})
+
+	send := func(method, path, ifNoneMatch string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("If-None-Match", ifNoneMatch)
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	status := "/api/v1/clusters/c/namespaces/ns/deployments/web/status"
+	if rec := send(http.MethodGet, status, `"42"`); rec.Code != http.StatusNotModified {
+		t.Errorf("handler's own ETag: %d, want 304", rec.Code)
+	}
+	if rec := send(http.MethodGet, status, `"41"`); rec.Code != http.StatusOK || rec.Header().Get("ETag") != `"42"` {
+		t.Errorf("stale version: %d with ETag %q, want 200 with \"42\"", rec.Code, rec.Header().Get("ETag"))
+	}
+
+	for _, tt := range []struct{ name, method, path string }{
+		{"error", http.MethodGet, "/api/v1/clusters"},
+		{"streaming operation", http.MethodGet, "/api/v1/clusters/c/watch/pods"},
+		{"POST", http.MethodPost, "/api/v1/clusters/c/apply"},
+	} {
+		rec := send(tt.method, tt.path, "*")
+		if rec.Code == http.StatusNotModified || rec.Header().Get("ETag") != "" {
+			t.Errorf("%s: %d with ETag %q, want untagged", tt.name, rec.Code, rec.Header().Get("ETag"))
+		}
+	}
+}
+
+func TestConditionalLargeBody(t *testing.T) {
+	body := strings.Repeat("a", maxETagBodySize+1)
+	h := newCompressed("application/octet-stream", body)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/clusters", nil)
+	req.Header.Set("If-None-Match", "*")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != "" || rec.Body.String() != body {
+		t.Errorf("%d with ETag %q and %d bytes, want the body untagged", rec.Code, rec.Header().Get("ETag"), rec.Body.Len())
+	}
+}
@@ -0,0 +1,237 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/go-chi/render"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/idempotency"
+	"iu-k8s.linecorp.com/server/internal/log"
+)
+
+// maxIdempotencyKeyLength bounds the Idempotency-Key header.
+const maxIdempotencyKeyLength = 255
+
+var readerType = reflect.TypeFor[io.Reader]()
+
+// Idempotency returns a strict middleware that makes mutating operations
+// sent with an Idempotency-Key header safe to retry. The first response for
+// a key, caller and operation is stored for ttl and replayed to retries
+// with an Idempotent-Replayed header, including the 202 of an operation
+// held back for approval. A retry while the first request is
+// in progress gets 409 and a key reused with another request 422. Server
+// errors are not stored, so the retry runs again. Raw request bodies are
+// read up to maxBodySize, the largest any operation accepts, to compare
+// them.
+func Idempotency(store idempotency.Store, ttl time.Duration, maxBodySize int) api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		visit := "Visit" + operationID + "Response"
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			clientKey := r.Header.Get("Idempotency-Key")
+			if clientKey == "" || !mutating(r.Method) {
+				return f(ctx, w, r, request)
+			}
+			if len(clientKey) > maxIdempotencyKeyLength {
+				writeIdempotencyError(w, r, http.StatusBadRequest, "invalid_idempotency_key",
+					fmt.Sprintf("Idempotency-Key is longer than %d characters", maxIdempotencyKeyLength))
+				return nil, nil
+			}
+
+			request, fingerprint, err := fingerprintRequest(request, maxBodySize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read request: %w", err)
+			}
+			key := hashKey(auth.From(ctx), operationID, clientKey)
+
+			now := time.Now()
+			lockedUntil := now.Add(ttl)
+			if deadline, ok := ctx.Deadline(); ok && deadline.Before(lockedUntil) {
+				lockedUntil = deadline
+			}
+			stored, err := store.Reserve(ctx, key, fingerprint, now, lockedUntil)
+			switch {
+			case errors.Is(err, idempotency.ErrInProgress):
+				writeIdempotencyError(w, r, http.StatusConflict, "idempotency_key_in_use", err.Error())
+				return nil, nil
+			case errors.Is(err, idempotency.ErrMismatch):
+				writeIdempotencyError(w, r, http.StatusUnprocessableEntity, "idempotency_key_reused", err.Error())
+				return nil, nil
+			case err != nil:
+				return nil, fmt.Errorf("failed to check idempotency key: %w", err)
+			case stored != nil:
+				// Nothing runs again, so the audit entry of a replay only
+				// says which response was sent.
+				audit.Annotate(ctx, func(req *audit.Request) {
+					req.Entry.Details = map[string]any{"idempotentReplay": true}
+				})
+				replay(w, stored)
+				return nil, nil
+			}
+
+			// Release the key unless a response is stored, including when
+			// the handler panics.
+			completed := false
+			defer func() {
+				if !completed {
+					if err := store.Release(context.WithoutCancel(ctx), key, fingerprint); err != nil {
+						log.From(ctx).Warn("failed to release idempotency key", "error", err)
+					}
+				}
+			}()
+
+			// Inner middlewares answering in place of the handler, such as
+			// Approval with its 202, write to rec too.
+			rec := &responseRecorder{header: http.Header{}}
+			response, err := f(ctx, rec, r, request)
+			if err != nil {
+				return nil, err
+			}
+			if response != nil {
+				method := reflect.ValueOf(response).MethodByName(visit)
+				if !method.IsValid() {
+					return response, nil
+				}
+				if out := method.Call([]reflect.Value{reflect.ValueOf(rec)}); !out[0].IsNil() {
+					return nil, out[0].Interface().(error)
+				}
+			} else if rec.status == 0 && rec.body.Len() == 0 {
+				// Nothing was written; nothing to store.
+				return nil, nil
+			}
+			if rec.status == 0 {
+				rec.status = http.StatusOK
+			}
+			if rec.status < http.StatusInternalServerError {
+				resp := idempotency.Response{Status: rec.status, Header: rec.header, Body: rec.body.Bytes()}
+				if err := store.Complete(context.WithoutCancel(ctx), key, fingerprint, resp, time.Now().Add(ttl)); err != nil {
+					log.From(ctx).Warn("failed to store idempotent response", "error", err)
+				} else {
+					completed = true
+				}
+			}
+			for name, values := range rec.header {
+				w.Header()[name] = values
+			}
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+			return nil, nil
+		}
+	}
+}
+
+func mutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// hashKey scopes the client's key to the caller, by name and by how it
+// authenticated as auth.Principal.Same compares them, and the operation.
+func hashKey(principal *auth.Principal, operationID, clientKey string) string {
+	sum := sha256.Sum256([]byte(principal.Name + "\x00" + principal.Source + "\x00" + operationID + "\x00" + clientKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// fingerprintRequest hashes the parameters and body of a request object of
+// the generated strict handlers. Raw bodies are read to hash them, so the
+// returned request, which replaces them with the bytes read, must be served
+// instead.
+func fingerprintRequest(request interface{}, maxBodySize int) (interface{}, string, error) {
+	request, body, err := bufferBody(request, maxBodySize)
+	if err != nil {
+		return nil, "", err
+	}
+	h := sha256.New()
+	h.Write(body)
+	if err := json.NewEncoder(h).Encode(request); err != nil {
+		return nil, "", err
+	}
+	return request, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// bufferBody reads the raw body of a request object of the generated strict
+// handlers, if it has one, and returns a copy of the request reading the
+// bytes read instead. Reading stops one byte past maxBodySize, enough for
+// the handler to reject the body as too large.
+func bufferBody(request interface{}, maxBodySize int) (interface{}, []byte, error) {
+	v := reflect.ValueOf(request)
+	if v.Kind() != reflect.Struct {
+		return request, nil, nil
+	}
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	var body []byte
+	for i := range copied.NumField() {
+		field := copied.Field(i)
+		if field.Type() != readerType || field.IsNil() {
+			continue
+		}
+		var err error
+		body, err = io.ReadAll(io.LimitReader(field.Interface().(io.Reader), int64(maxBodySize)+1))
+		if err != nil {
+			return nil, nil, err
+		}
+		field.Set(reflect.ValueOf(bytes.NewReader(body)))
+	}
+	return copied.Interface(), body, nil
+}
+
+// replay writes a stored response.
+func replay(w http.ResponseWriter, stored *idempotency.Response) {
+	for name, values := range stored.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(stored.Status)
+	_, _ = w.Write(stored.Body)
+}
+
+func writeIdempotencyError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	render.Status(r, status)
+	render.JSON(w, r, api.ErrorResponse{
+		Error:     code,
+		Message:   message,
+		Timestamp: ptr(time.Now()),
+		RequestId: requestID(r),
+	})
+}
+
+// responseRecorder captures the response a strict handler visits so that it
+// can be stored before it is sent.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *responseRecorder) Header() http.Header {
+	return rec.header
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.body.Write(b)
+}
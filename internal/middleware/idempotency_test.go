@@ -0,0 +1,266 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/approval"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/idempotency"
+)
+
+// auditLog keeps the entries recorded by the Audit middleware.
+type auditLog struct {
+	mu      sync.Mutex
+	entries []audit.Entry
+}
+
+func (l *auditLog) Record(ctx context.Context, entry audit.Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func (l *auditLog) last() audit.Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.entries[len(l.entries)-1]
+}
+
+// scaler serves ScaleWorkload, answering 202 with an operation numbered by
+// the call, or the status of fail. Other operations are not implemented.
+type scaler struct {
+	api.StrictServerInterface
+	mu    sync.Mutex
+	calls int
+	// started receives a value when a call starts, and the call then waits
+	// for release, if set.
+	started chan struct{}
+	release chan struct{}
+	fail    int
+}
+
+func (s *scaler) ScaleWorkload(ctx context.Context, request api.ScaleWorkloadRequestObject) (api.ScaleWorkloadResponseObject, error) {
+	s.mu.Lock()
+	s.calls++
+	n := s.calls
+	s.mu.Unlock()
+	if s.started != nil {
+		s.started <- struct{}{}
+		<-s.release
+	}
+	audit.Annotate(ctx, func(req *audit.Request) { req.Entry.Action = "deployments.scale" })
+	if s.fail != 0 {
+		return nil, fmt.Errorf("scale failed with %d", s.fail)
+	}
+	return api.ScaleWorkload202JSONResponse{OperationAcceptedJSONResponse: api.OperationAcceptedJSONResponse{
+		Body:    api.Operation{Id: fmt.Sprint("op-", n), Status: api.OperationStatusRunning},
+		Headers: api.OperationAcceptedResponseHeaders{Location: fmt.Sprint("/api/v1/operations/op-", n)},
+	}}, nil
+}
+
+func (s *scaler) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+// newIdempotentServer serves s with the middleware of the server, and
+// inner, the middlewares serve.go runs inside Idempotency.
+func newIdempotentServer(t *testing.T, s *scaler, inner ...api.StrictMiddlewareFunc) (*httptest.Server, *auditLog) {
+	t.Helper()
+	log := &auditLog{}
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal := &auth.Principal{Name: r.Header.Get("X-Test-User"), Source: auth.SourceToken}
+			if source := r.Header.Get("X-Test-Source"); source != "" {
+				principal.Source = source
+			}
+			next.ServeHTTP(w, r.WithContext(auth.With(r.Context(), principal)))
+		})
+	})
+	r.Use(Audit(log))
+	si := api.NewStrictHandlerWithOptions(s, append(inner,
+		Idempotency(idempotency.NewMemory(), time.Hour, 1<<20),
+		AuditOperation,
+	), api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	})
+	api.HandlerWithOptions(si, api.ChiServerOptions{BaseRouter: r})
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return srv, log
+}
+
+func scale(t *testing.T, srv *httptest.Server, user, key string, replicas int) (*http.Response, string) {
+	t.Helper()
+	resp, body, err := sendScale(srv, user, key, replicas, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, body
+}
+
+func sendScale(srv *httptest.Server, user, key string, replicas int, header http.Header) (*http.Response, string, error) {
+	body := fmt.Sprintf(`{"replicas": %d}`, replicas)
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/api/v1/clusters/dev/namespaces/shop/deployments/web/scale", strings.NewReader(body))
+	if err != nil {
+		return nil, "", err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Test-User", user)
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	out, err := io.ReadAll(resp.Body)
+	return resp, string(out), err
+}
+
+func TestIdempotentReplay(t *testing.T) {
+	s := &scaler{}
+	srv, log := newIdempotentServer(t, s)
+
+	first, firstBody := scale(t, srv, "alice", "key-1", 3)
+	if first.StatusCode != http.StatusAccepted || first.Header.Get("Idempotent-Replayed") != "" {
+		t.Fatalf("first request: %d replayed=%q", first.StatusCode, first.Header.Get("Idempotent-Replayed"))
+	}
+	retry, retryBody := scale(t, srv, "alice", "key-1", 3)
+	if retry.StatusCode != http.StatusAccepted || retry.Header.Get("Idempotent-Replayed") != "true" {
+		t.Errorf("retry: %d replayed=%q, want the replayed 202", retry.StatusCode, retry.Header.Get("Idempotent-Replayed"))
+	}
+	if retryBody != firstBody || retry.Header.Get("Location") != first.Header.Get("Location") {
+		t.Errorf("retry got %s at %s, want %s at %s", retryBody, retry.Header.Get("Location"), firstBody, first.Header.Get("Location"))
+	}
+	if got := s.callCount(); got != 1 {
+		t.Errorf("handler ran %d times, want once", got)
+	}
+
+	// The replay is audited under its operation.
+	entry := log.last()
+	if entry.OperationID != "scaleWorkload" || entry.Outcome != audit.OutcomeSuccess {
+		t.Errorf("replay audited as %q with %q, want scaleWorkload and success", entry.OperationID, entry.Outcome)
+	}
+	if entry.Details["idempotentReplay"] != true {
+		t.Errorf("replay details = %v, want idempotentReplay", entry.Details)
+	}
+
+	// Keys are scoped to the caller, and requests without one always run.
+	if resp, _ := scale(t, srv, "bob", "key-1", 3); resp.Header.Get("Idempotent-Replayed") != "" {
+		t.Error("replayed the response of another caller")
+	}
+	certificate := http.Header{"X-Test-Source": {auth.SourceCertificate}}
+	if resp, _, err := sendScale(srv, "alice", "key-1", 3, certificate); err != nil || resp.Header.Get("Idempotent-Replayed") != "" {
+		t.Errorf("replayed the response of alice's token to alice's certificate (%v)", err)
+	}
+	scale(t, srv, "alice", "", 3)
+	scale(t, srv, "alice", "", 3)
+	if got := s.callCount(); got != 5 {
+		t.Errorf("handler ran %d times, want 5", got)
+	}
+}
+
+func TestIdempotencyConflicts(t *testing.T) {
+	s := &scaler{started: make(chan struct{}), release: make(chan struct{})}
+	srv, log := newIdempotentServer(t, s)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _, _ = sendScale(srv, "alice", "key-1", 3, nil)
+	}()
+	<-s.started
+
+	resp, body := scale(t, srv, "alice", "key-1", 3)
+	if resp.StatusCode != http.StatusConflict || !strings.Contains(body, "idempotency_key_in_use") {
+		t.Errorf("retry in progress: %d %s, want 409 idempotency_key_in_use", resp.StatusCode, body)
+	}
+	if entry := log.last(); entry.OperationID != "scaleWorkload" || entry.Outcome != audit.OutcomeFailure {
+		t.Errorf("conflict audited as %q with %q, want scaleWorkload and failure", entry.OperationID, entry.Outcome)
+	}
+	close(s.release)
+	<-done
+
+	resp, body = scale(t, srv, "alice", "key-1", 5)
+	if resp.StatusCode != http.StatusUnprocessableEntity || !strings.Contains(body, "idempotency_key_reused") {
+		t.Errorf("reused key: %d %s, want 422 idempotency_key_reused", resp.StatusCode, body)
+	}
+	if entry := log.last(); entry.OperationID != "scaleWorkload" {
+		t.Errorf("reused key audited as %q, want scaleWorkload", entry.OperationID)
+	}
+	if got := s.callCount(); got != 1 {
+		t.Errorf("handler ran %d times, want once", got)
+	}
+
+	long := strings.Repeat("k", maxIdempotencyKeyLength+1)
+	if resp, _ := scale(t, srv, "alice", long, 3); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("overlong key: %d, want 400", resp.StatusCode)
+	}
+}
+
+func TestIdempotencyDoesNotStoreServerErrors(t *testing.T) {
+	s := &scaler{fail: http.StatusInternalServerError}
+	srv, _ := newIdempotentServer(t, s)
+
+	for range 2 {
+		if resp, _ := scale(t, srv, "alice", "key-1", 3); resp.StatusCode != http.StatusInternalServerError {
+			t.Fatalf("status %d, want 500", resp.StatusCode)
+		}
+	}
+	if got := s.callCount(); got != 2 {
+		t.Errorf("handler ran %d times, want the retry to run again", got)
+	}
+}
+
+func TestIdempotencyStoresPendingApprovals(t *testing.T) {
+	log := &auditLog{}
+	approvals := approval.NewManager(approval.NewMemory(), log, approval.Options{TTL: time.Hour, Retention: time.Hour})
+	defer approvals.Shutdown()
+	policy := &auth.Policy{Approvals: []auth.ApprovalRule{{Operations: []string{"scaleWorkload"}, Clusters: []string{auth.Wildcard}}}}
+	authorize := func(ctx context.Context, request interface{}, body []byte) error { return nil }
+	pending := func(w http.ResponseWriter, r *http.Request, a *approval.Approval) {
+		w.Header().Set("Location", "/api/v1/approvals/"+a.ID)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = io.WriteString(w, a.ID)
+	}
+	s := &scaler{}
+	srv, _ := newIdempotentServer(t, s, Approval(policy, approvals, 1<<20, authorize, pending))
+
+	first, firstBody := scale(t, srv, "alice", "key-1", 3)
+	retry, retryBody := scale(t, srv, "alice", "key-1", 3)
+	if first.StatusCode != http.StatusAccepted || retry.Header.Get("Idempotent-Replayed") != "true" || retryBody != firstBody {
+		t.Errorf("retry: %d replayed=%q with %s, want the 202 of %s replayed",
+			retry.StatusCode, retry.Header.Get("Idempotent-Replayed"), retryBody, firstBody)
+	}
+	requested, err := approvals.List(context.Background(), approval.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requested) != 1 || s.callCount() != 0 {
+		t.Errorf("%d approvals requested and %d calls, want one approval and no call", len(requested), s.callCount())
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/loadshed"
+	"iu-k8s.linecorp.com/server/internal/metrics"
+)
+
+// Shed admits requests through limiter at priority. Requests the limiter
+// sheds get 503 with Retry-After. Streaming operations, WebSocket sessions
+// included, would hold a slot for their whole life and bypass the limiter. routes is the router
+// the middleware is used on.
+func Shed(limiter *loadshed.Limiter, priority loadshed.Priority, routes chi.Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if isLongLived(operationOf(routes, r)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			release, waited, err := limiter.Acquire(r.Context(), priority)
+			if errors.Is(err, loadshed.ErrOverloaded) {
+				metrics.Shed(priority.String(), waited)
+				// Come back once the queue is likely to have drained.
+				retryAfter := ceilSeconds(max(limiter.Delay(), time.Second))
+				w.Header().Set("Retry-After", retryAfter)
+				render.Status(r, http.StatusServiceUnavailable)
+				render.JSON(w, r, api.ErrorResponse{
+					Error:     "overloaded",
+					Message:   "server overloaded, retry after " + retryAfter + "s",
+					Timestamp: ptr(time.Now()),
+					RequestId: requestID(r),
+				})
+				return
+			}
+			if err != nil {
+				// The client went away while queued; nobody reads a response.
+				return
+			}
+			defer release()
+			defer metrics.Admitted(priority.String(), waited)()
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/loadshed"
+)
+
+func TestShed(t *testing.T) {
+	limiter := loadshed.New(1, 20*time.Millisecond)
+	started, release := make(chan struct{}), make(chan struct{})
+	r := chi.NewRouter()
+	r.Use(Shed(limiter, loadshed.Low, r))
+	r.Get("/slow", func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	})
+	r.Get("/fast", func(w http.ResponseWriter, r *http.Request) {})
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if resp, err := http.Get(srv.URL + "/slow"); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+
+	// An Upgrade header does not take an ordinary request past the limiter.
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/fast", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "x")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status %d with an Upgrade header, want 503", resp.StatusCode)
+	}
+
+	resp, err = http.Get(srv.URL + "/fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("status %d, want 503", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Retry-After"); got != "1" {
+		t.Errorf("Retry-After = %q, want 1", got)
+	}
+	var body api.ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Error != "overloaded" {
+		t.Errorf("body = %+v (%v), want the overloaded error", body, err)
+	}
+
+	close(release)
+	<-done
+	resp, err = http.Get(srv.URL + "/fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status %d once idle, want 200", resp.StatusCode)
+	}
+}
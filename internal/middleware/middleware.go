@@ -5,16 +5,11 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/go-chi/chi/v5/middleware"
 	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/requestid"
 )
 
-var (
-	RealIP    = middleware.RealIP
-	RequestID = middleware.RequestID
-	Recovery  = middleware.Recoverer
-	GetReqID  = middleware.GetReqID
-)
+var GetReqID = requestid.From
 
 // Logger creates a new logger middleware
 func Logger(next http.Handler) http.Handler {
@@ -52,3 +47,9 @@ func (w *responseWriter) WriteHeader(statusCode int) {
 	w.statusCode = statusCode
 	w.ResponseWriter.WriteHeader(statusCode)
 }
+
+// Unwrap returns the underlying ResponseWriter so http.ResponseController can
+// reach optional interfaces such as http.Hijacker and http.Flusher.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/metrics"
+	"iu-k8s.linecorp.com/server/internal/ratelimit"
+)
+
+// RateLimit limits the request rate of each authenticated principal, or of
+// each client IP for anonymous requests, and reports the quota in the
+// RateLimit-* headers. routes is the router the middleware is used on; it
+// resolves the operation of a request for per-operation quotas before
+// routing. It must run after Authenticate and RealIP. When the store fails
+// requests are let through.
+func RateLimit(limiter *ratelimit.Limiter, routes chi.Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			// Browsers send preflights on their own; they are not the
+			// caller's requests.
+			if r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			operationID := operationOf(routes, r)
+			caller := "ip:" + sourceIP(r.RemoteAddr)
+			if principal := auth.From(r.Context()); !principal.IsAnonymous() {
+				caller = "principal:" + principal.Name
+			}
+
+			limit, res, err := limiter.Take(r.Context(), caller, operationID)
+			if err != nil {
+				log.From(r.Context()).Warn("rate limit check failed, allowing request", "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			h.Set("RateLimit-Policy", strconv.Itoa(limit.Burst)+";w="+ceilSeconds(limit.Window()))
+			h.Set("RateLimit-Limit", strconv.Itoa(limit.Burst))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", ceilSeconds(res.Reset))
+			if !res.Allowed {
+				metrics.RateLimited(operationID)
+				h.Set("Retry-After", ceilSeconds(res.RetryAfter))
+				render.Status(r, http.StatusTooManyRequests)
+				render.JSON(w, r, api.ErrorResponse{
+					Error:     "rate_limited",
+					Message:   "too many requests, retry after " + ceilSeconds(res.RetryAfter) + "s",
+					Timestamp: ptr(time.Now()),
+					RequestId: requestID(r),
+				})
+				return
+			}
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// operationOf resolves the operationId of a request before routing, or ""
+// when it matches no operation.
+func operationOf(routes chi.Routes, r *http.Request) string {
+	var operationID string
+	if rctx := chi.NewRouteContext(); routes.Match(rctx, r.Method, r.URL.Path) {
+		operationID, _ = api.OperationID(r.Method, rctx.RoutePattern())
+	}
+	return operationID
+}
+
+// ceilSeconds formats d as whole seconds, rounded up so that clients waiting
+// that long find the quota restored.
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
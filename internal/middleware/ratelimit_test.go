@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/config"
+	"iu-k8s.linecorp.com/server/internal/ratelimit"
+)
+
+func newRateLimited(t *testing.T, trusted ...netip.Prefix) http.Handler {
+	t.Helper()
+	limiter, err := ratelimit.New(config.RateLimitConfig{
+		Rate:      0.001,
+		Burst:     2,
+		Overrides: map[string]config.RateLimit{"getOperation": {Rate: 0.001, Burst: 1}},
+	}, ratelimit.NewMemory(), api.HasOperation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := chi.NewRouter()
+	r.Use(RealIP(trusted))
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal := auth.Anonymous()
+			if user := r.Header.Get("X-Test-User"); user != "" {
+				principal = &auth.Principal{Name: user}
+			}
+			next.ServeHTTP(w, r.WithContext(auth.With(r.Context(), principal)))
+		})
+	})
+	r.Use(RateLimit(limiter, r))
+	r.Get("/api/v1/clusters", func(w http.ResponseWriter, r *http.Request) {})
+	r.Get("/api/v1/operations/{operationId}", func(w http.ResponseWriter, r *http.Request) {})
+	return r
+}
+
+func get(h http.Handler, path, remoteAddr string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = remoteAddr
+	for name, value := range header {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimit(t *testing.T) {
+	h := newRateLimited(t)
+	alice := map[string]string{"X-Test-User": "alice"}
+
+	for i, remaining := range []string{"1", "0"} {
+		rec := get(h, "/api/v1/clusters", "192.0.2.1:1000", alice)
+		if rec.Code != http.StatusOK || rec.Header().Get("RateLimit-Remaining") != remaining {
+			t.Fatalf("request %d: %d with %q remaining, want 200 with %s", i+1, rec.Code, rec.Header().Get("RateLimit-Remaining"), remaining)
+		}
+		if got := rec.Header().Get("RateLimit-Policy"); got != "2;w=2000" {
+			t.Errorf("RateLimit-Policy = %q, want 2;w=2000", got)
+		}
+	}
+	rec := get(h, "/api/v1/clusters", "192.0.2.1:1000", alice)
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "1000" {
+		t.Errorf("over the quota: %d with Retry-After %q, want 429 after 1000s", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	var body api.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.Error != "rate_limited" {
+		t.Errorf("body = %s, want rate_limited", rec.Body)
+	}
+
+	// The principal, not the address, is limited; other principals and
+	// operations with a quota of their own are not.
+	if rec := get(h, "/api/v1/clusters", "192.0.2.2:1000", alice); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("alice from another address: %d, want 429", rec.Code)
+	}
+	if rec := get(h, "/api/v1/clusters", "192.0.2.1:1000", map[string]string{"X-Test-User": "bob"}); rec.Code != http.StatusOK {
+		t.Errorf("bob: %d, want 200", rec.Code)
+	}
+	if rec := get(h, "/api/v1/operations/op-1", "192.0.2.1:1000", alice); rec.Code != http.StatusOK ||
+		rec.Header().Get("RateLimit-Limit") != "1" {
+		t.Errorf("getOperation: %d with limit %q, want 200 with its own limit 1", rec.Code, rec.Header().Get("RateLimit-Limit"))
+	}
+
+	// Preflights are not counted.
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/clusters", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Header().Get("RateLimit-Limit") != "" {
+		t.Error("preflight was rate limited")
+	}
+}
+
+func TestRateLimitByAddress(t *testing.T) {
+	h := newRateLimited(t, netip.MustParsePrefix("10.0.0.0/8"))
+
+	// Anonymous clients cannot dodge the quota with forged headers.
+	for i := range 3 {
+		rec := get(h, "/api/v1/clusters", "192.0.2.1:1000", map[string]string{"X-Forwarded-For": fmt.Sprint("198.51.100.", i)})
+		if want := []int{200, 200, 429}[i]; rec.Code != want {
+			t.Errorf("request %d: %d, want %d", i+1, rec.Code, want)
+		}
+	}
+	// Behind a trusted proxy every client has its own quota.
+	for i := range 3 {
+		rec := get(h, "/api/v1/clusters", "10.0.0.2:1000", map[string]string{"X-Forwarded-For": fmt.Sprint("198.51.100.", i)})
+		if rec.Code != http.StatusOK {
+			t.Errorf("client %d behind the proxy: %d, want 200", i+1, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// RealIP replaces the remote address of requests sent by one of the
+// trusted proxies with the client address they forwarded: the last address
+// in X-Forwarded-For that is not a trusted proxy itself, or X-Real-IP when
+// there is no X-Forwarded-For. The headers of other peers are ignored, so
+// clients cannot pick the address that audit entries and the rate limits
+// of anonymous requests go by.
+func RealIP(trusted []netip.Prefix) func(http.Handler) http.Handler {
+	isTrusted := func(addr netip.Addr) bool {
+		addr = addr.Unmap()
+		for _, prefix := range trusted {
+			if prefix.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			peer, err := parseAddr(r.RemoteAddr)
+			if err == nil && isTrusted(peer) {
+				if client, ok := forwardedFor(r.Header, isTrusted); ok {
+					r.RemoteAddr = client.String()
+				}
+			}
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// forwardedFor returns the client address forwarded in header. Proxies
+// append to X-Forwarded-For, so only the entries after the last untrusted
+// one were added by trusted proxies; anything before it may be forged.
+func forwardedFor(header http.Header, isTrusted func(netip.Addr) bool) (netip.Addr, bool) {
+	if values := header.Values("X-Forwarded-For"); len(values) > 0 {
+		hops := strings.Split(strings.Join(values, ","), ",")
+		var client netip.Addr
+		for i := len(hops) - 1; i >= 0; i-- {
+			addr, err := parseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				break
+			}
+			client = addr.Unmap()
+			if !isTrusted(addr) {
+				break
+			}
+		}
+		return client, client.IsValid()
+	}
+	addr, err := parseAddr(strings.TrimSpace(header.Get("X-Real-IP")))
+	return addr.Unmap(), err == nil
+}
+
+// parseAddr parses an IP address with or without a port.
+func parseAddr(s string) (netip.Addr, error) {
+	if addrPort, err := netip.ParseAddrPort(s); err == nil {
+		return addrPort.Addr(), nil
+	}
+	return netip.ParseAddr(s)
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestRealIP(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")}
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		want       string
+	}{
+		{"direct", "203.0.113.7:4711", nil, "", "203.0.113.7:4711"},
+		{"untrusted peer forwarding", "203.0.113.7:4711", []string{"198.51.100.1"}, "198.51.100.2", "203.0.113.7:4711"},
+		{"trusted proxy", "10.0.0.2:4711", []string{"198.51.100.1"}, "", "198.51.100.1"},
+		{"forged entries before the client", "10.0.0.2:4711", []string{"192.0.2.66, 198.51.100.1"}, "", "198.51.100.1"},
+		{"chain of trusted proxies", "10.0.0.2:4711", []string{"198.51.100.1, 10.1.2.3", "10.0.0.9"}, "", "198.51.100.1"},
+		{"only trusted hops", "10.0.0.2:4711", []string{"10.1.2.3, 10.0.0.9"}, "", "10.1.2.3"},
+		{"ports and IPv6", "[fd00::1]:4711", []string{"[2001:db8::1]:5000"}, "", "2001:db8::1"},
+		{"IPv4-mapped peer", "[::ffff:10.0.0.2]:4711", []string{"198.51.100.1"}, "", "198.51.100.1"},
+		{"garbage", "10.0.0.2:4711", []string{"unknown"}, "", "10.0.0.2:4711"},
+		{"X-Real-IP", "10.0.0.2:4711", nil, "198.51.100.1", "198.51.100.1"},
+		{"X-Forwarded-For wins", "10.0.0.2:4711", []string{"198.51.100.1"}, "198.51.100.2", "198.51.100.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := RealIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.RemoteAddr
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			if got != tt.want {
+				t.Errorf("RemoteAddr = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no trusted proxies", func(t *testing.T) {
+		var got string
+		handler := RealIP(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r.RemoteAddr
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "10.0.0.2:4711"
+		req.Header.Set("X-Forwarded-For", "198.51.100.1")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if got != "10.0.0.2:4711" {
+			t.Errorf("RemoteAddr = %q, want the peer", got)
+		}
+	})
+}
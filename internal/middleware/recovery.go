@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-chi/render"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/recovery"
+)
+
+// Recovery turns a panic in a handler into 500 with an ErrorResponse, after
+// logging it with its stack through the request's logger and counting it.
+// It must run after Logger. When the response has started, or the
+// connection was taken over by a WebSocket, the panic is reported and the
+// connection dropped instead. Handlers aborting with http.ErrAbortHandler
+// are let through for the server to drop the connection silently.
+func Recovery(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		sw := &startWriter{ResponseWriter: w}
+		defer func() {
+			value := recover()
+			if value == nil {
+				return
+			}
+			if err, ok := value.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(value)
+			}
+			recovery.Report(r.Context(), "http", value, debug.Stack())
+			if sw.started || r.Header.Get("Upgrade") != "" {
+				panic(http.ErrAbortHandler)
+			}
+			// Drop what the handler meant to send along with its body.
+			for _, name := range []string{"Content-Length", "Content-Encoding", "ETag"} {
+				w.Header().Del(name)
+			}
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, api.ErrorResponse{
+				Error:     "internal_error",
+				Message:   "internal server error",
+				Timestamp: ptr(time.Now()),
+				RequestId: requestID(r),
+			})
+		}()
+		next.ServeHTTP(sw, r)
+	}
+	return http.HandlerFunc(fn)
+}
+
+// startWriter records whether a response has started.
+type startWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+func (w *startWriter) WriteHeader(statusCode int) {
+	if statusCode >= http.StatusOK {
+		w.started = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *startWriter) Write(b []byte) (int, error) {
+	w.started = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush starts the response.
+func (w *startWriter) Flush() {
+	w.started = true
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying ResponseWriter for http.ResponseController.
+func (w *startWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
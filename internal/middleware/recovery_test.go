@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/log"
+)
+
+// recovered serves req with handler behind Recovery, returning the response,
+// what was logged and what panicked out of Recovery.
+func recovered(req *http.Request, handler http.HandlerFunc) (rec *httptest.ResponseRecorder, logged string, value any) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	req = req.WithContext(log.With(req.Context(), logger))
+	rec = httptest.NewRecorder()
+	h := RequestID([]string{"X-Request-ID"}, 64)(Recovery(handler))
+	defer func() {
+		value = recover()
+		logged = buf.String()
+	}()
+	h.ServeHTTP(rec, req)
+	return
+}
+
+func TestRecovery(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Request-ID", "req-1")
+	rec, logged, value := recovered(req, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "42")
+		w.Header().Set("ETag", `"tag"`)
+		w.Header().Set("Content-Encoding", "gzip")
+		panic("boom")
+	})
+	if value != nil {
+		t.Fatalf("panic %v escaped", value)
+	}
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status %d, want 500", rec.Code)
+	}
+	for _, name := range []string{"Content-Length", "ETag", "Content-Encoding"} {
+		if got := rec.Header().Get(name); got != "" {
+			t.Errorf("%s = %q, want the handler's header dropped", name, got)
+		}
+	}
+	var body api.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("body %s: %v", rec.Body, err)
+	}
+	if body.Error != "internal_error" || body.RequestId == nil || *body.RequestId != "req-1" {
+		t.Errorf("body = %s, want internal_error for req-1", rec.Body)
+	}
+	if strings.Contains(rec.Body.String(), "boom") {
+		t.Error("the panic value leaked into the response")
+	}
+	if !strings.Contains(logged, `"panic":"boom"`) || !strings.Contains(logged, "recovery_test.go") {
+		t.Errorf("logged %s, want the panic with its stack", logged)
+	}
+}
+
+func TestRecoveryDropsConnection(t *testing.T) {
+	tests := []struct {
+		name    string
+		upgrade bool
+		handler http.HandlerFunc
+		logged  bool
+	}{
+		{"response started", false, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, "partial")
+			panic("boom")
+		}, true},
+		{"flushed", false, func(w http.ResponseWriter, r *http.Request) {
+			w.(http.Flusher).Flush()
+			panic("boom")
+		}, true},
+		{"WebSocket", true, func(w http.ResponseWriter, r *http.Request) {
+			panic("boom")
+		}, true},
+		{"aborted", false, func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.upgrade {
+				req.Header.Set("Upgrade", "websocket")
+			}
+			rec, logged, value := recovered(req, tt.handler)
+			if err, ok := value.(error); !ok || !errors.Is(err, http.ErrAbortHandler) {
+				t.Fatalf("panicked with %v, want http.ErrAbortHandler", value)
+			}
+			if strings.Contains(rec.Body.String(), "internal_error") {
+				t.Errorf("wrote an error response after dropping: %s", rec.Body)
+			}
+			if got := strings.Contains(logged, "panic recovered"); got != tt.logged {
+				t.Errorf("logged %q, want reported: %v", logged, tt.logged)
+			}
+		})
+	}
+}
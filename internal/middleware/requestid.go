@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+
+	"iu-k8s.linecorp.com/server/internal/requestid"
+)
+
+// RequestID returns a middleware giving each request an ID, taken from the
+// first of headers the client set to a valid ID of at most maxLength
+// characters, or generated. The ID is echoed in the X-Request-ID response
+// header and found in the request context with GetReqID.
+func RequestID(headers []string, maxLength int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			id := ""
+			for _, header := range headers {
+				if value := r.Header.Get(header); requestid.Valid(value, maxLength) {
+					id = value
+					break
+				}
+			}
+			if id == "" {
+				id = requestid.New()
+			}
+			w.Header().Set(requestid.Header, id)
+			next.ServeHTTP(w, r.WithContext(requestid.With(r.Context(), id)))
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// requestID returns the ID of r for the ErrorResponses of middlewares.
+func requestID(r *http.Request) *string {
+	if id := GetReqID(r.Context()); id != "" {
+		return &id
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestRequestID(t *testing.T) {
+	headers := []string{"X-Request-ID", "X-Correlation-ID"}
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    string // empty for a generated ID
+	}{
+		{"none", nil, ""},
+		{"X-Request-ID", map[string]string{"X-Request-ID": "abc-123"}, "abc-123"},
+		{"second header", map[string]string{"X-Correlation-ID": "corr-1"}, "corr-1"},
+		{"first header wins", map[string]string{"X-Request-ID": "abc-123", "X-Correlation-ID": "corr-1"}, "abc-123"},
+		{"invalid first header", map[string]string{"X-Request-ID": "abc 123", "X-Correlation-ID": "corr-1"}, "corr-1"},
+		{"unconfigured header", map[string]string{"X-Amzn-Trace-Id": "Root=1-abc"}, ""},
+		{"too long", map[string]string{"X-Request-ID": strings.Repeat("a", 33)}, ""},
+		{"at the maximum", map[string]string{"X-Request-ID": strings.Repeat("a", 32)}, strings.Repeat("a", 32)},
+		{"badly formatted", map[string]string{"X-Request-ID": "abc\r\nSet-Cookie: x=y"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := RequestID(headers, 32)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = GetReqID(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for name, value := range tt.headers {
+				req.Header[http.CanonicalHeaderKey(name)] = []string{value}
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if tt.want == "" {
+				if id, err := uuid.Parse(got); err != nil || id.Version() != 7 {
+					t.Errorf("request ID = %q, want a generated UUIDv7", got)
+				}
+			} else if got != tt.want {
+				t.Errorf("request ID = %q, want %q", got, tt.want)
+			}
+			if echoed := rec.Header().Get("X-Request-ID"); echoed != got {
+				t.Errorf("X-Request-ID = %q, want the request ID %q", echoed, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"iu-k8s.linecorp.com/server/internal/api"
+	"iu-k8s.linecorp.com/server/internal/metrics"
+)
+
+// deadlineGrace is how long the connection stays open past the deadline of
+// a request so that the handler can still report the timeout.
+const deadlineGrace = 5 * time.Second
+
+// Timeout gives each operation a deadline, timeout or its entry in
+// overrides, and replaces the read and write timeouts of the server with
+// it. An operation that fails with an internal error after its deadline
+// responds 504. Streaming operations, WebSocket sessions included, get no
+// deadline at all.
+// routes is the router the middleware is used on.
+func Timeout(timeout time.Duration, overrides map[string]time.Duration, routes chi.Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			operationID := operationOf(routes, r)
+			rc := http.NewResponseController(w)
+			if isLongLived(operationID) {
+				// Errors mean the connection has no deadlines to clear.
+				_ = rc.SetReadDeadline(time.Time{})
+				_ = rc.SetWriteDeadline(time.Time{})
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			d := timeout
+			if override, ok := overrides[operationID]; ok {
+				d = override
+			}
+			ctx, cancel := context.WithTimeout(r.Context(), d)
+			defer cancel()
+			// The server reads the connection in the background while the
+			// handler runs and cancels the request when that read times out.
+			connDeadline := time.Now().Add(d + deadlineGrace)
+			_ = rc.SetReadDeadline(connDeadline)
+			_ = rc.SetWriteDeadline(connDeadline)
+
+			next.ServeHTTP(&timeoutWriter{ResponseWriter: w, ctx: ctx}, r.WithContext(ctx))
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				metrics.TimedOut(operationID)
+			}
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// isLongLived reports whether operationID holds its connection for as long
+// as the client wants. WebSocket sessions are streaming operations of the
+// spec; an Upgrade header alone, which any client can send, does not make
+// a request long-lived.
+func isLongLived(operationID string) bool {
+	return api.IsStreaming(operationID)
+}
+
+// timeoutWriter turns the internal error of a handler that ran out of time
+// into 504 Gateway Timeout: the cluster, not the server, was too slow.
+type timeoutWriter struct {
+	http.ResponseWriter
+	ctx context.Context
+}
+
+func (w *timeoutWriter) WriteHeader(statusCode int) {
+	if statusCode == http.StatusInternalServerError && errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		statusCode = http.StatusGatewayTimeout
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Unwrap returns the underlying ResponseWriter for http.ResponseController.
+func (w *timeoutWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
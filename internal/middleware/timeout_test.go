@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestTimeout(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Timeout(time.Minute, map[string]time.Duration{"getOperation": time.Hour}, r))
+	deadline := func(w http.ResponseWriter, r *http.Request) {
+		if d, ok := r.Context().Deadline(); ok {
+			w.Header().Set("X-Deadline", time.Until(d).Round(time.Minute).String())
+		}
+	}
+	r.Get("/api/v1/clusters", deadline)
+	r.Get("/api/v1/operations/{operationId}", deadline)
+	r.Get("/api/v1/clusters/{cluster}/watch/{resource}", deadline)
+	r.Get("/api/v1/clusters/{cluster}/namespaces/{namespace}/pods/{pod}/exec", deadline)
+	r.Get("/slow", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		http.Error(w, "too slow", http.StatusInternalServerError)
+	})
+
+	tests := []struct {
+		name    string
+		path    string
+		upgrade bool
+		want    string
+	}{
+		{"default", "/api/v1/clusters", false, "1m0s"},
+		{"override", "/api/v1/operations/op-1", false, "1h0m0s"},
+		{"streaming operation", "/api/v1/clusters/c/watch/pods", false, ""},
+		{"WebSocket session", "/api/v1/clusters/c/namespaces/ns/pods/p/exec", true, ""},
+		{"Upgrade header on an ordinary request", "/api/v1/clusters", true, "1m0s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.upgrade {
+				req.Header.Set("Connection", "Upgrade")
+				req.Header.Set("Upgrade", "websocket")
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+			if got := rec.Header().Get("X-Deadline"); got != tt.want {
+				t.Errorf("deadline in %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("timed out", func(t *testing.T) {
+		h := Timeout(10*time.Millisecond, nil, r)(r)
+		req := httptest.NewRequest(http.MethodGet, "/slow", nil)
+		req.Header.Set("Upgrade", "x")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req.WithContext(context.Background()))
+		if rec.Code != http.StatusGatewayTimeout {
+			t.Errorf("status %d, want 504", rec.Code)
+		}
+	})
+}
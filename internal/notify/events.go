@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	"iu-k8s.linecorp.com/server/internal/approval"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/kube"
+	"iu-k8s.linecorp.com/server/internal/operation"
+)
+
+// OperationEvent describes a finished operation.
+func OperationEvent(op operation.Operation) Event {
+	event := Event{
+		Type:      EventOperationSucceeded,
+		Principal: op.Principal.Name,
+		Target:    op.Target,
+		Summary:   fmt.Sprintf("%s of %s succeeded", op.Type, describeTarget(op.Target)),
+		Details:   map[string]any{"operationId": op.ID, "operationType": op.Type},
+	}
+	if op.FinishedAt != nil {
+		event.Time = op.FinishedAt.UTC()
+	}
+	if op.Status == operation.StatusFailed {
+		event.Type = EventOperationFailed
+		if op.Target.Resource == kube.WorkloadDeployments || op.Target.Resource == kube.WorkloadStatefulSets {
+			event.Type = EventRolloutFailed
+		}
+		event.Summary = fmt.Sprintf("%s of %s failed: %s", op.Type, describeTarget(op.Target), op.Error)
+		event.Details["error"] = op.Error
+	}
+	return event
+}
+
+// ApprovalEvent describes a requested or decided approval.
+func ApprovalEvent(a approval.Approval) Event {
+	event := Event{
+		Target: a.Target,
+		Details: map[string]any{
+			"approvalId":  a.ID,
+			"operationId": a.OperationID,
+			"requestedBy": a.Requester.Name,
+			"expiresAt":   a.ExpiresAt,
+		},
+	}
+	what := fmt.Sprintf("%s on %s", a.OperationID, describeTarget(a.Target))
+	switch a.Status {
+	case approval.StatusPending:
+		event.Type = EventApprovalRequested
+		event.Time = a.RequestedAt
+		event.Principal = a.Requester.Name
+		event.Summary = fmt.Sprintf("%s requested approval of %s", a.Requester.Name, what)
+	case approval.StatusApproved, approval.StatusRejected:
+		event.Type = EventApprovalApproved
+		if a.Status == approval.StatusRejected {
+			event.Type = EventApprovalRejected
+		}
+		event.Time = a.DecidedAt
+		event.Principal = a.DecidedBy
+		event.Summary = fmt.Sprintf("%s %s %s requested by %s", a.DecidedBy, a.Status, what, a.Requester.Name)
+		event.Details["decidedBy"] = a.DecidedBy
+		if a.Comment != "" {
+			event.Details["comment"] = a.Comment
+		}
+	case approval.StatusExpired:
+		event.Type = EventApprovalExpired
+		event.Time = a.DecidedAt
+		event.Summary = fmt.Sprintf("approval of %s requested by %s expired", what, a.Requester.Name)
+	}
+	return event
+}
+
+// describeTarget writes a target as cluster/namespace/resource/name,
+// leaving out empty parts.
+func describeTarget(t audit.Target) string {
+	var parts []string
+	for _, part := range []string{t.Cluster, t.Namespace, t.Resource, t.Name} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
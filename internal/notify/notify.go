@@ -0,0 +1,423 @@
+// Package notify tells people about what happens on the clusters: finished
+// operations, failed rollouts and operations waiting for approval. Events
+// go out in the background to the sinks that subscribe to them: signed
+// webhooks, Slack-compatible incoming webhooks and email. Failed deliveries
+// are retried with backoff and end up in a dead-letter store, from which
+// they can be retried once the sink is fixed.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/metrics"
+	"iu-k8s.linecorp.com/server/internal/recovery"
+)
+
+const (
+	// workers is the number of deliveries made at the same time.
+	workers = 4
+	// maxBackoff caps the delay between two attempts of a delivery.
+	maxBackoff = 5 * time.Minute
+	// sweepInterval is how often old dead letters are deleted.
+	sweepInterval = time.Hour
+)
+
+var (
+	// ErrNotFound is returned for unknown dead letter IDs.
+	ErrNotFound = errors.New("dead letter not found")
+	// ErrUnknownSink is returned when retrying a dead letter of a sink that
+	// is no longer configured.
+	ErrUnknownSink = errors.New("notification sink is not configured")
+
+	// errQueueFull dead-letters events arriving faster than they are sent.
+	errQueueFull = errors.New("notification queue is full")
+	// errShutdown dead-letters deliveries still queued at shutdown.
+	errShutdown = errors.New("notification interrupted by server shutdown")
+)
+
+// EventType names what happened.
+type EventType string
+
+const (
+	EventOperationSucceeded EventType = "operation.succeeded"
+	// EventOperationFailed is sent for failed operations other than
+	// rollouts, such as node drains.
+	EventOperationFailed EventType = "operation.failed"
+	// EventRolloutFailed is sent for failed scales, restarts and rollbacks
+	// of workloads.
+	EventRolloutFailed     EventType = "rollout.failed"
+	EventApprovalRequested EventType = "approval.requested"
+	EventApprovalApproved  EventType = "approval.approved"
+	EventApprovalRejected  EventType = "approval.rejected"
+	EventApprovalExpired   EventType = "approval.expired"
+)
+
+// EventTypes lists every event type, which sinks may subscribe to.
+var EventTypes = []EventType{
+	EventOperationSucceeded, EventOperationFailed, EventRolloutFailed,
+	EventApprovalRequested, EventApprovalApproved, EventApprovalRejected, EventApprovalExpired,
+}
+
+// Event is something to notify about. It is also the data of message
+// templates.
+type Event struct {
+	ID   string    `json:"id"`
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+	// Principal is who caused the event, if anyone.
+	Principal string       `json:"principal,omitempty"`
+	Target    audit.Target `json:"target"`
+	// Summary is a one-line description for people.
+	Summary string         `json:"summary"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// Sink delivers events to one destination.
+type Sink interface {
+	// Send delivers event once. Errors wrapped with Permanent are not
+	// retried.
+	Send(ctx context.Context, event Event) error
+}
+
+// Destination is a named sink and the events it subscribes to.
+type Destination struct {
+	Name string
+	// Events are the types sent to the sink; empty subscribes to all.
+	Events []EventType
+	Sink   Sink
+}
+
+func (d *Destination) subscribes(t EventType) bool {
+	return len(d.Events) == 0 || slices.Contains(d.Events, t)
+}
+
+// permanentError marks a delivery that fails the same way when retried.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as not worth retrying, such as a rejected request.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// DeadLetter is a delivery that failed for good.
+type DeadLetter struct {
+	ID       string
+	Sink     string
+	Event    Event
+	Attempts int
+	Error    string
+	FailedAt time.Time
+}
+
+// DeadLetterStore keeps failed deliveries. Each method must be atomic.
+type DeadLetterStore interface {
+	// AddDeadLetter stores a new dead letter.
+	AddDeadLetter(ctx context.Context, dl DeadLetter) error
+	// ListDeadLetters returns up to limit dead letters, newest first.
+	ListDeadLetters(ctx context.Context, limit int) ([]DeadLetter, error)
+	// GetDeadLetter returns a dead letter or ErrNotFound.
+	GetDeadLetter(ctx context.Context, id string) (*DeadLetter, error)
+	// DeleteDeadLetter deletes a dead letter, failing with ErrNotFound if
+	// it is already gone.
+	DeleteDeadLetter(ctx context.Context, id string) error
+	// DeleteDeadLetters deletes the dead letters that failed before t.
+	DeleteDeadLetters(ctx context.Context, before time.Time) error
+}
+
+// Options configure a Notifier.
+type Options struct {
+	// Timeout bounds a single delivery attempt.
+	Timeout time.Duration
+	// MaxAttempts is how often a delivery is tried before it is
+	// dead-lettered.
+	MaxAttempts int
+	// Backoff is the delay before the second attempt, doubling for each
+	// further one.
+	Backoff time.Duration
+	// QueueSize is how many deliveries may wait to be sent.
+	QueueSize int
+	// Retention is how long dead letters are kept.
+	Retention time.Duration
+}
+
+// delivery is an event on its way to one destination.
+type delivery struct {
+	dest     *Destination
+	event    Event
+	attempts int
+}
+
+// Notifier sends events to the destinations subscribing to them.
+type Notifier struct {
+	destinations []Destination
+	deadLetters  DeadLetterStore
+	opts         Options
+	queue        chan *delivery
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+}
+
+// New creates a notifier and starts its workers. Without destinations
+// every event is dropped.
+func New(destinations []Destination, deadLetters DeadLetterStore, opts Options) *Notifier {
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &Notifier{
+		destinations: destinations,
+		deadLetters:  deadLetters,
+		opts:         opts,
+		queue:        make(chan *delivery, opts.QueueSize),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+
+	n.wg.Add(workers + 1)
+	for range workers {
+		go n.work()
+	}
+	go n.sweep()
+	return n
+}
+
+// Notify queues event for the destinations subscribing to it and returns
+// without waiting for the deliveries. The notifier assigns the ID and, if
+// unset, the time.
+func (n *Notifier) Notify(event Event) {
+	event.ID = uuid.NewString()
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	for i := range n.destinations {
+		if dest := &n.destinations[i]; dest.subscribes(event.Type) {
+			n.enqueue(&delivery{dest: dest, event: event})
+		}
+	}
+}
+
+// DeadLetters returns up to limit dead letters, newest first.
+func (n *Notifier) DeadLetters(ctx context.Context, limit int) ([]DeadLetter, error) {
+	return n.deadLetters.ListDeadLetters(ctx, limit)
+}
+
+// Retry sends a dead letter again, with a fresh set of attempts, and
+// removes it from the store. A delivery failing again is dead-lettered
+// anew.
+func (n *Notifier) Retry(ctx context.Context, id string) (*DeadLetter, error) {
+	dl, err := n.deadLetters.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(n.destinations, func(d Destination) bool { return d.Name == dl.Sink })
+	if i < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSink, dl.Sink)
+	}
+	// Deleting first makes concurrent retries send it once.
+	if err := n.deadLetters.DeleteDeadLetter(ctx, id); err != nil {
+		return nil, err
+	}
+	n.enqueue(&delivery{dest: &n.destinations[i], event: dl.Event})
+	return dl, nil
+}
+
+// Shutdown stops sending and dead-letters the deliveries not made yet.
+func (n *Notifier) Shutdown() {
+	n.cancel()
+	n.wg.Wait()
+	for {
+		select {
+		case d := <-n.queue:
+			n.deadLetter(d, errShutdown)
+		default:
+			return
+		}
+	}
+}
+
+func (n *Notifier) enqueue(d *delivery) {
+	if n.ctx.Err() != nil {
+		n.deadLetter(d, errShutdown)
+		return
+	}
+	select {
+	case n.queue <- d:
+	default:
+		n.deadLetter(d, errQueueFull)
+	}
+}
+
+func (n *Notifier) work() {
+	defer n.wg.Done()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case d := <-n.queue:
+			n.deliver(d)
+		}
+	}
+}
+
+// deliver makes one attempt of d and schedules the next one if it failed.
+func (n *Notifier) deliver(d *delivery) {
+	d.attempts++
+	logger := slog.With("component", "notify", "sink", d.dest.Name, "event_id", d.event.ID, "type", d.event.Type)
+	ctx, cancel := context.WithTimeout(n.ctx, n.opts.Timeout)
+	defer cancel()
+
+	// A panicking sink fails the delivery rather than the server.
+	err := recovery.Catch(log.With(ctx, logger), "notification", func() error {
+		return d.dest.Sink.Send(ctx, d.event)
+	})
+	if err == nil {
+		metrics.Notified(d.dest.Name, "delivered")
+		return
+	}
+	var permanent *permanentError
+	if errors.As(err, &permanent) || d.attempts >= n.opts.MaxAttempts || n.ctx.Err() != nil {
+		if n.ctx.Err() != nil {
+			err = errShutdown
+		}
+		n.deadLetter(d, err)
+		return
+	}
+
+	delay := min(n.opts.Backoff<<(d.attempts-1), maxBackoff)
+	logger.Warn("notification failed, retrying", "error", err, "attempt", d.attempts, "delay", delay)
+	metrics.Notified(d.dest.Name, "retried")
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			n.enqueue(d)
+		case <-n.ctx.Done():
+			n.deadLetter(d, errShutdown)
+		}
+	}()
+}
+
+func (n *Notifier) deadLetter(d *delivery, err error) {
+	slog.Error("notification failed", "component", "notify", "sink", d.dest.Name,
+		"event_id", d.event.ID, "type", d.event.Type, "attempts", d.attempts, "error", err)
+	metrics.Notified(d.dest.Name, "dead_lettered")
+	dl := DeadLetter{
+		ID:       uuid.NewString(),
+		Sink:     d.dest.Name,
+		Event:    d.event,
+		Attempts: d.attempts,
+		Error:    err.Error(),
+		FailedAt: time.Now().UTC(),
+	}
+	if err := n.deadLetters.AddDeadLetter(context.Background(), dl); err != nil {
+		slog.Error("Failed to store dead letter", "sink", dl.Sink, "event_id", dl.Event.ID, "error", err)
+	}
+}
+
+// sweep deletes dead letters older than the retention period.
+func (n *Notifier) sweep() {
+	defer n.wg.Done()
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := n.deadLetters.DeleteDeadLetters(n.ctx, now.Add(-n.opts.Retention)); err != nil {
+				slog.Warn("Failed to delete old dead letters", "error", err)
+			}
+		}
+	}
+}
+
+// Memory is a DeadLetterStore for a single replica.
+type Memory struct {
+	mu          sync.Mutex
+	deadLetters map[string]DeadLetter
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{deadLetters: map[string]DeadLetter{}}
+}
+
+// AddDeadLetter implements DeadLetterStore.
+func (m *Memory) AddDeadLetter(ctx context.Context, dl DeadLetter) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deadLetters[dl.ID] = dl
+	return nil
+}
+
+// ListDeadLetters implements DeadLetterStore.
+func (m *Memory) ListDeadLetters(ctx context.Context, limit int) ([]DeadLetter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	deadLetters := make([]DeadLetter, 0, len(m.deadLetters))
+	for _, dl := range m.deadLetters {
+		deadLetters = append(deadLetters, dl)
+	}
+	slices.SortFunc(deadLetters, func(a, b DeadLetter) int {
+		return b.FailedAt.Compare(a.FailedAt)
+	})
+	if limit > 0 && len(deadLetters) > limit {
+		deadLetters = deadLetters[:limit]
+	}
+	return deadLetters, nil
+}
+
+// GetDeadLetter implements DeadLetterStore.
+func (m *Memory) GetDeadLetter(ctx context.Context, id string) (*DeadLetter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dl, ok := m.deadLetters[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return &dl, nil
+}
+
+// DeleteDeadLetter implements DeadLetterStore.
+func (m *Memory) DeleteDeadLetter(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.deadLetters[id]; !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	delete(m.deadLetters, id)
+	return nil
+}
+
+// DeleteDeadLetters implements DeadLetterStore.
+func (m *Memory) DeleteDeadLetters(ctx context.Context, before time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, dl := range m.deadLetters {
+		if dl.FailedAt.Before(before) {
+			delete(m.deadLetters, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSink fails the first failures attempts with err and records the time
+// of every attempt.
+type fakeSink struct {
+	mu       sync.Mutex
+	failures int
+	err      error
+	attempts []time.Time
+	sent     chan Event
+}
+
+func newFakeSink(failures int, err error) *fakeSink {
+	return &fakeSink{failures: failures, err: err, sent: make(chan Event, 10)}
+}
+
+func (s *fakeSink) Send(ctx context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts = append(s.attempts, time.Now())
+	if len(s.attempts) <= s.failures {
+		return s.err
+	}
+	s.sent <- event
+	return nil
+}
+
+func (s *fakeSink) attemptTimes() []time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]time.Time(nil), s.attempts...)
+}
+
+func newTestNotifier(t *testing.T, sink Sink, store DeadLetterStore, events ...EventType) *Notifier {
+	t.Helper()
+	n := New([]Destination{{Name: "test", Events: events, Sink: sink}}, store, Options{
+		Timeout:     time.Second,
+		MaxAttempts: 3,
+		Backoff:     50 * time.Millisecond,
+		QueueSize:   10,
+		Retention:   time.Hour,
+	})
+	t.Cleanup(n.Shutdown)
+	return n
+}
+
+// waitDeadLetters waits until store holds n dead letters.
+func waitDeadLetters(t *testing.T, store DeadLetterStore, n int) []DeadLetter {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		dls, err := store.ListDeadLetters(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dls) == n {
+			return dls
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d dead letters, want %d", len(dls), n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func waitSent(t *testing.T, sink *fakeSink) Event {
+	t.Helper()
+	select {
+	case event := <-sink.sent:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the delivery")
+		return Event{}
+	}
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	sink := newFakeSink(2, errors.New("connection refused"))
+	store := NewMemory()
+	n := newTestNotifier(t, sink, store)
+
+	n.Notify(Event{Type: EventOperationSucceeded, Summary: "done"})
+	event := waitSent(t, sink)
+	if event.ID == "" || event.Time.IsZero() {
+		t.Errorf("event %+v lacks the ID and time the notifier assigns", event)
+	}
+
+	attempts := sink.attemptTimes()
+	if len(attempts) != 3 {
+		t.Fatalf("made %d attempts, want 3", len(attempts))
+	}
+	// The delay doubles from Backoff.
+	for i, want := range []time.Duration{50 * time.Millisecond, 100 * time.Millisecond} {
+		if got := attempts[i+1].Sub(attempts[i]); got < want {
+			t.Errorf("attempt %d followed after %v, want at least %v", i+2, got, want)
+		}
+	}
+	if dls, _ := store.ListDeadLetters(context.Background(), 0); len(dls) != 0 {
+		t.Errorf("dead-lettered %d deliveries that succeeded", len(dls))
+	}
+}
+
+func TestDeadLetterAfterMaxAttempts(t *testing.T) {
+	sink := newFakeSink(10, errors.New("responded with 503 Service Unavailable"))
+	store := NewMemory()
+	n := newTestNotifier(t, sink, store)
+
+	n.Notify(Event{Type: EventRolloutFailed, Summary: "web failed"})
+	dl := waitDeadLetters(t, store, 1)[0]
+	if dl.Sink != "test" || dl.Attempts != 3 || dl.Error != "responded with 503 Service Unavailable" {
+		t.Errorf("dead letter = %+v, want 3 attempts of test failing with the last error", dl)
+	}
+	if dl.Event.Type != EventRolloutFailed || dl.Event.Summary != "web failed" {
+		t.Errorf("dead letter holds %+v, want the event", dl.Event)
+	}
+}
+
+func TestPermanentFailureIsNotRetried(t *testing.T) {
+	sink := newFakeSink(10, Permanent(errors.New("responded with 404 Not Found")))
+	store := NewMemory()
+	n := newTestNotifier(t, sink, store)
+
+	n.Notify(Event{Type: EventApprovalRequested})
+	dl := waitDeadLetters(t, store, 1)[0]
+	if dl.Attempts != 1 {
+		t.Errorf("made %d attempts, want 1", dl.Attempts)
+	}
+	if got := len(sink.attemptTimes()); got != 1 {
+		t.Errorf("sink saw %d attempts, want 1", got)
+	}
+}
+
+func TestRetryDeadLetter(t *testing.T) {
+	sink := newFakeSink(1, Permanent(errors.New("responded with 401 Unauthorized")))
+	store := NewMemory()
+	n := newTestNotifier(t, sink, store)
+
+	n.Notify(Event{Type: EventOperationFailed, Summary: "drain failed"})
+	dl := waitDeadLetters(t, store, 1)[0]
+
+	// The sink was fixed; the retry delivers the same event.
+	retried, err := n.Retry(context.Background(), dl.ID)
+	if err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if retried.ID != dl.ID {
+		t.Errorf("retried %s, want %s", retried.ID, dl.ID)
+	}
+	if event := waitSent(t, sink); event.ID != dl.Event.ID {
+		t.Errorf("delivered event %s, want %s", event.ID, dl.Event.ID)
+	}
+	waitDeadLetters(t, store, 0)
+
+	if _, err := n.Retry(context.Background(), dl.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("retrying twice = %v, want ErrNotFound", err)
+	}
+}
+
+func TestRetryUnknownSink(t *testing.T) {
+	store := NewMemory()
+	n := newTestNotifier(t, newFakeSink(0, nil), store)
+	if err := store.AddDeadLetter(context.Background(), DeadLetter{ID: "dl-1", Sink: "removed"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := n.Retry(context.Background(), "dl-1"); !errors.Is(err, ErrUnknownSink) {
+		t.Errorf("Retry = %v, want ErrUnknownSink", err)
+	}
+	if _, err := store.GetDeadLetter(context.Background(), "dl-1"); err != nil {
+		t.Errorf("dead letter of an unknown sink was deleted: %v", err)
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	sink := newFakeSink(0, nil)
+	n := newTestNotifier(t, sink, NewMemory(), EventApprovalRequested)
+
+	n.Notify(Event{Type: EventOperationSucceeded})
+	n.Notify(Event{Type: EventApprovalRequested})
+	if event := waitSent(t, sink); event.Type != EventApprovalRequested {
+		t.Errorf("delivered %s, want only %s", event.Type, EventApprovalRequested)
+	}
+	select {
+	case event := <-sink.sent:
+		t.Errorf("delivered unsubscribed %s", event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestShutdownDeadLettersPendingRetries(t *testing.T) {
+	sink := newFakeSink(10, errors.New("connection refused"))
+	store := NewMemory()
+	n := New([]Destination{{Name: "test", Sink: sink}}, store, Options{
+		Timeout:     time.Second,
+		MaxAttempts: 5,
+		Backoff:     time.Hour,
+		QueueSize:   10,
+	})
+
+	n.Notify(Event{Type: EventOperationFailed})
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sink.attemptTimes()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	n.Shutdown()
+
+	dls := waitDeadLetters(t, store, 1)
+	if dls[0].Error != errShutdown.Error() {
+		t.Errorf("dead letter error = %q, want %q", dls[0].Error, errShutdown)
+	}
+}
@@ -0,0 +1,163 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultMessage = `{{.Summary}}`
+	defaultSubject = `[iu-k8s] {{.Summary}}`
+)
+
+// SinkConfig configures a sink in the sinks file.
+type SinkConfig struct {
+	Name string `json:"name"`
+	// Type is webhook, slack or smtp.
+	Type string `json:"type"`
+	// Events are the event types sent to the sink; empty subscribes to all.
+	Events []EventType `json:"events,omitempty"`
+	// Message is a text/template of the message, executed with the Event.
+	Message string `json:"message,omitempty"`
+
+	// URL is where webhook and slack sinks post to.
+	URL string `json:"url,omitempty"`
+	// Secret signs the requests of a webhook sink.
+	Secret string `json:"secret,omitempty"`
+
+	SMTP *SMTPConfig `json:"smtp,omitempty"`
+}
+
+// SMTPConfig configures an smtp sink.
+type SMTPConfig struct {
+	// Addr is the host:port of the mail server. STARTTLS is used when the
+	// server offers it.
+	Addr     string   `json:"addr"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+	// Subject is a text/template of the subject, executed with the Event.
+	Subject string `json:"subject,omitempty"`
+}
+
+// LoadSinks reads the YAML or JSON sinks file at path and builds its
+// destinations.
+func LoadSinks(path string) ([]Destination, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read notification sinks: %w", err)
+	}
+	var file struct {
+		Sinks []SinkConfig `json:"sinks"`
+	}
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("parse notification sinks: %w", err)
+	}
+
+	var errs []error
+	seen := map[string]bool{}
+	destinations := make([]Destination, 0, len(file.Sinks))
+	for _, cfg := range file.Sinks {
+		if seen[cfg.Name] {
+			errs = append(errs, fmt.Errorf("sink %q: defined more than once", cfg.Name))
+		}
+		seen[cfg.Name] = true
+		sink, err := cfg.build()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sink %q: %w", cfg.Name, err))
+			continue
+		}
+		destinations = append(destinations, Destination{Name: cfg.Name, Events: cfg.Events, Sink: sink})
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid notification sinks:\n%w", err)
+	}
+	return destinations, nil
+}
+
+func (c *SinkConfig) build() (Sink, error) {
+	if c.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	for _, t := range c.Events {
+		if !slices.Contains(EventTypes, t) {
+			return nil, fmt.Errorf("unknown event type %q", t)
+		}
+	}
+	message, err := parseTemplate("message", c.Message, defaultMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.Type {
+	case "webhook", "slack":
+		if err := checkURL(c.URL); err != nil {
+			return nil, err
+		}
+		if c.SMTP != nil {
+			return nil, fmt.Errorf("smtp is not supported by %s sinks", c.Type)
+		}
+		if c.Type == "slack" {
+			if c.Secret != "" {
+				return nil, errors.New("secret is not supported by slack sinks")
+			}
+			return NewSlack(c.URL, message), nil
+		}
+		return NewWebhook(c.URL, c.Secret, message), nil
+	case "smtp":
+		if c.URL != "" || c.Secret != "" {
+			return nil, errors.New("url and secret are not supported by smtp sinks")
+		}
+		s := c.SMTP
+		if s == nil || s.Addr == "" || s.From == "" || len(s.To) == 0 {
+			return nil, errors.New("smtp.addr, smtp.from and smtp.to are required")
+		}
+		subject, err := parseTemplate("subject", s.Subject, defaultSubject)
+		if err != nil {
+			return nil, err
+		}
+		return NewSMTP(*s, subject, message)
+	default:
+		return nil, fmt.Errorf("unknown type %q, must be webhook, slack or smtp", c.Type)
+	}
+}
+
+func checkURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http or https URL, not %q", raw)
+	}
+	return nil
+}
+
+func parseTemplate(name, text, fallback string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		text = fallback
+	}
+	t, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	return t, nil
+}
+
+// render executes t with event.
+func render(t *template.Template, event Event) (string, error) {
+	var b strings.Builder
+	if err := t.Execute(&b, event); err != nil {
+		// The template fails the same way every time.
+		return "", Permanent(fmt.Errorf("render %s template: %w", t.Name(), err))
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,82 @@
+package notify
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeSinks(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "sinks.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadSinks(t *testing.T) {
+	destinations, err := LoadSinks(writeSinks(t, `
+sinks:
+  - name: audit-hook
+    type: webhook
+    url: https://hooks.example.com/iu
+    secret: s3cret
+  - name: oncall
+    type: slack
+    url: https://hooks.slack.com/services/T0/B0/x
+    events: [rollout.failed, approval.requested]
+    message: "{{.Summary}}"
+  - name: mail
+    type: smtp
+    smtp:
+      addr: mail.example.com:587
+      from: iu@example.com
+      to: [ops@example.com]
+`))
+	if err != nil {
+		t.Fatalf("LoadSinks: %v", err)
+	}
+	if len(destinations) != 3 {
+		t.Fatalf("got %d destinations, want 3", len(destinations))
+	}
+	if _, ok := destinations[0].Sink.(*WebhookSink); !ok {
+		t.Errorf("audit-hook is a %T, want a webhook", destinations[0].Sink)
+	}
+	if _, ok := destinations[1].Sink.(*SlackSink); !ok {
+		t.Errorf("oncall is a %T, want a slack sink", destinations[1].Sink)
+	}
+	if _, ok := destinations[2].Sink.(*SMTPSink); !ok {
+		t.Errorf("mail is a %T, want an smtp sink", destinations[2].Sink)
+	}
+	if d := destinations[1]; !d.subscribes(EventRolloutFailed) || d.subscribes(EventOperationSucceeded) {
+		t.Errorf("oncall subscribes to %v, want only its events", d.Events)
+	}
+	if d := destinations[0]; !d.subscribes(EventOperationSucceeded) {
+		t.Error("a sink without events does not subscribe to all")
+	}
+}
+
+func TestLoadSinksErrors(t *testing.T) {
+	tests := []struct {
+		name, content, want string
+	}{
+		{"unknown type", "sinks: [{name: a, type: pager}]", "unknown type"},
+		{"duplicate", "sinks: [{name: a, type: webhook, url: 'http://x'}, {name: a, type: webhook, url: 'http://y'}]", "defined more than once"},
+		{"relative url", "sinks: [{name: a, type: webhook, url: /hook}]", "absolute http or https URL"},
+		{"slack secret", "sinks: [{name: a, type: slack, url: 'https://x', secret: s}]", "secret is not supported"},
+		{"smtp without recipients", "sinks: [{name: a, type: smtp, smtp: {addr: 'mail:25', from: iu@example.com}}]", "smtp.to are required"},
+		{"unknown event", "sinks: [{name: a, type: webhook, url: 'http://x', events: [pod.crashed]}]", "unknown event type"},
+		{"bad template", "sinks: [{name: a, type: webhook, url: 'http://x', message: '{{.Summary'}]", "invalid message template"},
+		{"unknown field", "sinks: [{name: a, type: webhook, url: 'http://x', retries: 3}]", "unknown field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadSinks(writeSinks(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadSinks = %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
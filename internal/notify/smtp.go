@@ -0,0 +1,146 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// SMTPSink emails the rendered message.
+type SMTPSink struct {
+	addr    string
+	host    string
+	auth    smtp.Auth
+	from    *mail.Address
+	to      []*mail.Address
+	subject *template.Template
+	message *template.Template
+}
+
+// NewSMTP returns a sink mailing through the server of cfg. Without a
+// username it sends unauthenticated.
+func NewSMTP(cfg SMTPConfig, subject, message *template.Template) (*SMTPSink, error) {
+	host, _, err := net.SplitHostPort(cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("smtp.addr: %w", err)
+	}
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("smtp.from: %w", err)
+	}
+	s := &SMTPSink{addr: cfg.Addr, host: host, from: from, subject: subject, message: message}
+	for _, addr := range cfg.To {
+		to, err := mail.ParseAddress(addr)
+		if err != nil {
+			return nil, fmt.Errorf("smtp.to: %w", err)
+		}
+		s.to = append(s.to, to)
+	}
+	if cfg.Username != "" {
+		// PlainAuth refuses to send the password unencrypted to anything
+		// but localhost.
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, host)
+	}
+	return s, nil
+}
+
+// Send implements Sink.
+func (s *SMTPSink) Send(ctx context.Context, event Event) error {
+	subject, err := render(s.subject, event)
+	if err != nil {
+		return err
+	}
+	message, err := render(s.message, event)
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		return smtpError(err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return smtpError(err)
+		}
+	}
+	if s.auth != nil {
+		if err := c.Auth(s.auth); err != nil {
+			return smtpError(err)
+		}
+	}
+	if err := c.Mail(s.from.Address); err != nil {
+		return smtpError(err)
+	}
+	for _, to := range s.to {
+		if err := c.Rcpt(to.Address); err != nil {
+			return smtpError(err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return smtpError(err)
+	}
+	if _, err := w.Write(s.compose(event, subject, message)); err != nil {
+		return smtpError(err)
+	}
+	if err := w.Close(); err != nil {
+		return smtpError(err)
+	}
+	return smtpError(c.Quit())
+}
+
+// compose writes a plain text mail.
+func (s *SMTPSink) compose(event Event, subject, message string) []byte {
+	to := make([]string, len(s.to))
+	for i, addr := range s.to {
+		to[i] = addr.String()
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", strings.ReplaceAll(subject, "\n", " ")))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Message-ID: <%s@%s>\r\n", event.ID, s.host)
+	fmt.Fprintf(&b, "%s: %s\r\n", EventHeader, event.Type)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	for line := range strings.Lines(message) {
+		// Lines end in CRLF; the data writer escapes leading dots.
+		b.WriteString(strings.TrimRight(line, "\r\n"))
+		b.WriteString("\r\n")
+	}
+	return b.Bytes()
+}
+
+// smtpError marks rejections by the server as permanent.
+func smtpError(err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return Permanent(err)
+	}
+	return err
+}
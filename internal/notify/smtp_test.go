@@ -0,0 +1,176 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+// message is a mail accepted by a fakeSMTP server.
+type message struct {
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTP is a minimal SMTP server without extensions. It answers RCPT
+// with rcptReply, so tests can make it reject recipients.
+type fakeSMTP struct {
+	addr      string
+	rcptReply string
+	mails     chan message
+}
+
+func startSMTP(t *testing.T, rcptReply string) *fakeSMTP {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	s := &fakeSMTP{addr: ln.Addr().String(), rcptReply: rcptReply, mails: make(chan message, 10)}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	reply := func(line string) { _ = tp.PrintfLine("%s", line) }
+
+	reply("220 localhost ESMTP fake")
+	var m message
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250 localhost")
+		case "MAIL":
+			m = message{from: strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")}
+			reply("250 OK")
+		case "RCPT":
+			if !strings.HasPrefix(s.rcptReply, "250") {
+				reply(s.rcptReply)
+				continue
+			}
+			m.to = append(m.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			m.data = string(data)
+			s.mails <- m
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func newTestSMTP(t *testing.T, addr string) *SMTPSink {
+	t.Helper()
+	cfg := SMTPConfig{
+		Addr: addr,
+		From: "IU <iu@example.com>",
+		To:   []string{"ops@example.com", "Oncall <oncall@example.com>"},
+	}
+	subject, err := parseTemplate("subject", "", defaultSubject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink, err := NewSMTP(cfg, subject, mustTemplate(t, "message", "{{.Summary}}\n.\nby {{.Principal}}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sink
+}
+
+func TestSMTPSend(t *testing.T) {
+	server := startSMTP(t, "250 OK")
+	if err := newTestSMTP(t, server.addr).Send(context.Background(), testEvent()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	m := <-server.mails
+
+	if m.from != "iu@example.com" {
+		t.Errorf("MAIL FROM %q, want iu@example.com", m.from)
+	}
+	if strings.Join(m.to, ",") != "ops@example.com,oncall@example.com" {
+		t.Errorf("RCPT TO %v, want both recipients", m.to)
+	}
+	header, body, _ := strings.Cut(m.data, "\n\n")
+	for _, want := range []string{
+		`From: "IU" <iu@example.com>`,
+		`To: <ops@example.com>, "Oncall" <oncall@example.com>`,
+		"Subject: [iu-k8s] " + testEvent().Summary,
+		"Message-ID: <0b7d2c1e@127.0.0.1>",
+		EventHeader + ": rollout.failed",
+		"Content-Type: text/plain; charset=utf-8",
+	} {
+		if !strings.Contains(header, want+"\n") {
+			t.Errorf("header lacks %q:\n%s", want, header)
+		}
+	}
+	// The lone dot survives dot-stuffing.
+	if want := testEvent().Summary + "\n.\nby alice\n"; body != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestSMTPFailures(t *testing.T) {
+	tests := []struct {
+		reply     string
+		permanent bool
+	}{
+		{"550 no such user", true},
+		{"451 try again later", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reply, func(t *testing.T) {
+			server := startSMTP(t, tt.reply)
+			err := newTestSMTP(t, server.addr).Send(context.Background(), testEvent())
+			if err == nil {
+				t.Fatal("Send succeeded")
+			}
+			var permanent *permanentError
+			if got := errors.As(err, &permanent); got != tt.permanent {
+				t.Errorf("permanent = %v, want %v (%v)", got, tt.permanent, err)
+			}
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr := ln.Addr().String()
+		ln.Close()
+		err = newTestSMTP(t, addr).Send(context.Background(), testEvent())
+		var permanent *permanentError
+		if err == nil || errors.As(err, &permanent) {
+			t.Errorf("Send = %v, want a retryable error", err)
+		}
+	})
+}
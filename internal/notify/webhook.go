@@ -0,0 +1,138 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"text/template"
+	"time"
+)
+
+// Headers of webhook deliveries. The signature is the hex HMAC-SHA256 of
+// the timestamp, a dot and the body, keyed with the secret of the sink, so
+// that receivers can reject forged and replayed requests.
+const (
+	EventHeader     = "X-IU-Event"
+	DeliveryHeader  = "X-IU-Delivery"
+	TimestampHeader = "X-IU-Timestamp"
+	SignatureHeader = "X-IU-Signature"
+)
+
+// WebhookSink posts events as JSON, with the rendered message, to a URL.
+type WebhookSink struct {
+	url     string
+	secret  []byte
+	message *template.Template
+	client  *http.Client
+}
+
+// NewWebhook returns a sink posting to endpoint, signing requests with secret
+// unless it is empty.
+func NewWebhook(endpoint, secret string, message *template.Template) *WebhookSink {
+	s := &WebhookSink{url: endpoint, message: message, client: &http.Client{}}
+	if secret != "" {
+		s.secret = []byte(secret)
+	}
+	return s
+}
+
+// Send implements Sink.
+func (s *WebhookSink) Send(ctx context.Context, event Event) error {
+	message, err := render(s.message, event)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(struct {
+		Event
+		Message string `json:"message"`
+	}{event, message})
+	if err != nil {
+		return Permanent(err)
+	}
+
+	header := http.Header{}
+	header.Set(EventHeader, string(event.Type))
+	header.Set(DeliveryHeader, event.ID)
+	if s.secret != nil {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		header.Set(TimestampHeader, timestamp)
+		header.Set(SignatureHeader, "sha256="+Sign(s.secret, timestamp, body))
+	}
+	return post(ctx, s.client, s.url, header, body)
+}
+
+// Sign returns the signature of a webhook delivery.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SlackSink posts the rendered message to a Slack-compatible incoming
+// webhook.
+type SlackSink struct {
+	url     string
+	message *template.Template
+	client  *http.Client
+}
+
+// NewSlack returns a sink posting to the incoming webhook at endpoint.
+func NewSlack(endpoint string, message *template.Template) *SlackSink {
+	return &SlackSink{url: endpoint, message: message, client: &http.Client{}}
+}
+
+// Send implements Sink.
+func (s *SlackSink) Send(ctx context.Context, event Event) error {
+	message, err := render(s.message, event)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]string{"text": message})
+	if err != nil {
+		return Permanent(err)
+	}
+	return post(ctx, s.client, s.url, http.Header{}, body)
+}
+
+// post sends a JSON body. Client errors other than timeouts and rate
+// limits are permanent.
+func post(ctx context.Context, client *http.Client, endpoint string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(err)
+	}
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// Leave out the URL, which may hold a token, as with Slack.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = fmt.Errorf("post: %w", urlErr.Err)
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+	err = fmt.Errorf("responded with %s", resp.Status)
+	if resp.StatusCode >= 400 && resp.StatusCode <= 499 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return Permanent(err)
+	}
+	return err
+}
@@ -0,0 +1,208 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/audit"
+)
+
+// received is a request captured by a test endpoint.
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+// endpoint starts a server answering every request with status and passing
+// it on through the returned channel.
+func endpoint(t *testing.T, status int) (string, <-chan received) {
+	t.Helper()
+	requests := make(chan received, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{header: r.Header.Clone(), body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL, requests
+}
+
+func testEvent() Event {
+	return Event{
+		ID:        "0b7d2c1e",
+		Type:      EventRolloutFailed,
+		Time:      time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Principal: "alice",
+		Target:    audit.Target{Cluster: "dev", Namespace: "shop", Resource: "deployments", Name: "web"},
+		Summary:   "deployments.scale of dev/shop/deployments/web failed",
+		Details:   map[string]any{"operationId": "op-1"},
+	}
+}
+
+func mustTemplate(t *testing.T, name, text string) *template.Template {
+	t.Helper()
+	tmpl, err := parseTemplate(name, text, defaultMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tmpl
+}
+
+func TestWebhookSignsDeliveries(t *testing.T) {
+	url, requests := endpoint(t, http.StatusNoContent)
+	sink := NewWebhook(url, "s3cret", mustTemplate(t, "message", ""))
+
+	if err := sink.Send(context.Background(), testEvent()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	req := <-requests
+
+	if got := req.header.Get(EventHeader); got != string(EventRolloutFailed) {
+		t.Errorf("%s = %q, want %q", EventHeader, got, EventRolloutFailed)
+	}
+	if got := req.header.Get(DeliveryHeader); got != "0b7d2c1e" {
+		t.Errorf("%s = %q, want the event ID", DeliveryHeader, got)
+	}
+	timestamp := req.header.Get(TimestampHeader)
+	sent, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Since(time.Unix(sent, 0)) > time.Minute {
+		t.Errorf("%s = %q, want the current Unix time", TimestampHeader, timestamp)
+	}
+	want := "sha256=" + Sign([]byte("s3cret"), timestamp, req.body)
+	if got := req.header.Get(SignatureHeader); got != want {
+		t.Errorf("%s = %q, want %q", SignatureHeader, got, want)
+	}
+	if Sign([]byte("other"), timestamp, req.body) == Sign([]byte("s3cret"), timestamp, req.body) {
+		t.Error("signature does not depend on the secret")
+	}
+	if Sign([]byte("s3cret"), "0", req.body) == Sign([]byte("s3cret"), timestamp, req.body) {
+		t.Error("signature does not depend on the timestamp")
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	if payload["id"] != "0b7d2c1e" || payload["type"] != string(EventRolloutFailed) || payload["principal"] != "alice" {
+		t.Errorf("body = %s, want the event", req.body)
+	}
+	if payload["message"] != testEvent().Summary {
+		t.Errorf("message = %v, want the summary", payload["message"])
+	}
+}
+
+func TestWebhookWithoutSecretIsUnsigned(t *testing.T) {
+	url, requests := endpoint(t, http.StatusOK)
+	if err := NewWebhook(url, "", mustTemplate(t, "message", "")).Send(context.Background(), testEvent()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	req := <-requests
+	if req.header.Get(SignatureHeader) != "" || req.header.Get(TimestampHeader) != "" {
+		t.Errorf("unsigned sink sent %s %q and %s %q", SignatureHeader, req.header.Get(SignatureHeader),
+			TimestampHeader, req.header.Get(TimestampHeader))
+	}
+}
+
+func TestSlackPayload(t *testing.T) {
+	url, requests := endpoint(t, http.StatusOK)
+	message := mustTemplate(t, "message", `:warning: {{.Target.Name}} in {{.Target.Cluster}}: {{index .Details "operationId"}}`)
+	if err := NewSlack(url, message).Send(context.Background(), testEvent()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	req := <-requests
+
+	if got := req.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	want := map[string]any{"text": ":warning: web in dev: op-1"}
+	if len(payload) != 1 || payload["text"] != want["text"] {
+		t.Errorf("payload = %v, want %v", payload, want)
+	}
+}
+
+func TestTemplating(t *testing.T) {
+	tests := []struct {
+		name, text, want string
+	}{
+		{"default", "", testEvent().Summary},
+		{"blank", "  \n", testEvent().Summary},
+		{"fields", "{{.Type}} by {{.Principal}} at {{.Time.Format \"15:04\"}}", "rollout.failed by alice at 03:04"},
+		{"missing detail", `[{{index .Details "nope"}}]`, "[<no value>]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := render(mustTemplate(t, "message", tt.text), testEvent())
+			if err != nil {
+				t.Fatalf("render: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("render = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := parseTemplate("message", "{{.Summary", defaultMessage); err == nil {
+		t.Error("parsed an unterminated action")
+	}
+	// Templates failing at execution fail every attempt the same way.
+	_, err := render(mustTemplate(t, "message", "{{.Summary.Nope}}"), testEvent())
+	var permanent *permanentError
+	if !errors.As(err, &permanent) {
+		t.Errorf("render error %v is not permanent", err)
+	}
+}
+
+func TestPostFailures(t *testing.T) {
+	tests := []struct {
+		status    int
+		permanent bool
+	}{
+		{http.StatusBadRequest, true},
+		{http.StatusUnauthorized, true},
+		{http.StatusNotFound, true},
+		{http.StatusRequestTimeout, false},
+		{http.StatusTooManyRequests, false},
+		{http.StatusInternalServerError, false},
+		{http.StatusBadGateway, false},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			url, _ := endpoint(t, tt.status)
+			err := NewWebhook(url, "", mustTemplate(t, "message", "")).Send(context.Background(), testEvent())
+			if err == nil {
+				t.Fatal("Send succeeded")
+			}
+			var permanent *permanentError
+			if got := errors.As(err, &permanent); got != tt.permanent {
+				t.Errorf("permanent = %v, want %v (%v)", got, tt.permanent, err)
+			}
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		url := srv.URL + "/hooks/T0KEN"
+		srv.Close()
+		err := NewSlack(url, mustTemplate(t, "message", "")).Send(context.Background(), testEvent())
+		var permanent *permanentError
+		if err == nil || errors.As(err, &permanent) {
+			t.Fatalf("Send = %v, want a retryable error", err)
+		}
+		if got := err.Error(); strings.Contains(got, "T0KEN") {
+			t.Errorf("error %q leaks the URL", got)
+		}
+	})
+}
@@ -0,0 +1,231 @@
+package operation
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/recovery"
+)
+
+// ErrNotFound is returned for unknown operation IDs.
+var ErrNotFound = errors.New("operation not found")
+
+// errShutdown fails operations still running when the manager shuts down.
+var errShutdown = errors.New("operation interrupted by server shutdown")
+
+// Status is the lifecycle state of an operation.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// Progress describes how far an operation has come.
+type Progress struct {
+	// Percent is the completion estimate from 0 to 100.
+	Percent int
+	Message string
+	// Detail carries the operation specific state, such as a rollout status.
+	Detail any
+}
+
+// Operation is a long-running action started by a mutating API call.
+type Operation struct {
+	ID         string
+	Type       string
+	Target     audit.Target
+	Principal  auth.Principal
+	Status     Status
+	Progress   Progress
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	Error      string
+	// Timeout, when set, bounds the tracker instead of the timeout of the
+	// manager, for operations that take a timeout of their own.
+	Timeout time.Duration
+}
+
+// Tracker follows an operation until it completes. It reports progress
+// through report and returns nil on success.
+type Tracker func(ctx context.Context, report func(Progress)) error
+
+// Options configure a Manager.
+type Options struct {
+	// Timeout bounds how long a tracker may run before the operation fails.
+	Timeout time.Duration
+	// Retention is how long finished operations remain queryable.
+	Retention time.Duration
+	// OnFinish, if set, is called with every operation that finished.
+	OnFinish func(Operation)
+}
+
+// Manager runs trackers in the background and keeps the state of their
+// operations in memory.
+type Manager struct {
+	opts   Options
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu         sync.RWMutex
+	operations map[string]*entry
+}
+
+type entry struct {
+	op   Operation
+	done chan struct{}
+}
+
+// NewManager creates a manager and starts its cleanup of expired operations.
+func NewManager(opts Options) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		opts:       opts,
+		ctx:        ctx,
+		cancel:     cancel,
+		operations: map[string]*entry{},
+	}
+
+	m.wg.Add(1)
+	go m.expire()
+	return m
+}
+
+// Start registers op and runs tracker in the background. The caller sets
+// the type, target, principal and optionally the initial progress and
+// timeout; the manager assigns the ID and lifecycle fields. It returns the registered
+// operation.
+func (m *Manager) Start(op Operation, tracker Tracker) Operation {
+	op.ID = uuid.NewString()
+	op.Status = StatusPending
+	op.StartedAt = time.Now()
+	op.FinishedAt = nil
+	op.Error = ""
+	e := &entry{op: op, done: make(chan struct{})}
+
+	m.mu.Lock()
+	m.operations[e.op.ID] = e
+	m.mu.Unlock()
+
+	m.wg.Add(1)
+	go m.run(e, tracker)
+	return op
+}
+
+// Get returns the current state of an operation.
+func (m *Manager) Get(id string) (Operation, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	e, ok := m.operations[id]
+	if !ok {
+		return Operation{}, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return e.op, nil
+}
+
+// Wait blocks until the operation finishes or ctx is done, then returns its
+// state. Hitting the deadline of ctx is not an error; the operation is
+// returned as it stands.
+func (m *Manager) Wait(ctx context.Context, id string) (Operation, error) {
+	m.mu.RLock()
+	e, ok := m.operations[id]
+	m.mu.RUnlock()
+	if !ok {
+		return Operation{}, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+
+	select {
+	case <-e.done:
+	case <-ctx.Done():
+	}
+	return m.Get(id)
+}
+
+// Shutdown stops all trackers, failing their operations, and waits for them.
+func (m *Manager) Shutdown() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *Manager) run(e *entry, tracker Tracker) {
+	defer m.wg.Done()
+	defer close(e.done)
+
+	logger := slog.With("component", "operation", "operation_id", e.op.ID, "type", e.op.Type)
+	m.update(e, func(op *Operation) { op.Status = StatusRunning })
+
+	timeout := cmp.Or(e.op.Timeout, m.opts.Timeout)
+	ctx, cancel := context.WithTimeout(m.ctx, timeout)
+	defer cancel()
+
+	// A panicking tracker fails its operation rather than the server.
+	err := recovery.Catch(log.With(ctx, logger), "operation", func() error {
+		return tracker(ctx, func(p Progress) {
+			m.update(e, func(op *Operation) { op.Progress = p })
+		})
+	})
+	if err != nil && m.ctx.Err() != nil {
+		err = errShutdown
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("operation did not complete within %s", timeout)
+	}
+
+	m.update(e, func(op *Operation) {
+		now := time.Now()
+		op.FinishedAt = &now
+		if err != nil {
+			op.Status = StatusFailed
+			op.Error = err.Error()
+			return
+		}
+		op.Status = StatusSucceeded
+		op.Progress.Percent = 100
+	})
+	logger.Info("operation finished", "error", err, "duration", time.Since(e.op.StartedAt))
+	if m.opts.OnFinish != nil {
+		m.mu.RLock()
+		op := e.op
+		m.mu.RUnlock()
+		m.opts.OnFinish(op)
+	}
+}
+
+func (m *Manager) update(e *entry, fn func(*Operation)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(&e.op)
+}
+
+// expire drops finished operations older than the retention period.
+func (m *Manager) expire() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.mu.Lock()
+			for id, e := range m.operations {
+				if e.op.FinishedAt != nil && now.Sub(*e.op.FinishedAt) > m.opts.Retention {
+					delete(m.operations, id)
+				}
+			}
+			m.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package operation
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOperationTimeout(t *testing.T) {
+	m := NewManager(Options{Timeout: 20 * time.Millisecond, Retention: time.Minute})
+	defer m.Shutdown()
+	block := func(ctx context.Context, report func(Progress)) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		want    string
+	}{
+		{"manager's", 0, "operation did not complete within 20ms"},
+		{"own", 200 * time.Millisecond, "operation did not complete within 200ms"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			op := m.Start(Operation{Type: "test.block", Timeout: tt.timeout}, block)
+			op, err := m.Wait(context.Background(), op.ID)
+			if err != nil {
+				t.Fatalf("Wait: %v", err)
+			}
+			if op.Status != StatusFailed || op.Error != tt.want {
+				t.Errorf("operation %s with %q, want failed with %q", op.Status, op.Error, tt.want)
+			}
+			if elapsed := time.Since(start); elapsed < max(tt.timeout, 20*time.Millisecond) {
+				t.Errorf("failed after %v, want after its timeout", elapsed)
+			}
+		})
+	}
+}
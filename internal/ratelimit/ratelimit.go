@@ -0,0 +1,161 @@
+// Package ratelimit limits the request rate of each caller with token
+// buckets. Buckets live in memory or, to hold across replicas, in the
+// database.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+// Limit is the quota of a bucket: Burst requests at once, refilled at Rate
+// requests per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Window is the time an empty bucket takes to refill.
+func (l Limit) Window() time.Duration {
+	return time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+}
+
+// Bucket is the state of a token bucket.
+type Bucket struct {
+	Tokens float64
+	// Updated is when Tokens was computed. A zero bucket is full.
+	Updated time.Time
+}
+
+// Result is the outcome of taking a token.
+type Result struct {
+	Allowed bool
+	// Remaining is the number of whole tokens left.
+	Remaining int
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is the time until a token is available when not allowed.
+	RetryAfter time.Duration
+}
+
+// Take refills the bucket up to now and takes a token if one is available.
+func (b *Bucket) Take(limit Limit, now time.Time) Result {
+	burst := float64(limit.Burst)
+	if b.Updated.IsZero() {
+		b.Tokens = burst
+	} else if elapsed := now.Sub(b.Updated); elapsed > 0 {
+		b.Tokens = math.Min(burst, b.Tokens+elapsed.Seconds()*limit.Rate)
+	}
+	b.Updated = now
+
+	var res Result
+	if b.Tokens >= 1 {
+		b.Tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = seconds((1 - b.Tokens) / limit.Rate)
+	}
+	res.Remaining = int(b.Tokens)
+	res.Reset = seconds((burst - b.Tokens) / limit.Rate)
+	return res
+}
+
+// Full reports whether the bucket has refilled completely by now, so that
+// forgetting it changes nothing.
+func (b *Bucket) Full(limit Limit, now time.Time) bool {
+	return b.Tokens+now.Sub(b.Updated).Seconds()*limit.Rate >= float64(limit.Burst)
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// Store keeps the buckets. TakeToken must update a bucket atomically.
+type Store interface {
+	TakeToken(ctx context.Context, key string, limit Limit, now time.Time) (Result, error)
+}
+
+// Memory is a Store for a single replica.
+type Memory struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	swept   time.Time
+}
+
+type memoryBucket struct {
+	Bucket
+	limit Limit
+}
+
+// sweepInterval is how often Memory forgets full buckets.
+const sweepInterval = time.Minute
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{buckets: map[string]*memoryBucket{}}
+}
+
+// TakeToken implements Store.
+func (m *Memory) TakeToken(ctx context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if now.Sub(m.swept) > sweepInterval {
+		for k, b := range m.buckets {
+			if b.Full(b.limit, now) {
+				delete(m.buckets, k)
+			}
+		}
+		m.swept = now
+	}
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &memoryBucket{}
+		m.buckets[key] = b
+	}
+	b.limit = limit
+	return b.Take(limit, now), nil
+}
+
+// Limiter picks the bucket and quota of a request.
+type Limiter struct {
+	store     Store
+	limit     Limit
+	overrides map[string]Limit
+}
+
+// New creates a limiter with the quotas of cfg. Overrides must name
+// operations in known.
+func New(cfg config.RateLimitConfig, store Store, known func(operationID string) bool) (*Limiter, error) {
+	l := &Limiter{
+		store:     store,
+		limit:     Limit{Rate: cfg.Rate, Burst: cfg.Burst},
+		overrides: map[string]Limit{},
+	}
+	for operationID, o := range cfg.Overrides {
+		if !known(operationID) {
+			return nil, fmt.Errorf("RATE_LIMIT_OVERRIDES: unknown operation %q", operationID)
+		}
+		l.overrides[operationID] = Limit{Rate: o.Rate, Burst: o.Burst}
+	}
+	return l, nil
+}
+
+// Take takes a token for a request of caller to operationID, which may be
+// empty for requests that match no operation. Operations with an override
+// have a bucket per caller of their own; all other requests of a caller
+// share one. It returns the quota the result applies to.
+func (l *Limiter) Take(ctx context.Context, caller, operationID string) (Limit, Result, error) {
+	key, limit := caller, l.limit
+	if o, ok := l.overrides[operationID]; ok {
+		key, limit = operationID+"|"+caller, o
+	}
+	res, err := l.store.TakeToken(ctx, key, limit, time.Now())
+	return limit, res, err
+}
@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+func TestBucket(t *testing.T) {
+	limit := Limit{Rate: 2, Burst: 3}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var b Bucket
+
+	for i, want := range []int{2, 1, 0} {
+		res := b.Take(limit, now)
+		if !res.Allowed || res.Remaining != want {
+			t.Fatalf("take %d = %+v, want allowed with %d remaining", i+1, res, want)
+		}
+	}
+	res := b.Take(limit, now)
+	if res.Allowed || res.RetryAfter != 500*time.Millisecond || res.Reset != 1500*time.Millisecond {
+		t.Errorf("take over the burst = %+v, want denied, retry after 500ms and reset after 1.5s", res)
+	}
+
+	now = now.Add(250 * time.Millisecond)
+	if res := b.Take(limit, now); res.Allowed || res.RetryAfter != 250*time.Millisecond {
+		t.Errorf("take after 250ms = %+v, want denied for another 250ms", res)
+	}
+	now = now.Add(250 * time.Millisecond)
+	if res := b.Take(limit, now); !res.Allowed || res.Remaining != 0 {
+		t.Errorf("take after 500ms = %+v, want allowed", res)
+	}
+
+	// Refills stop at the burst.
+	if b.Full(limit, now.Add(time.Second)) {
+		t.Error("Full after 1s, want after 1.5s")
+	}
+	if !b.Full(limit, now.Add(1500*time.Millisecond)) {
+		t.Error("not Full after 1.5s")
+	}
+	if res := b.Take(limit, now.Add(time.Hour)); !res.Allowed || res.Remaining != 2 {
+		t.Errorf("take after an hour = %+v, want a full bucket", res)
+	}
+}
+
+func TestLimiterOverrides(t *testing.T) {
+	cfg := config.RateLimitConfig{
+		Rate:      1,
+		Burst:     2,
+		Overrides: map[string]config.RateLimit{"applyManifests": {Rate: 1, Burst: 1}},
+	}
+	known := func(operationID string) bool { return operationID != "nope" }
+	l, err := New(cfg, NewMemory(), known)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	ctx := context.Background()
+
+	take := func(caller, operationID string) (Limit, Result) {
+		t.Helper()
+		limit, res, err := l.Take(ctx, caller, operationID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return limit, res
+	}
+
+	// Operations without an override share the caller's bucket.
+	take("principal:alice", "listClusters")
+	if _, res := take("principal:alice", "getOperation"); !res.Allowed || res.Remaining != 0 {
+		t.Errorf("second request = %+v, want the last token", res)
+	}
+	if _, res := take("principal:alice", ""); res.Allowed {
+		t.Error("third request allowed over a burst of 2")
+	}
+	// Overridden operations and other callers have buckets of their own.
+	if limit, res := take("principal:alice", "applyManifests"); !res.Allowed || limit.Burst != 1 {
+		t.Errorf("applyManifests = %+v with %+v, want allowed by its own quota", res, limit)
+	}
+	if _, res := take("principal:alice", "applyManifests"); res.Allowed {
+		t.Error("applyManifests allowed over its burst of 1")
+	}
+	if _, res := take("principal:bob", "listClusters"); !res.Allowed {
+		t.Error("bob limited by alice's requests")
+	}
+
+	cfg.Overrides = map[string]config.RateLimit{"nope": {Rate: 1, Burst: 1}}
+	if _, err := New(cfg, NewMemory(), known); err == nil {
+		t.Error("New accepted an override of an unknown operation")
+	}
+}
+
+func TestMemoryForgetsFullBuckets(t *testing.T) {
+	m := NewMemory()
+	limit := Limit{Rate: 1, Burst: 1}
+	now := time.Now()
+	m.TakeToken(context.Background(), "a", limit, now)
+	m.TakeToken(context.Background(), "b", limit, now.Add(sweepInterval+time.Second))
+	if _, ok := m.buckets["a"]; ok {
+		t.Error("kept a bucket that has refilled")
+	}
+	if _, ok := m.buckets["b"]; !ok {
+		t.Error("forgot a bucket in use")
+	}
+}
@@ -0,0 +1,43 @@
+// Package recovery turns panics into errors that are logged with their
+// stack and counted, so that a bug in one request or background task does
+// not take the server down.
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"iu-k8s.linecorp.com/server/internal/log"
+	"iu-k8s.linecorp.com/server/internal/metrics"
+)
+
+// PanicError is a recovered panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Report logs a panic recovered in source, such as "http" or "operation",
+// with its stack through the logger of ctx and counts it.
+func Report(ctx context.Context, source string, value any, stack []byte) {
+	metrics.Panicked(source)
+	log.From(ctx).Error("panic recovered", "source", source, "panic", fmt.Sprint(value), "stack", string(stack))
+}
+
+// Catch runs fn and returns a panic in it as a *PanicError after reporting
+// it under source.
+func Catch(ctx context.Context, source string, fn func() error) (err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			stack := debug.Stack()
+			Report(ctx, source, value, stack)
+			err = &PanicError{Value: value, Stack: stack}
+		}
+	}()
+	return fn()
+}
@@ -0,0 +1,76 @@
+// Package requestid carries the ID of a request through its context and
+// onto the calls the server makes on its behalf, so that the logs of the
+// server, the clusters and the webhooks it calls can be correlated.
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+// Header carries the request ID in responses and outbound requests.
+const Header = "X-Request-ID"
+
+// With returns a context carrying id. It shares its key with chi's request
+// ID middleware, whose GetReqID keeps working.
+func With(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, middleware.RequestIDKey, id)
+}
+
+// From returns the request ID of ctx, or "" outside a request.
+func From(ctx context.Context) string {
+	return middleware.GetReqID(ctx)
+}
+
+// New generates a request ID. UUIDv7 IDs sort by the time they were
+// generated.
+func New() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		// Only a failing random source gets here.
+		return uuid.NewString()
+	}
+	return id.String()
+}
+
+// Valid reports whether a client-supplied id is safe to log and pass on: at
+// most maxLength characters of letters, digits and -_.:/+=@, which covers
+// UUIDs, trace IDs and the IDs of common proxies.
+func Valid(id string, maxLength int) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, c := range []byte(id) {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':', c == '/', c == '+', c == '=', c == '@':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Transport sets the request ID of each request's context on requests sent
+// through next that do not carry one.
+func Transport(next http.RoundTripper) http.RoundTripper {
+	return roundTripper{next: next}
+}
+
+type roundTripper struct {
+	next http.RoundTripper
+}
+
+func (t roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := From(req.Context())
+	if id == "" || req.Header.Get(Header) != "" {
+		return t.next.RoundTrip(req)
+	}
+	// RoundTrippers must not modify the request they are given.
+	req = req.Clone(req.Context())
+	req.Header.Set(Header, id)
+	return t.next.RoundTrip(req)
+}
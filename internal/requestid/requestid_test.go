@@ -0,0 +1,113 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		maxLength int
+		want      bool
+	}{
+		{"uuid", "0192f1c4-6a3e-7b8c-9d0e-1f2a3b4c5d6e", 64, true},
+		{"trace id", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", 64, true},
+		{"punctuation", "req_1.2:3/4+5=6@edge", 64, true},
+		{"at the maximum", strings.Repeat("a", 16), 16, true},
+		{"too long", strings.Repeat("a", 17), 16, false},
+		{"empty", "", 64, false},
+		{"semicolon", "Root=1-67891233;Sampled=1", 64, false},
+		{"space", "abc def", 64, false},
+		{"newline", "abc\ndef", 64, false},
+		{"quote", `abc"def`, 64, false},
+		{"non-ascii", "abcdé", 64, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Valid(tt.id, tt.maxLength); got != tt.want {
+				t.Errorf("Valid(%q, %d) = %v, want %v", tt.id, tt.maxLength, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	first := New()
+	id, err := uuid.Parse(first)
+	if err != nil {
+		t.Fatalf("New() = %q: %v", first, err)
+	}
+	if id.Version() != 7 {
+		t.Errorf("New() = %q, version %d, want a UUIDv7", first, id.Version())
+	}
+	if second := New(); second <= first {
+		t.Errorf("New() = %q after %q, want IDs sorting by generation", second, first)
+	}
+}
+
+func TestWith(t *testing.T) {
+	if got := From(context.Background()); got != "" {
+		t.Errorf("From outside a request = %q, want none", got)
+	}
+	ctx := With(context.Background(), "abc")
+	if got := From(ctx); got != "abc" {
+		t.Errorf("From = %q, want abc", got)
+	}
+	if got := middleware.GetReqID(ctx); got != "abc" {
+		t.Errorf("chi GetReqID = %q, want abc", got)
+	}
+}
+
+// roundTripFunc is an http.RoundTripper answering with a function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctxID  string
+		header string
+		want   string
+	}{
+		{"from the context", "abc", "", "abc"},
+		{"set by the caller", "abc", "def", "def"},
+		{"outside a request", "", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			transport := Transport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				got = req.Header.Get(Header)
+				return httptest.NewRecorder().Result(), nil
+			}))
+			ctx := context.Background()
+			if tt.ctxID != "" {
+				ctx = With(ctx, tt.ctxID)
+			}
+			req := httptest.NewRequest(http.MethodGet, "http://cluster.example/api", nil).WithContext(ctx)
+			if tt.header != "" {
+				req.Header.Set(Header, tt.header)
+			}
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("%s = %q, want %q", Header, got, tt.want)
+			}
+			if tt.header == "" && req.Header.Get(Header) != "" {
+				t.Errorf("the request given was modified")
+			}
+		})
+	}
+}
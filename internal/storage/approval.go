@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/approval"
+)
+
+var _ approval.Store = (*DB)(nil)
+
+const approvalColumns = `id, operation_id, cluster, namespace, resource, name, summary, request, body,
+	requester, requester_uid, requester_groups, requester_source, requested_at, expires_at, status,
+	decided_by, decided_at, comment, result`
+
+// CreateApproval implements approval.Store.
+func (d *DB) CreateApproval(ctx context.Context, a approval.Approval) error {
+	args, err := approvalArgs(a)
+	if err != nil {
+		return err
+	}
+	_, err = d.db.ExecContext(ctx, d.rebind(`
+		INSERT INTO approvals (`+approvalColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`), args...)
+	return err
+}
+
+// GetApproval implements approval.Store.
+func (d *DB) GetApproval(ctx context.Context, id string) (*approval.Approval, error) {
+	row := d.db.QueryRowContext(ctx, d.rebind(`SELECT `+approvalColumns+` FROM approvals WHERE id = ?`), id)
+	a, err := scanApproval(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", approval.ErrNotFound, id)
+	}
+	return a, err
+}
+
+// ListApprovals implements approval.Store.
+func (d *DB) ListApprovals(ctx context.Context, filter approval.Filter) ([]approval.Approval, error) {
+	stmt := `SELECT ` + approvalColumns + ` FROM approvals`
+	var args []any
+	if filter.Status != "" {
+		stmt += ` WHERE status = ?`
+		args = append(args, string(filter.Status))
+	}
+	stmt += ` ORDER BY requested_at DESC`
+	if filter.Limit > 0 {
+		stmt += ` LIMIT ?`
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := d.db.QueryContext(ctx, d.rebind(stmt), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	approvals := []approval.Approval{}
+	for rows.Next() {
+		a, err := scanApproval(rows)
+		if err != nil {
+			return nil, err
+		}
+		approvals = append(approvals, *a)
+	}
+	return approvals, rows.Err()
+}
+
+// UpdateApproval implements approval.Store. The status condition makes
+// concurrent decisions agree on a single winner.
+func (d *DB) UpdateApproval(ctx context.Context, a approval.Approval, from approval.Status) error {
+	var result any
+	if a.Result != nil {
+		data, err := json.Marshal(a.Result)
+		if err != nil {
+			return err
+		}
+		result = string(data)
+	}
+	res, err := d.db.ExecContext(ctx, d.rebind(`
+		UPDATE approvals SET status = ?, decided_by = ?, decided_at = ?, comment = ?, result = ?
+		WHERE id = ? AND status = ?`),
+		string(a.Status), a.DecidedBy, a.DecidedAt.UnixNano(), a.Comment, result, a.ID, string(from))
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("%w: %s is no longer %s", approval.ErrNotPending, a.ID, from)
+	}
+	return nil
+}
+
+// DeleteApprovals implements approval.Store.
+func (d *DB) DeleteApprovals(ctx context.Context, before time.Time) error {
+	_, err := d.db.ExecContext(ctx, d.rebind(`
+		DELETE FROM approvals WHERE status <> ? AND decided_at < ?`),
+		string(approval.StatusPending), before.UnixNano())
+	return err
+}
+
+func approvalArgs(a approval.Approval) ([]any, error) {
+	groups, err := json.Marshal(a.Requester.Groups)
+	if err != nil {
+		return nil, err
+	}
+	var result any
+	if a.Result != nil {
+		data, err := json.Marshal(a.Result)
+		if err != nil {
+			return nil, err
+		}
+		result = string(data)
+	}
+	var decidedAt int64
+	if !a.DecidedAt.IsZero() {
+		decidedAt = a.DecidedAt.UnixNano()
+	}
+	return []any{
+		a.ID, a.OperationID, a.Target.Cluster, a.Target.Namespace, a.Target.Resource, a.Target.Name,
+		a.Summary, string(a.Request), a.Body, a.Requester.Name, a.Requester.UID, string(groups), a.Requester.Source,
+		a.RequestedAt.UnixNano(), a.ExpiresAt.UnixNano(), string(a.Status),
+		a.DecidedBy, decidedAt, a.Comment, result,
+	}, nil
+}
+
+// scanApproval reads a row selected with approvalColumns.
+func scanApproval(row interface{ Scan(...any) error }) (*approval.Approval, error) {
+	var (
+		a                                 approval.Approval
+		request, groups, status           string
+		requestedAt, expiresAt, decidedAt int64
+		result                            sql.NullString
+	)
+	err := row.Scan(&a.ID, &a.OperationID, &a.Target.Cluster, &a.Target.Namespace, &a.Target.Resource, &a.Target.Name,
+		&a.Summary, &request, &a.Body, &a.Requester.Name, &a.Requester.UID, &groups, &a.Requester.Source,
+		&requestedAt, &expiresAt, &status, &a.DecidedBy, &decidedAt, &a.Comment, &result)
+	if err != nil {
+		return nil, err
+	}
+	a.Request = json.RawMessage(request)
+	a.Status = approval.Status(status)
+	a.RequestedAt = time.Unix(0, requestedAt).UTC()
+	a.ExpiresAt = time.Unix(0, expiresAt).UTC()
+	if decidedAt != 0 {
+		a.DecidedAt = time.Unix(0, decidedAt).UTC()
+	}
+	if err := json.Unmarshal([]byte(groups), &a.Requester.Groups); err != nil {
+		return nil, err
+	}
+	if result.Valid {
+		a.Result = &approval.Result{}
+		if err := json.Unmarshal([]byte(result.String), a.Result); err != nil {
+			return nil, err
+		}
+	}
+	return &a, nil
+}
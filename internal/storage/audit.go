@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/audit"
+)
+
+// RecordAudit implements Repository.
+func (d *DB) RecordAudit(ctx context.Context, entry audit.Entry) error {
+	var details any
+	if len(entry.Details) > 0 {
+		data, err := json.Marshal(entry.Details)
+		if err != nil {
+			return err
+		}
+		details = string(data)
+	}
+	_, err := d.db.ExecContext(ctx, d.rebind(`
+		INSERT INTO audit_entries (time, principal, source_ip, request_id, operation_id, action,
+			cluster, namespace, resource, name, summary, outcome, duration, error, details)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		entry.Time.UnixNano(), entry.Principal, entry.SourceIP, entry.RequestID, entry.OperationID, entry.Action,
+		entry.Target.Cluster, entry.Target.Namespace, entry.Target.Resource, entry.Target.Name,
+		entry.Summary, string(entry.Outcome), int64(entry.Duration), entry.Error, details,
+	)
+	return err
+}
+
+// QueryAudit implements Repository.
+func (d *DB) QueryAudit(ctx context.Context, query audit.Query) (audit.Page, error) {
+	var where []string
+	var args []any
+	add := func(clause string, arg any) {
+		where = append(where, clause)
+		args = append(args, arg)
+	}
+	if query.Cursor > 0 {
+		add("id < ?", query.Cursor)
+	}
+	if query.Principal != "" {
+		add("principal = ?", query.Principal)
+	}
+	if query.Cluster != "" {
+		add("cluster = ?", query.Cluster)
+	}
+	if query.Namespace != "" {
+		add("namespace = ?", query.Namespace)
+	}
+	if len(query.Resources) > 0 {
+		where = append(where, "resource IN (?"+strings.Repeat(", ?", len(query.Resources)-1)+")")
+		for _, resource := range query.Resources {
+			args = append(args, resource)
+		}
+	}
+	if query.Name != "" {
+		add("name = ?", query.Name)
+	}
+	if query.Action != "" {
+		add("action = ?", query.Action)
+	}
+	if query.OperationID != "" {
+		add("operation_id = ?", query.OperationID)
+	}
+	if query.Outcome != "" {
+		add("outcome = ?", string(query.Outcome))
+	}
+	if !query.Since.IsZero() {
+		add("time >= ?", query.Since.UnixNano())
+	}
+	if !query.Until.IsZero() {
+		add("time < ?", query.Until.UnixNano())
+	}
+
+	stmt := `SELECT id, time, principal, source_ip, request_id, operation_id, action,
+		cluster, namespace, resource, name, summary, outcome, duration, error, details
+		FROM audit_entries`
+	if len(where) > 0 {
+		stmt += " WHERE " + strings.Join(where, " AND ")
+	}
+	// Fetch one more entry than requested to learn whether another page follows.
+	stmt += " ORDER BY id DESC LIMIT ?"
+	args = append(args, query.Limit+1)
+
+	rows, err := d.db.QueryContext(ctx, d.rebind(stmt), args...)
+	if err != nil {
+		return audit.Page{}, err
+	}
+	defer rows.Close()
+
+	page := audit.Page{Entries: []audit.Entry{}}
+	for rows.Next() {
+		var (
+			entry    audit.Entry
+			at       int64
+			outcome  string
+			duration int64
+			details  []byte
+		)
+		err := rows.Scan(&entry.ID, &at, &entry.Principal, &entry.SourceIP, &entry.RequestID, &entry.OperationID,
+			&entry.Action, &entry.Target.Cluster, &entry.Target.Namespace, &entry.Target.Resource, &entry.Target.Name,
+			&entry.Summary, &outcome, &duration, &entry.Error, &details)
+		if err != nil {
+			return audit.Page{}, err
+		}
+		entry.Time = time.Unix(0, at).UTC()
+		entry.Outcome = audit.Outcome(outcome)
+		entry.Duration = time.Duration(duration)
+		if len(details) > 0 {
+			if err := json.Unmarshal(details, &entry.Details); err != nil {
+				return audit.Page{}, err
+			}
+		}
+		page.Entries = append(page.Entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return audit.Page{}, err
+	}
+
+	if len(page.Entries) > query.Limit {
+		page.Entries = page.Entries[:query.Limit]
+		page.HasMore = true
+		page.Cursor = page.Entries[len(page.Entries)-1].ID
+	}
+	return page, nil
+}
+
+// AuditSink records the audit trail in repo. Closing the sink leaves repo
+// open for its other users.
+func AuditSink(repo Repository) audit.Sink {
+	return auditSink{repo: repo}
+}
+
+type auditSink struct {
+	repo Repository
+}
+
+func (s auditSink) Record(ctx context.Context, entry audit.Entry) error {
+	return s.repo.RecordAudit(ctx, entry)
+}
+
+func (s auditSink) Query(ctx context.Context, query audit.Query) (audit.Page, error) {
+	return s.repo.QueryAudit(ctx, query)
+}
+
+func (s auditSink) Close() error {
+	return nil
+}
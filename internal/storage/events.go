@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/events"
+)
+
+var _ events.Store = (*DB)(nil)
+
+const eventColumns = `id, cluster, uid, namespace, name, type, reason, message,
+	object_api_version, object_kind, object_namespace, object_name, object_uid, object_field_path,
+	source, count, first_seen, last_seen, resource_version`
+
+// SaveEvent implements events.Store. Replicas collecting the same cluster
+// all save its events; the conditions skip the copies that are saved
+// already or older than the saved one.
+func (d *DB) SaveEvent(ctx context.Context, e events.Event) error {
+	_, err := d.db.ExecContext(ctx, d.rebind(`
+		INSERT INTO cluster_events (cluster, uid, namespace, name, type, reason, message,
+			object_api_version, object_kind, object_namespace, object_name, object_uid, object_field_path,
+			source, count, first_seen, last_seen, resource_version)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (cluster, uid) DO UPDATE SET namespace = excluded.namespace, name = excluded.name,
+			type = excluded.type, reason = excluded.reason, message = excluded.message,
+			object_api_version = excluded.object_api_version, object_kind = excluded.object_kind,
+			object_namespace = excluded.object_namespace, object_name = excluded.object_name,
+			object_uid = excluded.object_uid, object_field_path = excluded.object_field_path,
+			source = excluded.source, count = excluded.count, first_seen = excluded.first_seen,
+			last_seen = excluded.last_seen, resource_version = excluded.resource_version
+		WHERE cluster_events.resource_version <> excluded.resource_version
+			AND cluster_events.last_seen <= excluded.last_seen`),
+		e.Cluster, e.UID, e.Namespace, e.Name, e.Type, e.Reason, e.Message,
+		e.Object.APIVersion, e.Object.Kind, e.Object.Namespace, e.Object.Name, e.Object.UID, e.Object.FieldPath,
+		e.Source, e.Count, e.FirstSeen.UnixNano(), e.LastSeen.UnixNano(), e.ResourceVersion,
+	)
+	return err
+}
+
+// QueryEvents implements events.Store.
+func (d *DB) QueryEvents(ctx context.Context, query events.Query) (events.Page, error) {
+	var where []string
+	var args []any
+	add := func(clause string, clauseArgs ...any) {
+		where = append(where, clause)
+		args = append(args, clauseArgs...)
+	}
+	if query.Cursor > 0 {
+		var lastSeen int64
+		err := d.db.QueryRowContext(ctx, d.rebind(`SELECT last_seen FROM cluster_events WHERE id = ?`), query.Cursor).
+			Scan(&lastSeen)
+		if errors.Is(err, sql.ErrNoRows) {
+			return events.Page{}, fmt.Errorf("%w: %d", events.ErrInvalidCursor, query.Cursor)
+		} else if err != nil {
+			return events.Page{}, err
+		}
+		add("(last_seen < ? OR (last_seen = ? AND id < ?))", lastSeen, lastSeen, query.Cursor)
+	}
+	if query.Cluster != "" {
+		add("cluster = ?", query.Cluster)
+	}
+	if query.Namespace != "" {
+		add("namespace = ?", query.Namespace)
+	}
+	if query.Kind != "" {
+		add("object_kind = ?", query.Kind)
+	}
+	if query.Name != "" {
+		add("object_name = ?", query.Name)
+	}
+	if query.Reason != "" {
+		add("reason = ?", query.Reason)
+	}
+	if query.Type != "" {
+		add("type = ?", query.Type)
+	}
+	if !query.Since.IsZero() {
+		add("last_seen >= ?", query.Since.UnixNano())
+	}
+	if !query.Until.IsZero() {
+		add("first_seen < ?", query.Until.UnixNano())
+	}
+
+	stmt := `SELECT ` + eventColumns + ` FROM cluster_events`
+	if len(where) > 0 {
+		stmt += " WHERE " + strings.Join(where, " AND ")
+	}
+	// Fetch one more event than requested to learn whether another page follows.
+	stmt += " ORDER BY last_seen DESC, id DESC LIMIT ?"
+	args = append(args, query.Limit+1)
+
+	rows, err := d.db.QueryContext(ctx, d.rebind(stmt), args...)
+	if err != nil {
+		return events.Page{}, err
+	}
+	defer rows.Close()
+
+	page := events.Page{Events: []events.Event{}}
+	for rows.Next() {
+		var (
+			e         events.Event
+			firstSeen int64
+			lastSeen  int64
+		)
+		err := rows.Scan(&e.ID, &e.Cluster, &e.UID, &e.Namespace, &e.Name, &e.Type, &e.Reason, &e.Message,
+			&e.Object.APIVersion, &e.Object.Kind, &e.Object.Namespace, &e.Object.Name, &e.Object.UID, &e.Object.FieldPath,
+			&e.Source, &e.Count, &firstSeen, &lastSeen, &e.ResourceVersion)
+		if err != nil {
+			return events.Page{}, err
+		}
+		e.FirstSeen = time.Unix(0, firstSeen).UTC()
+		e.LastSeen = time.Unix(0, lastSeen).UTC()
+		page.Events = append(page.Events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return events.Page{}, err
+	}
+
+	if len(page.Events) > query.Limit {
+		page.Events = page.Events[:query.Limit]
+		page.HasMore = true
+		page.Cursor = page.Events[len(page.Events)-1].ID
+	}
+	return page, nil
+}
+
+// DeleteEvents implements events.Store.
+func (d *DB) DeleteEvents(ctx context.Context, before time.Time) error {
+	_, err := d.db.ExecContext(ctx, d.rebind(`DELETE FROM cluster_events WHERE last_seen < ?`), before.UnixNano())
+	return err
+}
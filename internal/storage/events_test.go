@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/events"
+)
+
+// migratedSQLite returns a SQLite database with every migration applied.
+func migratedSQLite(t *testing.T) *DB {
+	t.Helper()
+	db := openSQLite(t)
+	m, err := NewMigrator(db)
+	if err != nil {
+		t.Fatalf("NewMigrator: %v", err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	return db
+}
+
+// eventStores runs test against the database and, as the reference it must
+// behave like, the in-memory store.
+func eventStores(t *testing.T, test func(t *testing.T, store events.Store)) {
+	t.Run("sqlite", func(t *testing.T) { test(t, migratedSQLite(t)) })
+	t.Run("memory", func(t *testing.T) { test(t, events.NewMemory()) })
+}
+
+var eventsStart = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func clusterEvent(uid, resourceVersion string, count int, lastSeen time.Duration) events.Event {
+	return events.Event{
+		Cluster:         "dev",
+		UID:             uid,
+		Namespace:       "default",
+		Name:            "web." + uid,
+		Type:            "Warning",
+		Reason:          "BackOff",
+		Message:         "Back-off restarting failed container",
+		Object:          events.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "web-0"},
+		Source:          "kubelet",
+		Count:           count,
+		FirstSeen:       eventsStart,
+		LastSeen:        eventsStart.Add(lastSeen),
+		ResourceVersion: resourceVersion,
+	}
+}
+
+func queryAll(t *testing.T, store events.Store, filter events.Filter) []events.Event {
+	t.Helper()
+	page, err := store.QueryEvents(context.Background(), events.Query{Filter: filter, Limit: 100})
+	if err != nil {
+		t.Fatalf("QueryEvents: %v", err)
+	}
+	return page.Events
+}
+
+func TestSaveEventDedup(t *testing.T) {
+	eventStores(t, func(t *testing.T, store events.Store) {
+		ctx := context.Background()
+		save := func(e events.Event) {
+			t.Helper()
+			if err := store.SaveEvent(ctx, e); err != nil {
+				t.Fatalf("SaveEvent: %v", err)
+			}
+		}
+
+		save(clusterEvent("e1", "10", 1, 0))
+		first := queryAll(t, store, events.Filter{})
+		if len(first) != 1 {
+			t.Fatalf("saved %d events, want 1", len(first))
+		}
+
+		// The event recurs: its copy is updated in place.
+		save(clusterEvent("e1", "11", 4, time.Minute))
+		// A replica saves the copy it already has, then a stale one.
+		save(clusterEvent("e1", "11", 9, 2*time.Minute))
+		save(clusterEvent("e1", "9", 2, -time.Minute))
+		got := queryAll(t, store, events.Filter{})
+		if len(got) != 1 {
+			t.Fatalf("saved %d events, want the recurring event once", len(got))
+		}
+		if e := got[0]; e.ID != first[0].ID || e.Count != 4 || !e.LastSeen.Equal(eventsStart.Add(time.Minute)) ||
+			!e.FirstSeen.Equal(eventsStart) || e.ResourceVersion != "11" {
+			t.Errorf("event = %+v, want ID %d with count 4, last seen a minute in", e, first[0].ID)
+		}
+
+		// The same UID in another cluster is another event.
+		other := clusterEvent("e1", "10", 1, 0)
+		other.Cluster = "prod"
+		save(other)
+		if got := queryAll(t, store, events.Filter{}); len(got) != 2 || got[0].ID == got[1].ID {
+			t.Errorf("events = %+v, want one per cluster", got)
+		}
+	})
+}
+
+func TestQueryEvents(t *testing.T) {
+	eventStores(t, func(t *testing.T, store events.Store) {
+		ctx := context.Background()
+		normal := clusterEvent("scheduled", "1", 1, time.Minute)
+		normal.Type, normal.Reason = "Normal", "Scheduled"
+		node := clusterEvent("node", "2", 1, 2*time.Minute)
+		node.Object = events.ObjectReference{Kind: "Node", Name: "node-1"}
+		kubeSystem := clusterEvent("kube-system", "3", 1, 3*time.Minute)
+		kubeSystem.Namespace = "kube-system"
+		late := clusterEvent("late", "4", 1, time.Hour)
+		late.FirstSeen = eventsStart.Add(30 * time.Minute)
+		prod := clusterEvent("prod", "5", 1, 0)
+		prod.Cluster = "prod"
+		for _, e := range []events.Event{normal, node, kubeSystem, late, prod} {
+			if err := store.SaveEvent(ctx, e); err != nil {
+				t.Fatalf("SaveEvent: %v", err)
+			}
+		}
+
+		tests := []struct {
+			name   string
+			filter events.Filter
+			want   []string
+		}{
+			{"cluster", events.Filter{Cluster: "dev"}, []string{"late", "kube-system", "node", "scheduled"}},
+			{"namespace", events.Filter{Cluster: "dev", Namespace: "kube-system"}, []string{"kube-system"}},
+			{"object", events.Filter{Kind: "Node", Name: "node-1"}, []string{"node"}},
+			{"reason", events.Filter{Reason: "Scheduled"}, []string{"scheduled"}},
+			{"type", events.Filter{Cluster: "dev", Type: "Warning"}, []string{"late", "kube-system", "node"}},
+			// Since and Until select what occurred in the range.
+			{"since", events.Filter{Since: eventsStart.Add(2 * time.Minute)}, []string{"late", "kube-system", "node"}},
+			{"until", events.Filter{Until: eventsStart.Add(30 * time.Minute)}, []string{"kube-system", "node", "scheduled", "prod"}},
+			{"range", events.Filter{Since: eventsStart.Add(2 * time.Minute), Until: eventsStart.Add(30 * time.Minute)},
+				[]string{"kube-system", "node"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var uids []string
+				for _, e := range queryAll(t, store, tt.filter) {
+					uids = append(uids, e.UID)
+				}
+				if !slices.Equal(uids, tt.want) {
+					t.Errorf("events %v, want %v", uids, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestQueryEventsPages(t *testing.T) {
+	eventStores(t, func(t *testing.T, store events.Store) {
+		ctx := context.Background()
+		// Events seen at the same time are ordered by ID.
+		for i := range 7 {
+			e := clusterEvent(fmt.Sprintf("e%d", i), "1", 1, time.Duration(i/2)*time.Minute)
+			if err := store.SaveEvent(ctx, e); err != nil {
+				t.Fatalf("SaveEvent: %v", err)
+			}
+		}
+		want := queryAll(t, store, events.Filter{})
+
+		var got []events.Event
+		query := events.Query{Limit: 3}
+		for pages := 0; ; pages++ {
+			if pages > len(want) {
+				t.Fatal("paging did not end")
+			}
+			page, err := store.QueryEvents(ctx, query)
+			if err != nil {
+				t.Fatalf("QueryEvents: %v", err)
+			}
+			got = append(got, page.Events...)
+			if !page.HasMore {
+				if page.Cursor != 0 {
+					t.Errorf("last page with cursor %d, want none", page.Cursor)
+				}
+				break
+			}
+			if page.Cursor != page.Events[len(page.Events)-1].ID {
+				t.Errorf("cursor %d, want the ID of the last event of the page", page.Cursor)
+			}
+			query.Cursor = page.Cursor
+		}
+		ids := func(es []events.Event) []int64 {
+			var ids []int64
+			for _, e := range es {
+				ids = append(ids, e.ID)
+			}
+			return ids
+		}
+		if !slices.Equal(ids(got), ids(want)) {
+			t.Errorf("paged through %v, want %v", ids(got), ids(want))
+		}
+		for i := 1; i < len(got); i++ {
+			if a, b := got[i-1], got[i]; a.LastSeen.Before(b.LastSeen) || (a.LastSeen.Equal(b.LastSeen) && a.ID < b.ID) {
+				t.Errorf("event %d before %d, want the most recent first", a.ID, b.ID)
+			}
+		}
+
+		// A page that ends exactly with the last event has none after it.
+		page, err := store.QueryEvents(ctx, events.Query{Limit: len(want)})
+		if err != nil || page.HasMore {
+			t.Errorf("QueryEvents of all = HasMore %v, %v, want the last page", page.HasMore, err)
+		}
+
+		if _, err := store.QueryEvents(ctx, events.Query{Cursor: 999, Limit: 3}); !errors.Is(err, events.ErrInvalidCursor) {
+			t.Errorf("QueryEvents with an unknown cursor = %v, want ErrInvalidCursor", err)
+		}
+
+		if err := store.DeleteEvents(ctx, eventsStart.Add(time.Minute)); err != nil {
+			t.Fatalf("DeleteEvents: %v", err)
+		}
+		if got := queryAll(t, store, events.Filter{}); len(got) != 5 {
+			t.Errorf("%d events after deleting the two last seen before a minute, want 5", len(got))
+		}
+	})
+}
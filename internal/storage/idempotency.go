@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/idempotency"
+)
+
+// idempotencySweepInterval is how often expired idempotency keys are
+// deleted.
+const idempotencySweepInterval = time.Hour
+
+var _ idempotency.Store = (*DB)(nil)
+
+// Reserve implements idempotency.Store, sharing the keys between every
+// replica using the database. A status of 0 marks a request in progress.
+func (d *DB) Reserve(ctx context.Context, key, fingerprint string, now, lockedUntil time.Time) (*idempotency.Response, error) {
+	d.sweepIdempotencyKeys(ctx, now)
+
+	// Claim the key unless a live record holds it; the upsert makes
+	// concurrent requests agree on a single winner.
+	res, err := d.db.ExecContext(ctx, d.rebind(`
+		INSERT INTO idempotency_keys (key, fingerprint, status, header, body, expires_at)
+		VALUES (?, ?, 0, NULL, NULL, ?)
+		ON CONFLICT (key) DO UPDATE SET fingerprint = excluded.fingerprint, status = 0,
+			header = NULL, body = NULL, expires_at = excluded.expires_at
+		WHERE idempotency_keys.expires_at <= ?`),
+		key, fingerprint, lockedUntil.UnixNano(), now.UnixNano())
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 1 {
+		return nil, nil
+	}
+
+	var storedFingerprint string
+	var status int
+	var header sql.NullString
+	var body []byte
+	err = d.db.QueryRowContext(ctx, d.rebind(`
+		SELECT fingerprint, status, header, body FROM idempotency_keys WHERE key = ?`), key).
+		Scan(&storedFingerprint, &status, &header, &body)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The holder released the key in between; it was in progress.
+		return nil, idempotency.ErrInProgress
+	} else if err != nil {
+		return nil, err
+	}
+	switch {
+	case storedFingerprint != fingerprint:
+		return nil, idempotency.ErrMismatch
+	case status == 0:
+		return nil, idempotency.ErrInProgress
+	}
+	resp := &idempotency.Response{Status: status, Body: body}
+	if header.Valid {
+		if err := json.Unmarshal([]byte(header.String), &resp.Header); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// Complete implements idempotency.Store.
+func (d *DB) Complete(ctx context.Context, key, fingerprint string, resp idempotency.Response, expires time.Time) error {
+	header, err := json.Marshal(resp.Header)
+	if err != nil {
+		return err
+	}
+	_, err = d.db.ExecContext(ctx, d.rebind(`
+		UPDATE idempotency_keys SET status = ?, header = ?, body = ?, expires_at = ?
+		WHERE key = ? AND fingerprint = ?`),
+		resp.Status, string(header), resp.Body, expires.UnixNano(), key, fingerprint)
+	return err
+}
+
+// Release implements idempotency.Store.
+func (d *DB) Release(ctx context.Context, key, fingerprint string) error {
+	_, err := d.db.ExecContext(ctx, d.rebind(`
+		DELETE FROM idempotency_keys WHERE key = ? AND fingerprint = ? AND status = 0`), key, fingerprint)
+	return err
+}
+
+// sweepIdempotencyKeys deletes expired keys at most once per interval.
+// Failures are left to the next sweep.
+func (d *DB) sweepIdempotencyKeys(ctx context.Context, now time.Time) {
+	last := d.idempotencySwept.Load()
+	if now.UnixNano()-last < int64(idempotencySweepInterval) || !d.idempotencySwept.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	_, _ = d.db.ExecContext(ctx, d.rebind(`DELETE FROM idempotency_keys WHERE expires_at <= ?`), now.UnixNano())
+}
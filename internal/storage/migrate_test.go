@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+func openSQLite(t *testing.T) *DB {
+	t.Helper()
+	db, err := Open(config.DatabaseConfig{Driver: DriverSQLite, DSN: filepath.Join(t.TempDir(), "iu.db")})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// schema returns the tables and indexes of db with their definitions,
+// leaving out the bookkeeping of SQLite and of the migrator.
+func schema(t *testing.T, db *DB) []string {
+	t.Helper()
+	rows, err := db.db.Query(`SELECT type, name, COALESCE(sql, '') FROM sqlite_master
+		WHERE name NOT LIKE 'sqlite_%' AND name != 'schema_migrations' ORDER BY name`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var objects []string
+	for rows.Next() {
+		var kind, name, sql string
+		if err := rows.Scan(&kind, &name, &sql); err != nil {
+			t.Fatal(err)
+		}
+		objects = append(objects, kind+" "+name+": "+sql)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return objects
+}
+
+func versions(migrations []Migration) []int {
+	var vs []int
+	for _, m := range migrations {
+		vs = append(vs, m.Version)
+	}
+	return vs
+}
+
+func TestMigrateUpDown(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLite(t)
+	m, err := NewMigrator(db)
+	if err != nil {
+		t.Fatalf("NewMigrator: %v", err)
+	}
+	all := versions(m.migrations)
+
+	if err := m.EnsureMigrated(ctx); !errors.Is(err, ErrPendingMigrations) {
+		t.Errorf("EnsureMigrated on an empty database = %v, want ErrPendingMigrations", err)
+	}
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if got := versions(applied); !slices.Equal(got, all) {
+		t.Errorf("Up applied %v, want %v", got, all)
+	}
+	if err := m.EnsureMigrated(ctx); err != nil {
+		t.Errorf("EnsureMigrated = %v after Up", err)
+	}
+	migrated := schema(t, db)
+
+	// Reverting the latest migration keeps the data of the tables it
+	// changed.
+	_, err = db.db.ExecContext(ctx, `INSERT INTO approvals
+		(id, operation_id, request, requester, requested_at, expires_at, status, requester_source)
+		VALUES ('a-1', 'scaleWorkload', '{}', 'alice', 1, 2, 'pending', 'token')`)
+	if err != nil {
+		t.Fatalf("inserting an approval: %v", err)
+	}
+	reverted, err := m.Down(ctx, 1)
+	if err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+	if got := versions(reverted); !slices.Equal(got, all[len(all)-1:]) {
+		t.Errorf("Down 1 reverted %v, want %v", got, all[len(all)-1:])
+	}
+	if err := m.EnsureMigrated(ctx); !errors.Is(err, ErrPendingMigrations) {
+		t.Errorf("EnsureMigrated after Down = %v, want ErrPendingMigrations", err)
+	}
+	var requester string
+	if err := db.db.QueryRowContext(ctx, `SELECT requester FROM approvals WHERE id = 'a-1'`).Scan(&requester); err != nil {
+		t.Errorf("approval lost by Down: %v", err)
+	}
+	if applied, err := m.Up(ctx); err != nil || !slices.Equal(versions(applied), all[len(all)-1:]) {
+		t.Errorf("Up after Down 1 = %v, %v, want only the reverted migration", versions(applied), err)
+	}
+
+	// Down past the first migration reverts everything applied, latest first.
+	reverted, err = m.Down(ctx, len(all)+1)
+	if err != nil {
+		t.Fatalf("Down all: %v", err)
+	}
+	want := slices.Clone(all)
+	slices.Reverse(want)
+	if got := versions(reverted); !slices.Equal(got, want) {
+		t.Errorf("Down all reverted %v, want %v", got, want)
+	}
+	if got := schema(t, db); len(got) != 0 {
+		t.Errorf("schema after Down all = %v, want empty", got)
+	}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	for _, s := range statuses {
+		if s.AppliedAt != nil {
+			t.Errorf("migration %d applied after Down all", s.Version)
+		}
+	}
+
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("Up after Down all: %v", err)
+	}
+	if got := schema(t, db); !slices.Equal(got, migrated) {
+		t.Errorf("schema after Down and Up =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(migrated, "\n"))
+	}
+}
+
+func TestMigrationsMatchAcrossDrivers(t *testing.T) {
+	sqlite, err := loadMigrations(DriverSQLite)
+	if err != nil {
+		t.Fatalf("loading sqlite migrations: %v", err)
+	}
+	postgres, err := loadMigrations(DriverPostgres)
+	if err != nil {
+		t.Fatalf("loading postgres migrations: %v", err)
+	}
+	names := func(migrations []Migration) []string {
+		var ns []string
+		for _, m := range migrations {
+			ns = append(ns, m.Name)
+		}
+		return ns
+	}
+	if !slices.Equal(versions(sqlite), versions(postgres)) || !slices.Equal(names(sqlite), names(postgres)) {
+		t.Errorf("sqlite migrations %v %v, postgres %v %v, want the same", versions(sqlite), names(sqlite), versions(postgres), names(postgres))
+	}
+	for i, m := range sqlite {
+		if m.Version != i+1 {
+			t.Errorf("migration %d_%s, want version %d: versions must not skip", m.Version, m.Name, i+1)
+		}
+	}
+}
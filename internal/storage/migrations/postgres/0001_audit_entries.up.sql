@@ -0,0 +1,22 @@
+CREATE TABLE audit_entries (
+	id           BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	time         BIGINT NOT NULL,
+	principal    TEXT NOT NULL,
+	source_ip    TEXT NOT NULL DEFAULT '',
+	request_id   TEXT NOT NULL DEFAULT '',
+	operation_id TEXT NOT NULL DEFAULT '',
+	action       TEXT NOT NULL,
+	cluster      TEXT NOT NULL DEFAULT '',
+	namespace    TEXT NOT NULL DEFAULT '',
+	resource     TEXT NOT NULL DEFAULT '',
+	name         TEXT NOT NULL DEFAULT '',
+	summary      TEXT NOT NULL DEFAULT '',
+	outcome      TEXT NOT NULL,
+	duration     BIGINT NOT NULL DEFAULT 0,
+	error        TEXT NOT NULL DEFAULT '',
+	details      JSONB
+);
+
+CREATE INDEX audit_entries_time ON audit_entries (time);
+CREATE INDEX audit_entries_principal ON audit_entries (principal, id);
+CREATE INDEX audit_entries_target ON audit_entries (cluster, namespace, id);
@@ -0,0 +1,24 @@
+CREATE TABLE approvals (
+	id               TEXT PRIMARY KEY,
+	operation_id     TEXT NOT NULL,
+	cluster          TEXT NOT NULL DEFAULT '',
+	namespace        TEXT NOT NULL DEFAULT '',
+	resource         TEXT NOT NULL DEFAULT '',
+	name             TEXT NOT NULL DEFAULT '',
+	summary          TEXT NOT NULL DEFAULT '',
+	request          TEXT NOT NULL,
+	body             BYTEA,
+	requester        TEXT NOT NULL,
+	requester_uid    TEXT NOT NULL DEFAULT '',
+	requester_groups TEXT NOT NULL DEFAULT '[]',
+	requested_at     BIGINT NOT NULL,
+	expires_at       BIGINT NOT NULL,
+	status           TEXT NOT NULL,
+	decided_by       TEXT NOT NULL DEFAULT '',
+	decided_at       BIGINT NOT NULL DEFAULT 0,
+	comment          TEXT NOT NULL DEFAULT '',
+	result           TEXT
+);
+
+CREATE INDEX approvals_status ON approvals (status, requested_at);
+CREATE INDEX approvals_requested_at ON approvals (requested_at);
@@ -0,0 +1 @@
+ALTER TABLE approvals ADD COLUMN requester_source TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,7 @@
+CREATE TABLE rate_limit_buckets (
+	key        TEXT PRIMARY KEY,
+	tokens     REAL NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX rate_limit_buckets_updated_at ON rate_limit_buckets (updated_at);
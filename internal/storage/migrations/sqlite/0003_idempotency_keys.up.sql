@@ -0,0 +1,10 @@
+CREATE TABLE idempotency_keys (
+	key         TEXT PRIMARY KEY,
+	fingerprint TEXT NOT NULL,
+	status      INTEGER NOT NULL,
+	header      TEXT,
+	body        BLOB,
+	expires_at  INTEGER NOT NULL
+);
+
+CREATE INDEX idempotency_keys_expires_at ON idempotency_keys (expires_at);
@@ -0,0 +1,10 @@
+CREATE TABLE notification_dead_letters (
+	id        TEXT PRIMARY KEY,
+	sink      TEXT NOT NULL,
+	event     TEXT NOT NULL,
+	attempts  INTEGER NOT NULL,
+	error     TEXT NOT NULL DEFAULT '',
+	failed_at INTEGER NOT NULL
+);
+
+CREATE INDEX notification_dead_letters_failed_at ON notification_dead_letters (failed_at);
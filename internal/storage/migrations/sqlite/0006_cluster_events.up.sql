@@ -0,0 +1,26 @@
+CREATE TABLE cluster_events (
+	id                 INTEGER PRIMARY KEY AUTOINCREMENT,
+	cluster            TEXT NOT NULL,
+	uid                TEXT NOT NULL,
+	namespace          TEXT NOT NULL DEFAULT '',
+	name               TEXT NOT NULL DEFAULT '',
+	type               TEXT NOT NULL DEFAULT '',
+	reason             TEXT NOT NULL DEFAULT '',
+	message            TEXT NOT NULL DEFAULT '',
+	object_api_version TEXT NOT NULL DEFAULT '',
+	object_kind        TEXT NOT NULL DEFAULT '',
+	object_namespace   TEXT NOT NULL DEFAULT '',
+	object_name        TEXT NOT NULL DEFAULT '',
+	object_uid         TEXT NOT NULL DEFAULT '',
+	object_field_path  TEXT NOT NULL DEFAULT '',
+	source             TEXT NOT NULL DEFAULT '',
+	count              INTEGER NOT NULL DEFAULT 1,
+	first_seen         INTEGER NOT NULL,
+	last_seen          INTEGER NOT NULL,
+	resource_version   TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX cluster_events_uid ON cluster_events (cluster, uid);
+CREATE INDEX cluster_events_last_seen ON cluster_events (last_seen);
+CREATE INDEX cluster_events_cluster ON cluster_events (cluster, last_seen, id);
+CREATE INDEX cluster_events_object ON cluster_events (cluster, object_kind, object_name, last_seen);
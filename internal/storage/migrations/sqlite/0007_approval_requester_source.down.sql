@@ -0,0 +1 @@
+ALTER TABLE approvals DROP COLUMN requester_source;
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/notify"
+)
+
+var _ notify.DeadLetterStore = (*DB)(nil)
+
+const deadLetterColumns = `id, sink, event, attempts, error, failed_at`
+
+// AddDeadLetter implements notify.DeadLetterStore.
+func (d *DB) AddDeadLetter(ctx context.Context, dl notify.DeadLetter) error {
+	event, err := json.Marshal(dl.Event)
+	if err != nil {
+		return err
+	}
+	_, err = d.db.ExecContext(ctx, d.rebind(`
+		INSERT INTO notification_dead_letters (`+deadLetterColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?)`),
+		dl.ID, dl.Sink, string(event), dl.Attempts, dl.Error, dl.FailedAt.UnixNano())
+	return err
+}
+
+// ListDeadLetters implements notify.DeadLetterStore.
+func (d *DB) ListDeadLetters(ctx context.Context, limit int) ([]notify.DeadLetter, error) {
+	stmt := `SELECT ` + deadLetterColumns + ` FROM notification_dead_letters ORDER BY failed_at DESC`
+	var args []any
+	if limit > 0 {
+		stmt += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := d.db.QueryContext(ctx, d.rebind(stmt), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	deadLetters := []notify.DeadLetter{}
+	for rows.Next() {
+		dl, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, *dl)
+	}
+	return deadLetters, rows.Err()
+}
+
+// GetDeadLetter implements notify.DeadLetterStore.
+func (d *DB) GetDeadLetter(ctx context.Context, id string) (*notify.DeadLetter, error) {
+	row := d.db.QueryRowContext(ctx, d.rebind(`SELECT `+deadLetterColumns+` FROM notification_dead_letters WHERE id = ?`), id)
+	dl, err := scanDeadLetter(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", notify.ErrNotFound, id)
+	}
+	return dl, err
+}
+
+// DeleteDeadLetter implements notify.DeadLetterStore.
+func (d *DB) DeleteDeadLetter(ctx context.Context, id string) error {
+	res, err := d.db.ExecContext(ctx, d.rebind(`DELETE FROM notification_dead_letters WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("%w: %s", notify.ErrNotFound, id)
+	}
+	return nil
+}
+
+// DeleteDeadLetters implements notify.DeadLetterStore.
+func (d *DB) DeleteDeadLetters(ctx context.Context, before time.Time) error {
+	_, err := d.db.ExecContext(ctx, d.rebind(`DELETE FROM notification_dead_letters WHERE failed_at < ?`), before.UnixNano())
+	return err
+}
+
+// scanDeadLetter reads a row selected with deadLetterColumns.
+func scanDeadLetter(row interface{ Scan(...any) error }) (*notify.DeadLetter, error) {
+	var (
+		dl       notify.DeadLetter
+		event    string
+		failedAt int64
+	)
+	if err := row.Scan(&dl.ID, &dl.Sink, &event, &dl.Attempts, &dl.Error, &failedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(event), &dl.Event); err != nil {
+		return nil, err
+	}
+	dl.FailedAt = time.Unix(0, failedAt).UTC()
+	return &dl, nil
+}
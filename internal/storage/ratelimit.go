@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/ratelimit"
+)
+
+// rateLimitBucketTTL is how long an untouched bucket is kept. Quotas refill
+// well within it, so deleting the bucket is the same as keeping it full.
+const rateLimitBucketTTL = time.Hour
+
+var _ ratelimit.Store = (*DB)(nil)
+
+// TakeToken implements ratelimit.Store, sharing the buckets between every
+// replica using the database.
+func (d *DB) TakeToken(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+	d.sweepRateLimitBuckets(ctx, now)
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+	defer tx.Rollback()
+
+	// Create the row first so that concurrent requests for a new key queue
+	// on its lock instead of each starting from a full bucket.
+	if _, err := tx.ExecContext(ctx, d.rebind(`
+		INSERT INTO rate_limit_buckets (key, tokens, updated_at) VALUES (?, 0, 0)
+		ON CONFLICT (key) DO NOTHING`), key); err != nil {
+		return ratelimit.Result{}, err
+	}
+	query := `SELECT tokens, updated_at FROM rate_limit_buckets WHERE key = ?`
+	if d.driver == DriverPostgres {
+		// SQLite has a single writer and needs no row lock.
+		query += " FOR UPDATE"
+	}
+	var tokens float64
+	var updated int64
+	if err := tx.QueryRowContext(ctx, d.rebind(query), key).Scan(&tokens, &updated); err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	var bucket ratelimit.Bucket
+	if updated != 0 {
+		bucket = ratelimit.Bucket{Tokens: tokens, Updated: time.Unix(0, updated)}
+	}
+	res := bucket.Take(limit, now)
+	if _, err := tx.ExecContext(ctx, d.rebind(`
+		UPDATE rate_limit_buckets SET tokens = ?, updated_at = ? WHERE key = ?`),
+		bucket.Tokens, bucket.Updated.UnixNano(), key); err != nil {
+		return ratelimit.Result{}, err
+	}
+	return res, tx.Commit()
+}
+
+// sweepRateLimitBuckets deletes untouched buckets at most once per TTL.
+// Failures are left to the next sweep.
+func (d *DB) sweepRateLimitBuckets(ctx context.Context, now time.Time) {
+	last := d.rateLimitSwept.Load()
+	if now.UnixNano()-last < int64(rateLimitBucketTTL) || !d.rateLimitSwept.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	_, _ = d.db.ExecContext(ctx, d.rebind(`DELETE FROM rate_limit_buckets WHERE updated_at < ?`),
+		now.Add(-rateLimitBucketTTL).UnixNano())
+}
@@ -0,0 +1,114 @@
+// Package storage persists the state of the server in a SQL database. SQLite
+// and Postgres are supported behind the same Repository.
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/config"
+	_ "modernc.org/sqlite"
+)
+
+// ErrPendingMigrations is returned when the schema is older than the binary.
+var ErrPendingMigrations = errors.New("database has pending migrations")
+
+// Supported drivers.
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+// Repository is the persistent state of the server.
+type Repository interface {
+	// RecordAudit stores an audit entry, assigning its ID.
+	RecordAudit(ctx context.Context, entry audit.Entry) error
+	// QueryAudit returns a page of audit entries, newest first.
+	QueryAudit(ctx context.Context, query audit.Query) (audit.Page, error)
+
+	// Ping checks the connection to the database.
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// DB is a Repository backed by SQLite or Postgres.
+type DB struct {
+	db     *sql.DB
+	driver string
+
+	// rateLimitSwept is when expired rate limit buckets were last deleted,
+	// in Unix nanoseconds.
+	rateLimitSwept atomic.Int64
+	// idempotencySwept is when expired idempotency keys were last deleted,
+	// in Unix nanoseconds.
+	idempotencySwept atomic.Int64
+}
+
+var _ Repository = (*DB)(nil)
+
+// Open connects to the database configured by cfg. It does not migrate the
+// schema; see Migrator.
+func Open(cfg config.DatabaseConfig) (*DB, error) {
+	var db *sql.DB
+	var err error
+	switch cfg.Driver {
+	case DriverSQLite:
+		db, err = sql.Open("sqlite", "file:"+cfg.DSN+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)&_pragma=foreign_keys(1)")
+		if err == nil {
+			// SQLite serialises writers; one connection avoids busy errors.
+			db.SetMaxOpenConns(1)
+		}
+	case DriverPostgres:
+		db, err = sql.Open("pgx", cfg.DSN)
+		if err == nil {
+			db.SetMaxOpenConns(cfg.MaxOpenConns)
+		}
+	default:
+		return nil, fmt.Errorf("unknown database driver %q", cfg.Driver)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &DB{db: db, driver: cfg.Driver}, nil
+}
+
+// Driver returns the name of the database driver.
+func (d *DB) Driver() string {
+	return d.driver
+}
+
+// Ping implements Repository.
+func (d *DB) Ping(ctx context.Context) error {
+	return d.db.PingContext(ctx)
+}
+
+// Close implements Repository.
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// rebind rewrites the ? placeholders of a query into the $n placeholders
+// Postgres expects. Queries must not contain literal question marks.
+func (d *DB) rebind(query string) string {
+	if d.driver != DriverPostgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,166 @@
+// Package tlsconfig builds the TLS configuration of the server and keeps
+// its certificates current as the files on disk are rotated.
+package tlsconfig
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+// Reloader serves the certificate and client CA bundle last read from disk.
+// Files are read as a set, so a rotation that replaces the certificate and
+// key one after the other is only applied once both match.
+type Reloader struct {
+	certFile, keyFile, caFile string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+	// loaded are the file contents the current state was built from.
+	loaded [3][]byte
+}
+
+// New builds the TLS configuration described by cfg. The returned Reloader
+// must be run to pick up rotated files.
+func New(cfg config.TLSConfig) (*tls.Config, *Reloader, error) {
+	r := &Reloader{certFile: cfg.CertFile, keyFile: cfg.KeyFile, caFile: cfg.ClientCAFile}
+	if _, err := r.reload(); err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.getCertificate,
+		// Set explicitly: configs returned by GetConfigForClient do not get
+		// the defaults http.Server adds.
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if cfg.MinVersion == "1.3" {
+		tlsConfig.MinVersion = tls.VersionTLS13
+	}
+	if len(cfg.CipherSuites) > 0 {
+		suites, err := cipherSuites(cfg.CipherSuites)
+		if err != nil {
+			return nil, nil, err
+		}
+		tlsConfig.CipherSuites = suites
+	}
+
+	if cfg.ClientCAFile != "" {
+		clientAuth := tls.VerifyClientCertIfGiven
+		if cfg.ClientAuth == "require" {
+			clientAuth = tls.RequireAndVerifyClientCert
+		}
+		// The client CA pool can only be swapped per connection.
+		base := tlsConfig.Clone()
+		tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c := base.Clone()
+			c.ClientAuth = clientAuth
+			c.ClientCAs = r.clientCAs()
+			return c, nil
+		}
+	}
+	return tlsConfig, r, nil
+}
+
+// Run checks the files every interval until ctx is done. Failed reloads are
+// logged and the previous certificates stay in use.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := r.reload()
+			if err != nil {
+				slog.Warn("Failed to reload TLS certificates", "error", err)
+			} else if changed {
+				slog.Info("Reloaded TLS certificates", "cert", r.certFile)
+			}
+		}
+	}
+}
+
+// reload reads the files and swaps in their contents if they changed.
+func (r *Reloader) reload() (bool, error) {
+	var files [3][]byte
+	for i, path := range []string{r.certFile, r.keyFile, r.caFile} {
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return false, err
+		}
+		files[i] = data
+	}
+
+	r.mu.RLock()
+	unchanged := r.cert != nil && bytes.Equal(files[0], r.loaded[0]) &&
+		bytes.Equal(files[1], r.loaded[1]) && bytes.Equal(files[2], r.loaded[2])
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.X509KeyPair(files[0], files[1])
+	if err != nil {
+		return false, fmt.Errorf("load %s: %w", r.certFile, err)
+	}
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(files[2]) {
+			return false, fmt.Errorf("load %s: no certificates found", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.clientCA, r.loaded = &cert, pool, files
+	r.mu.Unlock()
+	return true, nil
+}
+
+func (r *Reloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+func (r *Reloader) clientCAs() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.clientCA
+}
+
+// cipherSuites resolves cipher suite names. Suites Go considers insecure
+// are rejected.
+func cipherSuites(names []string) ([]uint16, error) {
+	byName := map[string]uint16{}
+	for _, suite := range tls.CipherSuites() {
+		byName[suite.Name] = suite.ID
+	}
+	var ids []uint16
+	var errs []error
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown or insecure cipher suite %q", name))
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, errors.Join(errs...)
+}
@@ -0,0 +1,171 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/config"
+)
+
+// keyPair returns a self-signed certificate for name and its key, PEM
+// encoded.
+func keyPair(t *testing.T, name string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// servedName returns the common name of the certificate a new handshake
+// with cfg presents.
+func servedName(t *testing.T, cfg *tls.Config) string {
+	t.Helper()
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	go tls.Server(serverConn, cfg).Handshake()
+
+	client := tls.Client(clientConn, &tls.Config{InsecureSkipVerify: true})
+	if err := client.Handshake(); err != nil {
+		t.Fatalf("Handshake: %v", err)
+	}
+	return client.ConnectionState().PeerCertificates[0].Subject.CommonName
+}
+
+// waitForName polls new handshakes until one presents the certificate of
+// name.
+func waitForName(t *testing.T, cfg *tls.Config, name string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got := servedName(t, cfg)
+		if got == name {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("serving %s after 5s, want %s", got, name)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestReloaderRotatesCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	oldCert, oldKey := keyPair(t, "old")
+	writeFile(t, certFile, oldCert)
+	writeFile(t, keyFile, oldKey)
+
+	cfg, r, err := New(config.TLSConfig{CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go r.Run(ctx, 5*time.Millisecond)
+
+	if got := servedName(t, cfg); got != "old" {
+		t.Fatalf("serving %s, want old", got)
+	}
+
+	newCert, newKey := keyPair(t, "new")
+	writeFile(t, certFile, newCert)
+	writeFile(t, keyFile, newKey)
+	waitForName(t, cfg, "new")
+
+	// Files that do not load leave the previous certificate in use.
+	otherCert, _ := keyPair(t, "other")
+	for _, broken := range []struct {
+		name       string
+		cert, key  []byte
+		removeCert bool
+	}{
+		{"certificate without its key", otherCert, newKey, false},
+		{"corrupt certificate", []byte("not a certificate"), newKey, false},
+		{"missing certificate", nil, newKey, true},
+	} {
+		if broken.removeCert {
+			if err := os.Remove(certFile); err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			writeFile(t, certFile, broken.cert)
+		}
+		writeFile(t, keyFile, broken.key)
+		if _, err := r.reload(); err == nil {
+			t.Errorf("%s: reload succeeded, want an error", broken.name)
+		}
+		time.Sleep(20 * time.Millisecond)
+		if got := servedName(t, cfg); got != "new" {
+			t.Errorf("%s: serving %s, want the previous certificate", broken.name, got)
+		}
+	}
+
+	// Once the files are whole again they are picked up.
+	writeFile(t, certFile, oldCert)
+	writeFile(t, keyFile, oldKey)
+	waitForName(t, cfg, "old")
+}
+
+func TestNewRejectsInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	cert, key := keyPair(t, "server")
+	writeFile(t, certFile, cert)
+	writeFile(t, keyFile, key)
+	caFile := filepath.Join(dir, "ca.crt")
+	writeFile(t, caFile, []byte("not a certificate"))
+
+	tests := []struct {
+		name string
+		cfg  config.TLSConfig
+	}{
+		{"missing key", config.TLSConfig{CertFile: certFile, KeyFile: filepath.Join(dir, "missing.key")}},
+		{"key as certificate", config.TLSConfig{CertFile: keyFile, KeyFile: keyFile}},
+		{"client CA without certificates", config.TLSConfig{CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile}},
+		{"unknown cipher suite", config.TLSConfig{CertFile: certFile, KeyFile: keyFile, CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := New(tt.cfg); err == nil {
+				t.Error("New succeeded, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Package version describes the build of the server. The variables are set
+// at link time:
+//
+//	go build -ldflags "-X iu-k8s.linecorp.com/server/internal/version.Version=v1.2.3"
+package version
+
+var (
+	// Version is the release of the build.
+	Version = "dev"
+	// Commit is the git commit the build was made from.
+	Commit = "unknown"
+	// BuildDate is when the build was made, in RFC 3339.
+	BuildDate = "unknown"
+)
@@ -0,0 +1,139 @@
+// Package client is a typed Go client for the IU K8s API.
+//
+// The request and response types and the low-level Client are generated
+// from openapi.yaml (see openapi_client_config.yaml); this file and its
+// neighbours add authentication, retries, request ID propagation and
+// helpers for pagination, long-running operations and log streams.
+//
+//	c, err := client.New("https://iu-k8s.example.com",
+//		client.WithBearerToken(token),
+//		client.WithRetry(client.DefaultRetryPolicy),
+//	)
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RequestIDHeader carries the request ID to the server, which logs and
+// audits the request under it.
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context whose requests carry id in the
+// X-Request-ID header, tying them to the caller's own logs.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFrom returns the request ID set by WithRequestID, if any.
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// IdempotencyKeyHeader carries the idempotency key of a mutating request.
+// The server replays the response of the first request with a key to
+// retries instead of repeating it.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey returns a context whose requests carry key in the
+// Idempotency-Key header. Use a new key for every logical change; requests
+// that carry one are retried by WithRetry whatever their method.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+// IdempotencyKeyFrom returns the key set by WithIdempotencyKey, if any.
+func IdempotencyKeyFrom(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyKey{}).(string)
+	return key
+}
+
+// New creates a client for the API served at server. Requests carry the
+// request ID and idempotency key of their context. Options apply in order,
+// so WithRetry must follow WithHTTPClient.
+func New(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	opts = append([]ClientOption{WithRequestEditorFn(propagateContext)}, opts...)
+	return NewClientWithResponses(server, opts...)
+}
+
+func propagateContext(ctx context.Context, req *http.Request) error {
+	if id := RequestIDFrom(ctx); id != "" {
+		req.Header.Set(RequestIDHeader, id)
+	}
+	if key := IdempotencyKeyFrom(ctx); key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	return nil
+}
+
+// WithBearerToken authenticates every request with token.
+func WithBearerToken(token string) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// WithUserAgent identifies the calling program to the server.
+func WithUserAgent(userAgent string) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("User-Agent", userAgent)
+		return nil
+	})
+}
+
+// APIError is an error response of the API.
+type APIError struct {
+	StatusCode int
+	ErrorResponse
+}
+
+func (e *APIError) Error() string {
+	if e.ErrorResponse.Error == "" {
+		return fmt.Sprintf("unexpected status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("%s: %s (status %d)", e.ErrorResponse.Error, e.Message, e.StatusCode)
+}
+
+// PendingApprovalError is returned for a request the server held back until
+// another principal approves it. The request has not run.
+type PendingApprovalError struct {
+	Approval Approval
+}
+
+func (e *PendingApprovalError) Error() string {
+	return fmt.Sprintf("%s is waiting for approval %s", e.Approval.OperationId, e.Approval.Id)
+}
+
+// CheckResponse returns an *APIError for a response with an error status,
+// decoding its ErrorResponse body when there is one. The request ID falls
+// back to the X-Request-ID header for responses without such a body. A
+// request held back for approval gets a *PendingApprovalError instead of
+// the response of its operation.
+func CheckResponse(resp *http.Response, body []byte) error {
+	if resp.StatusCode == http.StatusAccepted && strings.HasPrefix(resp.Header.Get("Location"), "/api/v1/approvals/") {
+		pending := &PendingApprovalError{}
+		if err := json.Unmarshal(body, &pending.Approval); err != nil {
+			return fmt.Errorf("decode pending approval: %w", err)
+		}
+		return pending
+	}
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	_ = json.Unmarshal(body, &apiErr.ErrorResponse)
+	if id := resp.Header.Get(RequestIDHeader); apiErr.RequestId == nil && id != "" {
+		apiErr.RequestId = &id
+	}
+	return apiErr
+}
@@ -0,0 +1,334 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"iu-k8s.linecorp.com/server/internal/apitest"
+	"iu-k8s.linecorp.com/server/internal/audit"
+	"iu-k8s.linecorp.com/server/internal/auth"
+	"iu-k8s.linecorp.com/server/internal/events"
+	"iu-k8s.linecorp.com/server/internal/operation"
+	"iu-k8s.linecorp.com/server/pkg/client"
+)
+
+// fastRetry retries without noticeable delays.
+var fastRetry = client.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+func newClient(t *testing.T, srv *apitest.Server, opts ...client.ClientOption) *client.ClientWithResponses {
+	t.Helper()
+	c, err := client.New(srv.URL, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestAuthentication(t *testing.T) {
+	srv := apitest.New(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		opts   []client.ClientOption
+		status int
+		code   string
+	}{
+		{"admin", []client.ClientOption{client.WithBearerToken(apitest.AdminToken)}, http.StatusOK, ""},
+		{"invalid token", []client.ClientOption{client.WithBearerToken("nope")}, http.StatusUnauthorized, "unauthorized"},
+		{"anonymous", nil, http.StatusForbidden, "forbidden"},
+		{"no permission", []client.ClientOption{client.WithBearerToken(apitest.UserToken)}, http.StatusForbidden, "forbidden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, srv, tt.opts...)
+			resp, err := c.ListAuditEntriesWithResponse(ctx, &client.ListAuditEntriesParams{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode() != tt.status {
+				t.Fatalf("status %d, want %d: %s", resp.StatusCode(), tt.status, resp.Body)
+			}
+			err = client.CheckResponse(resp.HTTPResponse, resp.Body)
+			if tt.code == "" {
+				if err != nil {
+					t.Errorf("CheckResponse = %v, want nil", err)
+				}
+				return
+			}
+			var apiErr *client.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("CheckResponse = %v, want an *APIError", err)
+			}
+			if apiErr.StatusCode != tt.status || apiErr.ErrorResponse.Error != tt.code {
+				t.Errorf("APIError = %d %q, want %d %q", apiErr.StatusCode, apiErr.ErrorResponse.Error, tt.status, tt.code)
+			}
+		})
+	}
+}
+
+func TestRequestIDPropagation(t *testing.T) {
+	srv := apitest.New(t)
+	c := newClient(t, srv, client.WithBearerToken(apitest.AdminToken), client.WithUserAgent("client-test/1"))
+	ctx := client.WithRequestID(context.Background(), "trace-42")
+
+	// Error bodies carry the request ID.
+	resp, err := c.GetOperationWithResponse(ctx, "missing", &client.GetOperationParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var apiErr *client.APIError
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); !errors.As(err, &apiErr) {
+		t.Fatalf("CheckResponse = %v, want an *APIError", err)
+	}
+	if apiErr.RequestId == nil || *apiErr.RequestId != "trace-42" {
+		t.Errorf("error request ID = %v, want trace-42", apiErr.RequestId)
+	}
+	if got := resp.HTTPResponse.Header.Get(client.RequestIDHeader); got != "trace-42" {
+		t.Errorf("%s = %q, want trace-42", client.RequestIDHeader, got)
+	}
+	if got := srv.Requests()[0].Header.Get("User-Agent"); got != "client-test/1" {
+		t.Errorf("User-Agent = %q, want client-test/1", got)
+	}
+
+	// So do audit entries, here of a cordon failing to reach the cluster.
+	ctx = client.WithIdempotencyKey(ctx, "key-1")
+	if _, err := c.CordonNodeWithResponse(ctx, apitest.Cluster, "node-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Requests()[1].Header.Get(client.IdempotencyKeyHeader); got != "key-1" {
+		t.Errorf("%s = %q, want key-1", client.IdempotencyKeyHeader, got)
+	}
+	page, err := srv.Audit.Query(ctx, audit.Query{Filter: audit.Filter{Action: "nodes.cordon"}, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Entries) != 1 || page.Entries[0].RequestID != "trace-42" || page.Entries[0].Principal != apitest.Admin {
+		t.Errorf("audit entries = %+v, want one by %s under trace-42", page.Entries, apitest.Admin)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("idempotent method", func(t *testing.T) {
+		srv := apitest.New(t)
+		c := newClient(t, srv, client.WithBearerToken(apitest.AdminToken), client.WithRetry(fastRetry))
+		srv.Fail(2, http.StatusServiceUnavailable, "")
+		resp, err := c.ListClustersWithResponse(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusOK || len(resp.JSON200.Items) != 1 {
+			t.Errorf("status %d, want the clusters after retrying: %s", resp.StatusCode(), resp.Body)
+		}
+		if got := len(srv.Requests()); got != 3 {
+			t.Errorf("sent %d requests, want 3", got)
+		}
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		srv := apitest.New(t)
+		c := newClient(t, srv, client.WithBearerToken(apitest.AdminToken), client.WithRetry(fastRetry))
+		srv.Fail(10, http.StatusTooManyRequests, "")
+		resp, err := c.ListClustersWithResponse(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusTooManyRequests {
+			t.Errorf("status %d, want the last 429", resp.StatusCode())
+		}
+		if got := len(srv.Requests()); got != fastRetry.MaxAttempts {
+			t.Errorf("sent %d requests, want %d", got, fastRetry.MaxAttempts)
+		}
+	})
+
+	t.Run("not on client errors", func(t *testing.T) {
+		srv := apitest.New(t)
+		c := newClient(t, srv, client.WithRetry(fastRetry))
+		resp, err := c.ListClustersWithResponse(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			t.Fatalf("status %d: %s", resp.StatusCode(), resp.Body)
+		}
+		if _, err := c.GetOperationWithResponse(ctx, "missing", &client.GetOperationParams{}); err != nil {
+			t.Fatal(err)
+		}
+		if got := len(srv.Requests()); got != 2 {
+			t.Errorf("sent %d requests, want 2", got)
+		}
+	})
+
+	t.Run("post without idempotency key", func(t *testing.T) {
+		srv := apitest.New(t)
+		c := newClient(t, srv, client.WithBearerToken(apitest.AdminToken), client.WithRetry(fastRetry))
+		srv.Fail(1, http.StatusBadGateway, "")
+		resp, err := c.CordonNodeWithResponse(ctx, apitest.Cluster, "node-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusBadGateway {
+			t.Errorf("status %d, want the 502 unretried", resp.StatusCode())
+		}
+		if got := len(srv.Requests()); got != 1 {
+			t.Errorf("sent %d requests, want 1", got)
+		}
+	})
+
+	t.Run("post with idempotency key", func(t *testing.T) {
+		srv := apitest.New(t)
+		c := newClient(t, srv, client.WithBearerToken(apitest.AdminToken), client.WithRetry(fastRetry))
+		srv.Fail(1, http.StatusServiceUnavailable, "")
+		resp, err := c.CordonNodeWithResponse(client.WithIdempotencyKey(ctx, "key-1"), apitest.Cluster, "node-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() == http.StatusServiceUnavailable {
+			t.Error("the 503 was not retried")
+		}
+		if got := len(srv.Requests()); got != 2 {
+			t.Errorf("sent %d requests, want 2", got)
+		}
+	})
+
+	t.Run("retry-after takes precedence", func(t *testing.T) {
+		srv := apitest.New(t)
+		slow := client.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+		c := newClient(t, srv, client.WithRetry(slow))
+		srv.Fail(1, http.StatusServiceUnavailable, "0")
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		resp, err := c.ListClustersWithResponse(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			t.Errorf("status %d, want 200 after the retry", resp.StatusCode())
+		}
+	})
+
+	t.Run("context canceled while waiting", func(t *testing.T) {
+		srv := apitest.New(t)
+		slow := client.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+		c := newClient(t, srv, client.WithRetry(slow))
+		srv.Fail(1, http.StatusServiceUnavailable, "")
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		if _, err := c.ListClustersWithResponse(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want the context deadline", err)
+		}
+	})
+}
+
+func TestAuditEntries(t *testing.T) {
+	srv := apitest.New(t)
+	c := newClient(t, srv, client.WithBearerToken(apitest.AdminToken))
+	ctx := context.Background()
+	for i := range 5 {
+		if err := srv.Audit.Record(ctx, audit.Entry{Time: time.Now(), Principal: apitest.Admin, Action: "test.entry", Summary: fmt.Sprint(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var summaries []string
+	for entry, err := range client.AuditEntries(ctx, c, client.ListAuditEntriesParams{Action: ptr("test.entry"), Limit: ptr(2)}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		summaries = append(summaries, *entry.Summary)
+	}
+	if fmt.Sprint(summaries) != "[4 3 2 1 0]" {
+		t.Errorf("iterated %v, want all entries newest first", summaries)
+	}
+	if got := len(srv.Requests()); got != 3 {
+		t.Errorf("fetched %d pages, want 3", got)
+	}
+
+	// Stopping early fetches no further pages.
+	before := len(srv.Requests())
+	for range client.AuditEntries(ctx, c, client.ListAuditEntriesParams{Action: ptr("test.entry"), Limit: ptr(2)}) {
+		break
+	}
+	if got := len(srv.Requests()) - before; got != 1 {
+		t.Errorf("fetched %d pages after breaking off, want 1", got)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	srv := apitest.New(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	for i := range 5 {
+		err := srv.Events.SaveEvent(ctx, events.Event{
+			Cluster:   apitest.Cluster,
+			UID:       fmt.Sprint("uid-", i),
+			Namespace: "default",
+			Name:      fmt.Sprint("event-", i),
+			Type:      "Warning",
+			Reason:    "BackOff",
+			Object:    events.ObjectReference{Kind: "Pod", Namespace: "default", Name: "web"},
+			Count:     1,
+			FirstSeen: now.Add(time.Duration(i) * time.Second),
+			LastSeen:  now.Add(time.Duration(i) * time.Second),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := newClient(t, srv, client.WithBearerToken(apitest.AdminToken))
+	var names []string
+	for event, err := range client.Events(ctx, c, apitest.Cluster, client.SearchEventsParams{Limit: ptr(2)}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, event.Name)
+	}
+	if fmt.Sprint(names) != "[event-4 event-3 event-2 event-1 event-0]" {
+		t.Errorf("iterated %v, want all events most recent first", names)
+	}
+
+	// Errors end the iteration.
+	c = newClient(t, srv, client.WithBearerToken(apitest.UserToken))
+	var errs []error
+	for _, err := range client.Events(ctx, c, apitest.Cluster, client.SearchEventsParams{}) {
+		errs = append(errs, err)
+	}
+	var apiErr *client.APIError
+	if len(errs) != 1 || !errors.As(errs[0], &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("iterated errors %v, want a single 403", errs)
+	}
+}
+
+func TestWaitOperation(t *testing.T) {
+	srv := apitest.New(t)
+	c := newClient(t, srv, client.WithBearerToken(apitest.AdminToken))
+
+	op := srv.Operations.Start(operation.Operation{Type: "test.wait", Principal: auth.Principal{Name: apitest.Admin, Source: auth.SourceToken}},
+		func(ctx context.Context, report func(operation.Progress)) error {
+			time.Sleep(50 * time.Millisecond)
+			return errors.New("boom")
+		})
+	got, err := client.WaitOperation(context.Background(), c, op.ID)
+	if err != nil {
+		t.Fatalf("WaitOperation: %v", err)
+	}
+	if got.Status != client.OperationStatusFailed || got.Error == nil || *got.Error != "boom" {
+		t.Errorf("operation = %+v, want it failed with boom", got)
+	}
+
+	var apiErr *client.APIError
+	if _, err := client.WaitOperation(context.Background(), c, "missing"); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("WaitOperation of a missing operation = %v, want a 404", err)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
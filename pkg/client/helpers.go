@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// maxOperationWait is the longest wait the server accepts per poll of an
+// operation.
+const maxOperationWait = 300
+
+// Paginate iterates over the items of a cursor-paginated list. fetch
+// returns the page after cursor, which is nil for the first page. Iteration
+// stops after the first error.
+func Paginate[T any](ctx context.Context, fetch func(ctx context.Context, cursor *int64) ([]T, MetadataPagination, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var cursor *int64
+		for {
+			items, page, err := fetch(ctx, cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !page.HasMore {
+				return
+			}
+			next := int64(page.Cursor)
+			cursor = &next
+		}
+	}
+}
+
+// AuditEntries iterates over the audit entries matching params, newest
+// first, fetching further pages as needed. params.Cursor is ignored.
+func AuditEntries(ctx context.Context, c ClientWithResponsesInterface, params ListAuditEntriesParams) iter.Seq2[AuditEntry, error] {
+	return Paginate(ctx, func(ctx context.Context, cursor *int64) ([]AuditEntry, MetadataPagination, error) {
+		params.Cursor = cursor
+		resp, err := c.ListAuditEntriesWithResponse(ctx, &params)
+		if err != nil {
+			return nil, MetadataPagination{}, err
+		}
+		if err := CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+			return nil, MetadataPagination{}, err
+		}
+		return resp.JSON200.Items, resp.JSON200.Metadata, nil
+	})
+}
+
+// Events iterates over the events collected from cluster that match
+// params, the most recent first, fetching further pages as needed.
+// params.Cursor is ignored.
+func Events(ctx context.Context, c ClientWithResponsesInterface, cluster string, params SearchEventsParams) iter.Seq2[ClusterEvent, error] {
+	return Paginate(ctx, func(ctx context.Context, cursor *int64) ([]ClusterEvent, MetadataPagination, error) {
+		params.Cursor = cursor
+		resp, err := c.SearchEventsWithResponse(ctx, cluster, &params)
+		if err != nil {
+			return nil, MetadataPagination{}, err
+		}
+		if err := CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+			return nil, MetadataPagination{}, err
+		}
+		return resp.JSON200.Items, resp.JSON200.Metadata, nil
+	})
+}
+
+// WaitOperation blocks until the operation id finishes or ctx is done and
+// returns its final state. A failed operation is returned without error;
+// check its Status.
+func WaitOperation(ctx context.Context, c ClientWithResponsesInterface, id string) (*Operation, error) {
+	wait := maxOperationWait
+	for {
+		resp, err := c.GetOperationWithResponse(ctx, id, &GetOperationParams{WaitSeconds: &wait})
+		if err != nil {
+			return nil, err
+		}
+		if err := CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+			return nil, err
+		}
+		op := resp.JSON200
+		if op.Status == OperationStatusSucceeded || op.Status == OperationStatusFailed {
+			return op, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return op, err
+		}
+	}
+}
+
+// PodLogs opens the log of a container. With params.Follow the stream
+// stays open until the container stops or ctx is done. The caller closes
+// the stream.
+func PodLogs(ctx context.Context, c ClientInterface, cluster, namespace, pod string, params *GetPodLogsParams) (io.ReadCloser, error) {
+	resp, err := c.GetPodLogs(ctx, cluster, namespace, pod, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, CheckResponse(resp, body)
+	}
+	return resp.Body, nil
+}
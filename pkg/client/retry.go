@@ -0,0 +1,124 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Only requests with
+// idempotent methods or an Idempotency-Key are retried, after network
+// errors and 429, 502, 503 and 504 responses.
+type RetryPolicy struct {
+	// MaxAttempts includes the first attempt; 1 disables retries.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, doubled for every
+	// further one up to MaxBackoff. A Retry-After header takes precedence.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy retries up to three times within a few seconds.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  200 * time.Millisecond,
+	MaxBackoff:  5 * time.Second,
+}
+
+// WithRetry retries requests per policy. It wraps the Doer configured so
+// far, defaulting to http.DefaultClient.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		next := c.Client
+		if next == nil {
+			next = http.DefaultClient
+		}
+		c.Client = &retryDoer{next: next, policy: policy}
+		return nil
+	}
+}
+
+type retryDoer struct {
+	next   HttpRequestDoer
+	policy RetryPolicy
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	if !idempotent(req) {
+		return d.next.Do(req)
+	}
+
+	backoff := d.policy.MinBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := d.next.Do(req)
+		if attempt >= d.policy.MaxAttempts || !retryable(resp, err) {
+			return resp, err
+		}
+
+		wait := backoff
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				wait = after
+			}
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		backoff = min(2*backoff, d.policy.MaxBackoff)
+	}
+}
+
+func idempotent(req *http.Request) bool {
+	if req.Header.Get(IdempotencyKeyHeader) != "" {
+		return true
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses a Retry-After header in seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}